@@ -0,0 +1,130 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package annotations emits CI annotations for parse errors, validation errors
+and scenario failures so they appear inline on the pull request diff.
+
+Two formats are supported:
+  - github: workflow commands (::error file=...,line=...::message) printed to
+    stdout, picked up by GitHub Actions.
+  - gitlab: a code-quality JSON report written to gl-code-quality-report.json,
+    meant to be published as a GitLab code quality artifact.
+*/
+package annotations
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// GitHub emits GitHub Actions workflow commands.
+	GitHub = "github"
+	// GitLab collects a code-quality JSON report.
+	GitLab = "gitlab"
+
+	// gitLabReportFile is where the collected GitLab report is written,
+	// relative to the working directory so CI can pick it up as an artifact.
+	gitLabReportFile = "gl-code-quality-report.json"
+)
+
+// Format selects the annotation output, set from the --ci-annotations flag.
+// Empty means annotations are disabled.
+var Format string
+
+// Out is where GitHub workflow commands are printed. It is a variable to
+// allow tests to capture the output.
+var Out io.Writer = os.Stdout
+
+var mutex sync.Mutex
+var gitLabIssues []gitLabIssue
+
+// gitLabIssue is one entry of a GitLab code-quality report.
+type gitLabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitLabLocation `json:"location"`
+}
+
+type gitLabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitLabLines `json:"lines"`
+}
+
+type gitLabLines struct {
+	Begin int `json:"begin"`
+}
+
+// IsValidFormat reports whether the given --ci-annotations value is supported.
+func IsValidFormat(format string) bool {
+	return format == "" || format == GitHub || format == GitLab
+}
+
+// Enabled reports whether annotations should be emitted.
+func Enabled() bool {
+	return Format != ""
+}
+
+// Error records a failure against the given file and line. In GitHub format
+// the workflow command is printed immediately; in GitLab format the issue is
+// collected until Flush writes the report.
+func Error(checkName, file string, line int, message string) {
+	if !Enabled() {
+		return
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	switch Format {
+	case GitHub:
+		fmt.Fprintf(Out, "::error file=%s,line=%d::%s\n", file, line, escapeWorkflowCommand(message))
+	case GitLab:
+		gitLabIssues = append(gitLabIssues, gitLabIssue{
+			Description: message,
+			CheckName:   checkName,
+			Fingerprint: fmt.Sprintf("%x", md5.Sum([]byte(fmt.Sprintf("%s:%d:%s", file, line, message)))),
+			Severity:    "major",
+			Location:    gitLabLocation{Path: file, Lines: gitLabLines{Begin: line}},
+		})
+	}
+}
+
+// Flush writes the GitLab code-quality report when issues were collected and
+// returns the file it wrote to. It is a no-op for the GitHub format, which
+// prints annotations as they are recorded.
+func Flush() (string, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if Format != GitLab || gitLabIssues == nil {
+		return "", nil
+	}
+	contents, err := json.MarshalIndent(gitLabIssues, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	gitLabIssues = nil
+	if err := ioutil.WriteFile(gitLabReportFile, contents, 0640); err != nil {
+		return "", err
+	}
+	return gitLabReportFile, nil
+}
+
+// escapeWorkflowCommand escapes the characters GitHub Actions requires to be
+// encoded in workflow command messages.
+func escapeWorkflowCommand(message string) string {
+	message = strings.Replace(message, "%", "%25", -1)
+	message = strings.Replace(message, "\r", "%0D", -1)
+	message = strings.Replace(message, "\n", "%0A", -1)
+	return message
+}
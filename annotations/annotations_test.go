@@ -0,0 +1,116 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package annotations
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func useFormat(t *testing.T, format string) *bytes.Buffer {
+	t.Helper()
+	out := &bytes.Buffer{}
+	oldFormat, oldOut := Format, Out
+	Format, Out = format, out
+	t.Cleanup(func() {
+		Format, Out = oldFormat, oldOut
+		gitLabIssues = nil
+	})
+	return out
+}
+
+func TestIsValidFormat(t *testing.T) {
+	for _, format := range []string{"", GitHub, GitLab} {
+		if !IsValidFormat(format) {
+			t.Errorf("IsValidFormat(%q) = false, want true", format)
+		}
+	}
+	if IsValidFormat("jenkins") {
+		t.Error("IsValidFormat(\"jenkins\") = true, want false")
+	}
+}
+
+func TestErrorIsANoOpWhenDisabled(t *testing.T) {
+	out := useFormat(t, "")
+
+	Error("gauge-parse", "specs/example.spec", 4, "boom")
+
+	if out.Len() != 0 || gitLabIssues != nil {
+		t.Errorf("Error recorded output while annotations are disabled: %q %v", out.String(), gitLabIssues)
+	}
+}
+
+func TestGitHubWorkflowCommand(t *testing.T) {
+	out := useFormat(t, GitHub)
+
+	Error("gauge-parse", "specs/example.spec", 4, "Step implementation not found\nsecond line with 100%")
+
+	want := "::error file=specs/example.spec,line=4::Step implementation not found%0Asecond line with 100%25\n"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestGitLabCodeQualityReport(t *testing.T) {
+	useFormat(t, GitLab)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("unable to get working directory: %s", err.Error())
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("unable to change working directory: %s", err.Error())
+	}
+	defer func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatalf("unable to restore working directory: %s", err.Error())
+		}
+	}()
+
+	Error("gauge-execution", "specs/example.spec", 12, "Scenario failed: My scenario")
+	reportFile, err := Flush()
+	if err != nil {
+		t.Fatalf("Flush returned error: %s", err.Error())
+	}
+	if reportFile != gitLabReportFile {
+		t.Errorf("reportFile = %s, want %s", reportFile, gitLabReportFile)
+	}
+
+	contents, err := ioutil.ReadFile(reportFile)
+	if err != nil {
+		t.Fatalf("unable to read report: %s", err.Error())
+	}
+	var issues []gitLabIssue
+	if err := json.Unmarshal(contents, &issues); err != nil {
+		t.Fatalf("report is not valid JSON: %s", err.Error())
+	}
+	if len(issues) != 1 {
+		t.Fatalf("issues = %v, want one issue", issues)
+	}
+	issue := issues[0]
+	if issue.Description != "Scenario failed: My scenario" || issue.CheckName != "gauge-execution" {
+		t.Errorf("issue = %+v, want the recorded description and check name", issue)
+	}
+	if issue.Location.Path != "specs/example.spec" || issue.Location.Lines.Begin != 12 {
+		t.Errorf("issue location = %+v, want specs/example.spec:12", issue.Location)
+	}
+	if issue.Fingerprint == "" || issue.Severity != "major" {
+		t.Errorf("issue = %+v, want a fingerprint and major severity", issue)
+	}
+}
+
+func TestFlushIsANoOpWithoutCollectedIssues(t *testing.T) {
+	useFormat(t, GitLab)
+
+	reportFile, err := Flush()
+
+	if err != nil || reportFile != "" {
+		t.Errorf("Flush() = (%q, %v), want no report and no error", reportFile, err)
+	}
+}
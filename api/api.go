@@ -18,6 +18,7 @@ import (
 	"github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/metrics"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 )
@@ -53,7 +54,7 @@ func startAPIService(port int, startChannels *StartChannels, sig *infoGatherer.S
 
 func startAPIServiceWithoutRunner(port int, startChannels *StartChannels, sig *infoGatherer.SpecInfoGatherer) {
 	apiHandler := &gaugeAPIMessageHandler{specInfoGatherer: sig}
-	gaugeConnectionHandler, err := conn.NewGaugeConnectionHandler(port, apiHandler)
+	gaugeConnectionHandler, err := conn.NewSecureGaugeConnectionHandler(port, apiHandler)
 	if err != nil {
 		startChannels.ErrorChan <- fmt.Errorf("Connection error. %s", err.Error())
 		return
@@ -80,13 +81,17 @@ func ConnectToRunner(killChannel chan bool, debug bool) (runner.Runner, error) {
 	return runner, nil
 }
 
-func runAPIServiceIndefinitely(port int, specDirs []string) {
+func runAPIServiceIndefinitely(port, metricsPort int, specDirs []string) {
 	startChan := &StartChannels{RunnerChan: make(chan runner.Runner), ErrorChan: make(chan error), KillChan: make(chan bool)}
 
 	sig := &infoGatherer.SpecInfoGatherer{SpecDirs: specDirs}
 	sig.Init()
 	go startAPIServiceWithoutRunner(port, startChan, sig)
 	go checkParentIsAlive(startChan)
+	if metricsPort > 0 {
+		recordRunnerRestarts()
+		go metrics.StartServer(metricsPort)
+	}
 
 	logger.Infof(true, "Gauge daemon initialized and listening on port: %d", port)
 
@@ -104,6 +109,20 @@ func runAPIServiceIndefinitely(port int, specDirs []string) {
 	}
 }
 
+// recordRunnerRestarts feeds runner lifecycle events into the restart
+// counter served on the metrics endpoint.
+func recordRunnerRestarts() {
+	lifecycleChan := make(chan runner.LifecycleEvent, 16)
+	runner.RegisterLifecycleListener(lifecycleChan)
+	go func() {
+		for e := range lifecycleChan {
+			if e.State == runner.RunnerRestarted {
+				metrics.RecordRunnerRestart()
+			}
+		}
+	}()
+}
+
 func checkParentIsAlive(startChannels *StartChannels) {
 	parentProcessID := os.Getppid()
 	for {
@@ -115,8 +134,10 @@ func checkParentIsAlive(startChannels *StartChannels) {
 	}
 }
 
-// RunInBackground runs Gauge in daemonized mode on the given apiPort
-func RunInBackground(apiPort string, specDirs []string) {
+// RunInBackground runs Gauge in daemonized mode on the given apiPort.
+// When metricsPort is non-zero, a /metrics endpoint serving Prometheus
+// metrics is exposed on that port.
+func RunInBackground(apiPort string, specDirs []string, metricsPort int) {
 	var port int
 	var err error
 	if apiPort != "" {
@@ -131,14 +152,14 @@ func RunInBackground(apiPort string, specDirs []string) {
 			logger.Fatalf(true, fmt.Sprintf("Failed to start API Service. %s \n", err.Error()))
 		}
 	}
-	runAPIServiceIndefinitely(port, specDirs)
+	runAPIServiceIndefinitely(port, metricsPort, specDirs)
 }
 
 func Start(specsDir []string) *conn.GaugeConnectionHandler {
 	sig := &infoGatherer.SpecInfoGatherer{SpecDirs: specsDir}
 	sig.Init()
 	apiHandler := &gaugeAPIMessageHandler{specInfoGatherer: sig}
-	gaugeConnectionHandler, err := conn.NewGaugeConnectionHandler(0, apiHandler)
+	gaugeConnectionHandler, err := conn.NewSecureGaugeConnectionHandler(0, apiHandler)
 	if err != nil {
 		logger.Fatalf(true, err.Error())
 	}
@@ -9,6 +9,7 @@ package api
 import (
 	"net"
 	"path/filepath"
+	"time"
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/api/infoGatherer"
@@ -19,6 +20,7 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/metrics"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/refactor"
@@ -42,6 +44,8 @@ func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, co
 	} else {
 		logger.Debugf(false, "Api Request Received: %s", apiMessage)
 		messageType := apiMessage.GetMessageType()
+		start := time.Now()
+		defer func() { metrics.RecordAPIRequest(messageType.String(), time.Since(start)) }()
 		switch messageType {
 		case gauge_messages.APIMessage_GetProjectRootRequest:
 			responseMessage = handler.projectRootRequestResponse(apiMessage)
@@ -71,19 +71,36 @@ type ClientCapabilities struct {
 	SaveFiles bool `json:"saveFiles,omitempty"`
 }
 
-func gaugeLSPCapabilities() lsp.InitializeResult {
+// serverCapabilities extends the vendored lsp.ServerCapabilities with
+// semantic tokens, which the vendored package predates.
+type serverCapabilities struct {
+	lsp.ServerCapabilities
+	SemanticTokensProvider *semanticTokensOptions `json:"semanticTokensProvider,omitempty"`
+}
+
+type initializeResult struct {
+	Capabilities serverCapabilities `json:"capabilities,omitempty"`
+}
+
+func gaugeLSPCapabilities() initializeResult {
 	kind := lsp.TDSKFull
-	return lsp.InitializeResult{
-		Capabilities: lsp.ServerCapabilities{
-			TextDocumentSync:           &lsp.TextDocumentSyncOptionsOrKind{Kind: &kind, Options: &lsp.TextDocumentSyncOptions{Save: &lsp.SaveOptions{IncludeText: true}}},
-			CompletionProvider:         &lsp.CompletionOptions{ResolveProvider: true, TriggerCharacters: []string{"*", "* ", "\"", "<", ":", ","}},
-			DocumentFormattingProvider: true,
-			CodeLensProvider:           &lsp.CodeLensOptions{ResolveProvider: false},
-			DefinitionProvider:         true,
-			CodeActionProvider:         true,
-			DocumentSymbolProvider:     true,
-			WorkspaceSymbolProvider:    true,
-			RenameProvider:             true,
+	return initializeResult{
+		Capabilities: serverCapabilities{
+			ServerCapabilities: lsp.ServerCapabilities{
+				TextDocumentSync:           &lsp.TextDocumentSyncOptionsOrKind{Kind: &kind, Options: &lsp.TextDocumentSyncOptions{Save: &lsp.SaveOptions{IncludeText: true}}},
+				CompletionProvider:         &lsp.CompletionOptions{ResolveProvider: true, TriggerCharacters: []string{"*", "* ", "\"", "<", ":", ","}},
+				DocumentFormattingProvider: true,
+				CodeLensProvider:           &lsp.CodeLensOptions{ResolveProvider: false},
+				DefinitionProvider:         true,
+				CodeActionProvider:         true,
+				DocumentSymbolProvider:     true,
+				WorkspaceSymbolProvider:    true,
+				RenameProvider:             true,
+			},
+			SemanticTokensProvider: &semanticTokensOptions{
+				Legend: semanticTokensLegend{TokenTypes: semanticTokenTypes, TokenModifiers: []string{}},
+				Full:   true,
+			},
 		},
 	}
 }
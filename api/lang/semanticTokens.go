@@ -0,0 +1,154 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// Semantic token types advertised in the legend, in legend order. The
+// vendored lsp package predates semantic tokens, so the types are defined
+// here.
+const (
+	parameterTokenType = iota
+	tagTokenType
+)
+
+var semanticTokenTypes = []string{"parameter", "decorator"}
+
+var (
+	dynamicParamRegexp = regexp.MustCompile(`<[^>\n]*>`)
+	staticParamRegexp  = regexp.MustCompile(`"[^"\n]*"`)
+)
+
+type semanticTokensLegend struct {
+	TokenTypes     []string `json:"tokenTypes"`
+	TokenModifiers []string `json:"tokenModifiers"`
+}
+
+type semanticTokensOptions struct {
+	Legend semanticTokensLegend `json:"legend"`
+	Full   bool                 `json:"full"`
+}
+
+type semanticTokensParams struct {
+	TextDocument lsp.TextDocumentIdentifier `json:"textDocument"`
+}
+
+type semanticTokensResponse struct {
+	Data []int `json:"data"`
+}
+
+// semanticToken is one highlighted range on a single line, before delta
+// encoding.
+type semanticToken struct {
+	line      int
+	startChar int
+	length    int
+	tokenType int
+}
+
+// semanticTokens returns the semantic tokens of a spec or concept file:
+// step parameters (dynamic and static) and tag values.
+func semanticTokens(req *jsonrpc2.Request) (interface{}, error) {
+	var params semanticTokensParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse request %v", err)
+	}
+	file := util.ConvertURItoFilePath(params.TextDocument.URI)
+	if !util.IsGaugeFile(file) {
+		return semanticTokensResponse{Data: []int{}}, nil
+	}
+	var content string
+	if isOpen(params.TextDocument.URI) {
+		content = getContent(params.TextDocument.URI)
+	} else {
+		var err error
+		content, err = common.ReadFileContents(file)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tokens := collectSemanticTokens(strings.Split(content, "\n"))
+	return semanticTokensResponse{Data: encodeSemanticTokens(tokens)}, nil
+}
+
+func collectSemanticTokens(lines []string) []semanticToken {
+	var tokens []semanticToken
+	for lineNo, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(strings.ToLower(trimmed), "tags:") {
+			tokens = append(tokens, tagTokens(lineNo, line)...)
+			continue
+		}
+		if strings.HasPrefix(trimmed, "*") || strings.HasPrefix(trimmed, "#") {
+			tokens = append(tokens, paramTokens(lineNo, line)...)
+		}
+	}
+	return tokens
+}
+
+// paramTokens highlights <dynamic> and "static" parameters of a step or
+// concept heading line.
+func paramTokens(lineNo int, line string) []semanticToken {
+	var tokens []semanticToken
+	for _, re := range []*regexp.Regexp{dynamicParamRegexp, staticParamRegexp} {
+		for _, match := range re.FindAllStringIndex(line, -1) {
+			tokens = append(tokens, semanticToken{line: lineNo, startChar: match[0], length: match[1] - match[0], tokenType: parameterTokenType})
+		}
+	}
+	return tokens
+}
+
+// tagTokens highlights each comma separated value on a tags line.
+func tagTokens(lineNo int, line string) []semanticToken {
+	colon := strings.Index(line, ":")
+	var tokens []semanticToken
+	offset := colon + 1
+	for _, tag := range strings.Split(line[colon+1:], ",") {
+		trimmed := strings.TrimSpace(tag)
+		if trimmed != "" {
+			tokens = append(tokens, semanticToken{line: lineNo, startChar: offset + strings.Index(tag, trimmed), length: len(trimmed), tokenType: tagTokenType})
+		}
+		offset += len(tag) + 1
+	}
+	return tokens
+}
+
+// encodeSemanticTokens flattens tokens into the LSP wire format: five
+// integers per token, with line and start character relative to the
+// previous token. Tokens on a line are already produced per type, so they
+// are sorted by position first.
+func encodeSemanticTokens(tokens []semanticToken) []int {
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].line != tokens[j].line {
+			return tokens[i].line < tokens[j].line
+		}
+		return tokens[i].startChar < tokens[j].startChar
+	})
+	data := make([]int, 0, len(tokens)*5)
+	prevLine, prevStart := 0, 0
+	for _, token := range tokens {
+		deltaLine := token.line - prevLine
+		deltaStart := token.startChar
+		if deltaLine == 0 {
+			deltaStart = token.startChar - prevStart
+		}
+		data = append(data, deltaLine, deltaStart, token.length, token.tokenType, 0)
+		prevLine, prevStart = token.line, token.startChar
+	}
+	return data
+}
@@ -0,0 +1,74 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lang
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func TestSemanticTokensForParamsAndTags(t *testing.T) {
+	specText := `Specification Heading
+=====================
+
+Tags: smoke, fast
+
+Scenario Heading
+----------------
+
+* Say <hello> to "gauge"`
+
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(uri, specText)
+
+	b, _ := json.Marshal(semanticTokensParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}})
+	p := json.RawMessage(b)
+
+	got, err := semanticTokens(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	want := semanticTokensResponse{Data: []int{
+		3, 6, 5, tagTokenType, 0,
+		0, 7, 4, tagTokenType, 0,
+		5, 6, 7, parameterTokenType, 0,
+		0, 11, 7, parameterTokenType, 0,
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("semantic tokens failed, want: `%v`, got: `%v`", want, got)
+	}
+}
+
+func TestSemanticTokensForNonGaugeFile(t *testing.T) {
+	uri := util.ConvertPathToURI("impl.js")
+	b, _ := json.Marshal(semanticTokensParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}})
+	p := json.RawMessage(b)
+
+	got, err := semanticTokens(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	if data := got.(semanticTokensResponse).Data; len(data) != 0 {
+		t.Errorf("want no tokens for non gauge file, got: `%v`", data)
+	}
+}
+
+func TestCapabilitiesAdvertiseSemanticTokens(t *testing.T) {
+	capabilities := gaugeLSPCapabilities().Capabilities
+	if capabilities.SemanticTokensProvider == nil || !capabilities.SemanticTokensProvider.Full {
+		t.Fatalf("semantic tokens capability not advertised")
+	}
+	if !reflect.DeepEqual(capabilities.SemanticTokensProvider.Legend.TokenTypes, semanticTokenTypes) {
+		t.Errorf("want legend `%v`, got: `%v`", semanticTokenTypes, capabilities.SemanticTokensProvider.Legend.TokenTypes)
+	}
+}
@@ -181,6 +181,12 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return val, err
+	case "textDocument/semanticTokens/full":
+		val, err := semanticTokens(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
 	case "workspace/symbol":
 		val, err := workspaceSymbols(req)
 		if err != nil {
@@ -193,6 +199,12 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return val, err
+	case "gauge/stepUsages":
+		val, err := stepUsages(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
 	case "gauge/stepValueAt":
 		val, err := stepValueAt(req)
 		if err != nil {
@@ -0,0 +1,32 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getgauge/gauge/parser"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// stepUsages returns every usage of the given step or concept across specs
+// and concepts, as locations with file, line and column. Unlike
+// stepReferences, the request carries the raw step text or concept heading
+// (e.g. "Say <hello> to <gauge>"), so clients need not compute the parsed
+// step value themselves.
+func stepUsages(req *jsonrpc2.Request) (interface{}, error) {
+	var stepText string
+	if err := json.Unmarshal(*req.Params, &stepText); err != nil {
+		return nil, fmt.Errorf("failed to parse request %v", err)
+	}
+	stepValue, err := parser.ExtractStepValueAndParams(stepText, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse step %q: %s", stepText, err.Error())
+	}
+	return getLocationFor(stepValue.StepValue)
+}
@@ -0,0 +1,63 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lang
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func TestStepUsagesFromRawStepText(t *testing.T) {
+	provider = &dummyInfoProvider{}
+	specText := `Specification Heading
+=====================
+
+Scenario Heading
+----------------
+
+* Say <hello> to <gauge>`
+
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(uri, specText)
+
+	b, _ := json.Marshal("Say <hello> to <gauge>")
+	params := json.RawMessage(b)
+	want := []lsp.Location{
+		{URI: uri, Range: lsp.Range{
+			Start: lsp.Position{Line: 6, Character: 0},
+			End:   lsp.Position{Line: 6, Character: 24},
+		}},
+	}
+	got, err := stepUsages(&jsonrpc2.Request{Params: &params})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("get step usages failed, want: `%v`, got: `%v`", want, got)
+	}
+}
+
+func TestStepUsagesWithNoMatchingStep(t *testing.T) {
+	provider = &dummyInfoProvider{}
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+
+	b, _ := json.Marshal("step that exists nowhere")
+	params := json.RawMessage(b)
+	got, err := stepUsages(&jsonrpc2.Request{Params: &params})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	if locations := got.([]lsp.Location); len(locations) != 0 {
+		t.Errorf("want no locations, got: `%v`", locations)
+	}
+}
@@ -0,0 +1,116 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+)
+
+// workspaceRestartDelay is the pause before a project's daemon is restarted
+// after it exits unexpectedly.
+var workspaceRestartDelay = 5 * time.Second
+
+// WorkspaceProject is one project served by a workspace daemon, with the
+// port its API listens on.
+type WorkspaceProject struct {
+	ProjectRoot string `json:"projectRoot"`
+	Port        int    `json:"port"`
+}
+
+// RunWorkspace serves several project roots from one invocation, for IDEs
+// working in a monorepo. Because much of gauge's configuration (starting
+// with config.ProjectRoot) is process-global, isolation comes from running
+// one child daemon per project: each gets its own port, runner and
+// environment, and is restarted if it exits. The project-to-port mapping is
+// printed on stdout as JSON so clients know where to connect.
+func RunWorkspace(projectRoots []string) {
+	projects, err := workspaceProjects(projectRoots)
+	if err != nil {
+		logger.Fatalf(true, "Unable to start workspace daemon. %s", err.Error())
+	}
+	payload, err := json.Marshal(map[string][]WorkspaceProject{"projects": projects})
+	if err != nil {
+		logger.Fatalf(true, "Unable to serialize the workspace project mapping. %s", err.Error())
+	}
+	fmt.Println(string(payload))
+	wg := &sync.WaitGroup{}
+	for _, project := range projects {
+		wg.Add(1)
+		go superviseProjectDaemon(project, wg)
+	}
+	wg.Wait()
+}
+
+// workspaceProjects resolves the given roots to absolute gauge projects and
+// assigns each a free port for its daemon.
+func workspaceProjects(projectRoots []string) ([]WorkspaceProject, error) {
+	if len(projectRoots) == 0 {
+		return nil, fmt.Errorf("no project roots given")
+	}
+	var projects []WorkspaceProject
+	for _, root := range projectRoots {
+		absRoot, err := filepath.Abs(root)
+		if err != nil {
+			return nil, err
+		}
+		if !common.FileExists(filepath.Join(absRoot, common.ManifestFile)) {
+			return nil, fmt.Errorf("%s is not a gauge project, no %s found", absRoot, common.ManifestFile)
+		}
+		port, err := freePort()
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, WorkspaceProject{ProjectRoot: absRoot, Port: port})
+	}
+	return projects, nil
+}
+
+// superviseProjectDaemon keeps the project's daemon running: it is started
+// with the project as its working directory and restarted when it exits. The
+// child watches this process and terminates with it.
+func superviseProjectDaemon(project WorkspaceProject, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		cmd := exec.Command(os.Args[0], "daemon", strconv.Itoa(project.Port), "--dir", project.ProjectRoot) // #nosec G204
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		logger.Infof(true, "Starting daemon for %s on port %d", project.ProjectRoot, project.Port)
+		if err := cmd.Start(); err != nil {
+			logger.Errorf(true, "Unable to start daemon for %s: %s", project.ProjectRoot, err.Error())
+			return
+		}
+		err := cmd.Wait()
+		if err != nil {
+			logger.Warningf(true, "Daemon for %s exited: %s. Restarting in %s.", project.ProjectRoot, err.Error(), workspaceRestartDelay)
+		} else {
+			logger.Warningf(true, "Daemon for %s exited. Restarting in %s.", project.ProjectRoot, workspaceRestartDelay)
+		}
+		time.Sleep(workspaceRestartDelay)
+	}
+}
+
+// freePort asks the OS for an unused port. The child daemon's own listeners
+// still honour gauge_port_range; this port only carries its API.
+func freePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
@@ -0,0 +1,65 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package api
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/common"
+)
+
+func gaugeProjectDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "workspace_project_")
+	if err != nil {
+		t.Fatalf("Unable to create temp dir: %s", err.Error())
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	if err := ioutil.WriteFile(filepath.Join(dir, common.ManifestFile), []byte("{}"), 0600); err != nil {
+		t.Fatalf("Unable to write manifest: %s", err.Error())
+	}
+	return dir
+}
+
+func TestWorkspaceProjectsAssignsAPortPerProject(t *testing.T) {
+	first, second := gaugeProjectDir(t), gaugeProjectDir(t)
+
+	projects, err := workspaceProjects([]string{first, second})
+
+	if err != nil {
+		t.Fatalf("workspaceProjects failed: %s", err.Error())
+	}
+	if len(projects) != 2 {
+		t.Fatalf("projects = %v, want 2", projects)
+	}
+	if projects[0].ProjectRoot != first || projects[1].ProjectRoot != second {
+		t.Errorf("projects = %v, want the given roots", projects)
+	}
+	if projects[0].Port == 0 || projects[1].Port == 0 {
+		t.Errorf("projects = %v, want a port assigned to each", projects)
+	}
+}
+
+func TestWorkspaceProjectsRejectsNonGaugeProject(t *testing.T) {
+	dir, err := ioutil.TempDir("", "not_a_project_")
+	if err != nil {
+		t.Fatalf("Unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := workspaceProjects([]string{dir}); err == nil {
+		t.Errorf("Expected an error for a directory without a manifest")
+	}
+}
+
+func TestWorkspaceProjectsRejectsEmptyList(t *testing.T) {
+	if _, err := workspaceProjects(nil); err == nil {
+		t.Errorf("Expected an error when no project roots are given")
+	}
+}
@@ -0,0 +1,44 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution"
+	"github.com/spf13/cobra"
+)
+
+var failingScenario string
+
+var bisectCmd = &cobra.Command{
+	Use:   "bisect --failing <scenario> [flags] [args]",
+	Short: "Find the minimal preceding scenarios that make a scenario fail",
+	Long: `Bisect repeatedly re-runs subsets of the executed scenario order to find a
+minimal set of scenarios that, when run before the failing scenario, make it
+fail. Use it to hunt test pollution behind order-dependent failures.`,
+	Example: `  gauge bisect --failing "Vowel counts in multiple word" specs/`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.SetProjectRoot(args); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		loadEnvAndReinitLogger(cmd)
+		if failingScenario == "" {
+			exit(fmt.Errorf("Invalid Command. Flag --failing is required"), cmd.UsageString())
+		}
+		execution.BisectScenario = failingScenario
+		os.Exit(execution.Bisect(getSpecsDir(args)))
+	},
+	DisableAutoGenTag: true,
+}
+
+func init() {
+	GaugeCmd.AddCommand(bisectCmd)
+	bisectCmd.Flags().StringVarP(&failingScenario, "failing", "", "", "Heading of the failing scenario to bisect")
+}
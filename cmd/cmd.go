@@ -10,11 +10,13 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/getgauge/gauge/annotations"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/order"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/skel"
 	"github.com/getgauge/gauge/util"
@@ -133,12 +135,17 @@ func setGlobalFlags() {
 }
 
 func initPackageFlags() {
+	streams = resolveStreams(streamsText)
 	if parallel {
 		simpleConsole = true
 		reporter.IsParallel = true
 	}
+	if quiet && verbose {
+		logger.Fatalf(true, "Invalid flag combination: --quiet cannot be used with --verbose.")
+	}
 	reporter.SimpleConsoleOutput = simpleConsole
 	reporter.Verbose = verbose
+	reporter.Quiet = quiet
 	reporter.MachineReadable = machineReadable
 	execution.MachineReadable = machineReadable
 	execution.ExecuteTags = tags
@@ -150,7 +157,16 @@ func initPackageFlags() {
 	execution.Verbose = verbose
 	execution.Strategy = strategy
 	filter.ExecuteTags = tags
-	order.Sorted = sort
+	switch sortOrder {
+	case "", "false":
+	case "alpha", "true":
+		order.Sorted = true
+	case "random":
+		order.Random = true
+		order.Seed = seed
+	default:
+		logger.Fatalf(true, "Invalid input(%s) to --sort flag.", sortOrder)
+	}
 	filter.Distribute = group
 	filter.NumberOfExecutionStreams = streams
 	reporter.NumberOfExecutionStreams = streams
@@ -161,6 +177,27 @@ func initPackageFlags() {
 	filter.ScenariosName = scenarios
 	execution.MaxRetriesCount = maxRetriesCount
 	execution.RetryOnlyTags = retryOnlyTags
+	execution.DryRun = dryRun
+	execution.SuiteTableFile = suiteTable
+	execution.BaselineFile = baseline
+	execution.Notify = notify
+	if !annotations.IsValidFormat(ciAnnotations) {
+		logger.Fatalf(true, "Invalid input(%s) to --%s flag, accepted values are github and gitlab.", ciAnnotations, ciAnnotationsName)
+	}
+	annotations.Format = ciAnnotations
+	switch ciMessages {
+	case "", reporter.TeamCityService, reporter.AzureDevOpsService:
+		reporter.CIService = ciMessages
+	default:
+		logger.Fatalf(true, "Invalid input(%s) to --%s flag, accepted values are teamcity and azure.", ciMessages, ciMessagesName)
+	}
+	execution.MaxDuration = maxDuration
+	execution.Reproducible = reproducible
+	execution.ContinueOnFailure = continueOnFailure
+	execution.RepeatCount = repeatCount
+	execution.UntilFailure = untilFailure
+	parser.FuzzTables = fuzz
+	parser.FuzzSeed = fuzzSeed
 }
 
 var exit = func(err error, additionalText string) {
@@ -8,8 +8,10 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/logger"
 	"github.com/spf13/cobra"
 )
@@ -46,11 +48,36 @@ var (
 		},
 		DisableAutoGenTag: true,
 	}
+	encryptCmd = &cobra.Command{
+		Use:   "encrypt <key>=<value>",
+		Short: "Encrypt a property value for use in env properties files",
+		Long: `Encrypt a property value and print the property line with the value wrapped
+in ENC(...), ready to be pasted into an env properties file. Encrypted values
+are decrypted when the environment is loaded, using the key in ` + env.EncryptionKeyEnv + `.`,
+		Example: `  GAUGE_ENCRYPTION_KEY=secret gauge config encrypt db_password=s3cr3t`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 || !strings.Contains(args[0], "=") {
+				exit(fmt.Errorf("Encrypt command needs a single <key>=<value> argument."), cmd.UsageString())
+			}
+			parts := strings.SplitN(args[0], "=", 2)
+			key, value := strings.TrimSpace(parts[0]), parts[1]
+			if key == "" {
+				exit(fmt.Errorf("Encrypt command needs a non-empty property key."), cmd.UsageString())
+			}
+			encrypted, err := env.Encrypt(value)
+			if err != nil {
+				logger.Fatalf(true, err.Error())
+			}
+			logger.Infof(true, "%s = %s", key, encrypted)
+		},
+		DisableAutoGenTag: true,
+	}
 	list bool
 )
 
 func init() {
 	GaugeCmd.AddCommand(configCmd)
+	configCmd.AddCommand(encryptCmd)
 	configCmd.Flags().BoolVarP(&list, "list", "", false, "List all global properties")
 	configCmd.Flags().BoolVarP(&machineReadable, "machine-readable", "m", false, "Print all properties in JSON format")
 }
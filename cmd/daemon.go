@@ -33,6 +33,11 @@ var (
 			if err != nil {
 				exit(err, "Unable to set Daemon=true")
 			}
+			if projects != "" {
+				initLogger(cmd.Name())
+				api.RunWorkspace(splitCommaSeparated(projects))
+				return
+			}
 			if err := config.SetProjectRoot(args); err != nil {
 				exit(err, cmd.UsageString())
 			}
@@ -47,17 +52,21 @@ var (
 				port = args[0]
 				specs = getSpecsDir(args[1:])
 			}
-			api.RunInBackground(port, specs)
+			api.RunInBackground(port, specs, metricsPort)
 		},
 		PersistentPostRun: func(cmd *cobra.Command, args []string) { /* noop */ },
 		DisableAutoGenTag: true,
 	}
-	lsp bool
+	lsp         bool
+	metricsPort int
+	projects    string
 )
 
 func init() {
 	GaugeCmd.AddCommand(daemonCmd)
 	daemonCmd.Flags().BoolVarP(&lsp, "lsp", "", false, "Start language server")
+	daemonCmd.Flags().IntVarP(&metricsPort, "metrics-port", "", 0, "Serve Prometheus metrics at /metrics on this port")
+	daemonCmd.Flags().StringVarP(&projects, "projects", "", "", "Comma separated list of project roots to serve from one invocation. Each project gets its own daemon port, runner and configuration; the project to port mapping is printed as JSON")
 	err := daemonCmd.Flags().MarkHidden("lsp")
 	if err != nil {
 		logger.Fatalf(true, "Unable to hide `--lsp` flag: %s", err.Error())
@@ -11,33 +11,48 @@ import (
 
 	"github.com/getgauge/gauge/api"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/docs"
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/spf13/cobra"
 )
 
-var docsCmd = &cobra.Command{
-	Use:     "docs [flags] <plugin> [args]",
-	Short:   "Generate documentation using specified plugin",
-	Long:    `Generate documentation using specified plugin.`,
-	Example: "  gauge docs spectacle specs/",
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := config.SetProjectRoot(args); err != nil {
-			exit(err, cmd.UsageString())
-		}
-		loadEnvAndReinitLogger(cmd)
-		if len(args) < 1 {
-			exit(fmt.Errorf("Missing argument <plugin name>."), cmd.UsageString())
-		}
-		specDirs := getSpecsDir(args[1:])
-		var startAPIFunc = func(specDirs []string) int {
-			gaugeConnectionHandler := api.Start(specDirs)
-			return gaugeConnectionHandler.ConnectionPortNumber()
-		}
-		plugin.GenerateDoc(args[0], specDirs, startAPIFunc)
-	},
-	DisableAutoGenTag: true,
-}
+var (
+	docsCmd = &cobra.Command{
+		Use:   "docs [flags] <plugin> [args]",
+		Short: "Generate documentation using specified plugin, or render specs to HTML or Markdown",
+		Long: `Generate documentation using specified plugin. With --format, specs are
+rendered to static HTML or GitHub flavored Markdown without any plugin.`,
+		Example: "  gauge docs spectacle specs/\n  gauge docs --format html --out docs specs/",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			if docsFormat != "" {
+				if err := docs.Export(getSpecsDir(args), docsFormat, docsOut); err != nil {
+					logger.Fatalf(true, err.Error())
+				}
+				return
+			}
+			if len(args) < 1 {
+				exit(fmt.Errorf("Missing argument <plugin name>."), cmd.UsageString())
+			}
+			specDirs := getSpecsDir(args[1:])
+			var startAPIFunc = func(specDirs []string) int {
+				gaugeConnectionHandler := api.Start(specDirs)
+				return gaugeConnectionHandler.ConnectionPortNumber()
+			}
+			plugin.GenerateDoc(args[0], specDirs, startAPIFunc)
+		},
+		DisableAutoGenTag: true,
+	}
+	docsFormat string
+	docsOut    string
+)
 
 func init() {
 	GaugeCmd.AddCommand(docsCmd)
+	docsCmd.Flags().StringVarP(&docsFormat, "format", "", "", "Render specs without a plugin, to \"html\" or \"markdown\"")
+	docsCmd.Flags().StringVarP(&docsOut, "out", "", "docs", "Directory to write rendered docs to")
 }
@@ -0,0 +1,36 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/doctor"
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor [flags]",
+	Short: "Diagnose the project and environment",
+	Long: `Diagnose the project and environment: project structure, manifest validity,
+runner and plugin installation and compatibility, port availability and
+configuration sanity. Every failed check is printed with an actionable fix.`,
+	Example: `  gauge doctor`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.SetProjectRoot(args); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		loadEnvAndReinitLogger(cmd)
+		os.Exit(doctor.RunDiagnostics())
+	},
+	DisableAutoGenTag: true,
+}
+
+func init() {
+	GaugeCmd.AddCommand(doctorCmd)
+}
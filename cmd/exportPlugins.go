@@ -0,0 +1,42 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/plugin/install"
+	"github.com/spf13/cobra"
+)
+
+var exportPluginsCmd = &cobra.Command{
+	Use:   "export-plugins <file>",
+	Short: "Export the project's plugins as an offline bundle",
+	Long: `Export the project's language runner and plugins, at the installed versions,
+into a single zip bundle. The bundle can be installed on an air-gapped machine
+with 'gauge install --from <file>'.`,
+	Example: `  gauge export-plugins plugin-bundle.zip`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			exit(fmt.Errorf("Invalid Command. Missing bundle file name"), cmd.UsageString())
+		}
+		if err := config.SetProjectRoot(args[1:]); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		loadEnvAndReinitLogger(cmd)
+		if err := install.ExportPlugins(args[0]); err != nil {
+			logger.Fatalf(true, "Failed to export plugins : %s\n", err.Error())
+		}
+	},
+	DisableAutoGenTag: true,
+}
+
+func init() {
+	GaugeCmd.AddCommand(exportPluginsCmd)
+}
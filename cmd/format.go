@@ -12,21 +12,29 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var formatCmd = &cobra.Command{
-	Use:     "format [flags] [args]",
-	Short:   "Formats the specified spec files",
-	Long:    `Formats the specified spec files.`,
-	Example: "  gauge format specs/",
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := config.SetProjectRoot(args); err != nil {
-			exit(err, cmd.UsageString())
-		}
-		loadEnvAndReinitLogger(cmd)
-		formatter.FormatSpecFilesIn(getSpecsDir(args)[0])
-	},
-	DisableAutoGenTag: true,
-}
+var (
+	formatCmd = &cobra.Command{
+		Use:     "format [flags] [args]",
+		Short:   "Formats the specified spec files",
+		Long:    `Formats the specified spec files.`,
+		Example: "  gauge format specs/",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			if formatCheck {
+				formatter.CheckSpecFilesIn(getSpecsDir(args)[0])
+				return
+			}
+			formatter.FormatSpecFilesIn(getSpecsDir(args)[0])
+		},
+		DisableAutoGenTag: true,
+	}
+	formatCheck bool
+)
 
 func init() {
 	GaugeCmd.AddCommand(formatCmd)
+	formatCmd.Flags().BoolVarP(&formatCheck, "check", "", false, "Report files that are not formatted without rewriting them, and exit non-zero if any")
 }
@@ -0,0 +1,41 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/health"
+	"github.com/spf13/cobra"
+)
+
+var (
+	healthCmd = &cobra.Command{
+		Use:   "health [flags] [args]",
+		Short: "Show a quality score for the project's test suite",
+		Long: `Show a quality score for the project's test suite, combining lint findings,
+step implementation coverage and the recorded run history: pass rates,
+flakiness, skip rates and duration regressions.`,
+		Example: "  gauge health specs/",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			health.JSONOutput = healthJSON
+			os.Exit(health.Run(getSpecsDir(args)))
+		},
+		DisableAutoGenTag: true,
+	}
+	healthJSON bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(healthCmd)
+	healthCmd.Flags().BoolVarP(&healthJSON, "json", "", false, "Print the health report as JSON")
+}
@@ -17,8 +17,13 @@ var (
 	initCmd = &cobra.Command{
 		Use:     "init [flags] <template>",
 		Short:   "Initialize project structure in the current directory",
-		Long:    `Initialize project structure in the current directory.`,
-		Example: "  gauge init java",
+		Long: `Initialize project structure in the current directory. The template can be
+a template name fetched from the templates repository, a git URL, or a local
+directory. For git and local templates, occurrences of {{project_name}} and
+{{package}} in the template files are replaced with values prompted from the
+user.`,
+		Example: `  gauge init java
+  gauge init git@github.com:org/template.git`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if templates {
 				projectInit.ListTemplates()
@@ -20,8 +20,15 @@ var (
 		Long:  `Download and install specified plugin or all plugins in the project's 'manifest.json' file.`,
 		Example: `  gauge install
   gauge install java
-  gauge install java -f gauge-java-0.6.3-darwin.x86_64.zip`,
+  gauge install java -f gauge-java-0.6.3-darwin.x86_64.zip
+  gauge install --from plugin-bundle.zip`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if from != "" {
+				if err := install.InstallPluginsFromBundle(from); err != nil {
+					logger.Fatalf(true, "Failed to install plugins from %s : %s\n", from, err.Error())
+				}
+				return
+			}
 			if len(args) < 1 {
 				install.AllPlugins(machineReadable, false)
 				return
@@ -41,10 +48,12 @@ var (
 	}
 	zip      string
 	pVersion string
+	from     string
 )
 
 func init() {
 	GaugeCmd.AddCommand(installCmd)
 	installCmd.Flags().StringVarP(&zip, "file", "f", "", "Installs the plugin from zip file")
 	installCmd.Flags().StringVarP(&pVersion, "version", "v", "", "Version of plugin to be installed")
+	installCmd.Flags().StringVarP(&from, "from", "", "", "Installs all plugins from a bundle created by 'gauge export-plugins'")
 }
@@ -0,0 +1,41 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/lint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintCmd = &cobra.Command{
+		Use:   "lint [flags] [args]",
+		Short: "Check specs and concepts for style issues",
+		Long: `Check specs and concepts for style issues such as duplicate scenario headings,
+overly long scenarios and unused concepts. Rule severities are configured via
+properties, e.g. gauge_lint_long_scenario = off.`,
+		Example: "  gauge lint specs/",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			lint.JSONOutput = lintJSON
+			os.Exit(lint.Lint(getSpecsDir(args)))
+		},
+		DisableAutoGenTag: true,
+	}
+	lintJSON bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(lintCmd)
+	lintCmd.Flags().BoolVarP(&lintJSON, "json", "", false, "Print lint issues as a JSON array")
+}
@@ -0,0 +1,39 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/preview"
+	"github.com/spf13/cobra"
+)
+
+var previewPort int
+
+var previewCmd = &cobra.Command{
+	Use:   "preview [flags] [args]",
+	Short: "Serve a live-reloading HTML preview of specifications",
+	Long: `Serve a live-reloading HTML rendering of the project's specifications,
+with data tables, expanded concepts and styled tags, so specs can be
+reviewed in a browser while they are being edited.`,
+	Example: `  gauge preview specs/`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.SetProjectRoot(args); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		loadEnvAndReinitLogger(cmd)
+		if err := preview.Start(getSpecsDir(args), previewPort); err != nil {
+			exit(err, "")
+		}
+	},
+	DisableAutoGenTag: true,
+}
+
+func init() {
+	GaugeCmd.AddCommand(previewCmd)
+	previewCmd.Flags().IntVarP(&previewPort, "port", "p", 0, "Port to serve the preview on, defaults to an available port")
+}
@@ -0,0 +1,76 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/spf13/cobra"
+)
+
+// profilesFileName is the project file holding named execution profiles.
+// Each profile maps run flag names to values, e.g.
+//
+//	{
+//	  "pr":      {"tags": "smoke", "parallel": "true", "n": "4"},
+//	  "nightly": {"max-retries-count": "3", "verbose": "true"}
+//	}
+//
+// so `gauge run --profile pr` applies the preset without repeating flags.
+const profilesFileName = "profiles.json"
+
+// applyProfile loads the named profile from profiles.json and applies its
+// values to the command's flags. Flags given explicitly on the command line
+// win over profile values.
+func applyProfile(cmd *cobra.Command, name string) error {
+	profilesFile := filepath.Join(config.ProjectRoot, profilesFileName)
+	contents, err := common.ReadFileContents(profilesFile)
+	if err != nil {
+		return fmt.Errorf("Unable to read %s. %s", profilesFileName, err.Error())
+	}
+	var profiles map[string]map[string]string
+	if err = json.Unmarshal([]byte(contents), &profiles); err != nil {
+		return fmt.Errorf("Invalid %s. %s", profilesFileName, err.Error())
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		var available []string
+		for p := range profiles {
+			available = append(available, p)
+		}
+		sort.Strings(available)
+		return fmt.Errorf("Profile %q not found in %s. Available profiles: %s", name, profilesFileName, strings.Join(available, ", "))
+	}
+	flags := cmd.Flags()
+	var flagNames []string
+	for flagName := range profile {
+		flagNames = append(flagNames, flagName)
+	}
+	sort.Strings(flagNames)
+	for _, flagName := range flagNames {
+		if flagName == profileName {
+			return fmt.Errorf("Profile %q must not set the --%s flag", name, profileName)
+		}
+		flag := flags.Lookup(flagName)
+		if flag == nil {
+			return fmt.Errorf("Profile %q sets unknown flag %q", name, flagName)
+		}
+		if flags.Changed(flagName) {
+			continue
+		}
+		if err := flags.Set(flagName, profile[flagName]); err != nil {
+			return fmt.Errorf("Profile %q has invalid value %q for flag %q. %s", name, profile[flagName], flagName, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/spf13/cobra"
+)
+
+func setupProfilesFile(t *testing.T, contents string) func() {
+	dir, err := ioutil.TempDir("", "gauge-profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, profilesFileName), []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	return func() {
+		config.ProjectRoot = oldRoot
+		os.RemoveAll(dir)
+	}
+}
+
+func newProfileTestCommand() (*cobra.Command, *string, *int, *bool) {
+	cmd := &cobra.Command{Use: "run"}
+	profileTags := cmd.Flags().StringP("tags", "t", "", "")
+	streams := cmd.Flags().IntP("n", "n", 1, "")
+	parallel := cmd.Flags().BoolP("parallel", "p", false, "")
+	return cmd, profileTags, streams, parallel
+}
+
+func TestApplyProfileSetsFlagValues(t *testing.T) {
+	teardown := setupProfilesFile(t, `{"pr": {"tags": "smoke", "n": "4", "parallel": "true"}}`)
+	defer teardown()
+	cmd, profileTags, streams, parallel := newProfileTestCommand()
+
+	if err := applyProfile(cmd, "pr"); err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	if *profileTags != "smoke" || *streams != 4 || !*parallel {
+		t.Errorf("Expected profile values applied, got tags=%q n=%d parallel=%v", *profileTags, *streams, *parallel)
+	}
+}
+
+func TestApplyProfileDoesNotOverrideExplicitFlags(t *testing.T) {
+	teardown := setupProfilesFile(t, `{"pr": {"tags": "smoke"}}`)
+	defer teardown()
+	cmd, profileTags, _, _ := newProfileTestCommand()
+	if err := cmd.Flags().Set("tags", "regression"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := applyProfile(cmd, "pr"); err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	if *profileTags != "regression" {
+		t.Errorf("Expected explicit flag to win, got tags=%q", *profileTags)
+	}
+}
+
+func TestApplyProfileWithUnknownProfile(t *testing.T) {
+	teardown := setupProfilesFile(t, `{"nightly": {}, "pr": {}}`)
+	defer teardown()
+	cmd, _, _, _ := newProfileTestCommand()
+
+	err := applyProfile(cmd, "weekly")
+	if err == nil {
+		t.Fatal("Expected an error for unknown profile")
+	}
+	expected := `Profile "weekly" not found in profiles.json. Available profiles: nightly, pr`
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestApplyProfileWithUnknownFlag(t *testing.T) {
+	teardown := setupProfilesFile(t, `{"pr": {"no-such-flag": "1"}}`)
+	defer teardown()
+	cmd, _, _, _ := newProfileTestCommand()
+
+	if err := applyProfile(cmd, "pr"); err == nil {
+		t.Error("Expected an error for unknown flag in profile")
+	}
+}
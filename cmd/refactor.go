@@ -0,0 +1,97 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/refactor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	refactorCmd = &cobra.Command{
+		Use:   "refactor [flags] [args]",
+		Short: "Refactor specs and concepts",
+		Long: `Refactor specs and concepts. With --extract-concept, the steps in the line
+range given by --from are extracted into a concept in the file given by --to,
+and every occurrence of that step sequence across the specs is replaced with
+the new concept. With --rename-concept, the concept heading given as the
+first argument is renamed to the second, updating the definition and every
+usage; parameters can be renamed and reordered in the same refactoring.`,
+		Example: `  gauge refactor --extract-concept "Log in as admin" --from specs/login.spec:12-15 --to concepts/login.cpt
+  gauge refactor --rename-concept "Log in as <user>" "Sign in as <username>" --dry-run`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			if undoLast {
+				if err := refactor.UndoLast(); err != nil {
+					logger.Fatalf(true, err.Error())
+				}
+				return
+			}
+			if renameConcept {
+				if len(args) < 2 {
+					exit(fmt.Errorf("Missing arguments, --rename-concept needs <old heading> <new heading>."), cmd.UsageString())
+				}
+				if err := refactor.RenameConcept(args[0], args[1], getSpecsDir(args[2:]), refactorDryRun); err != nil {
+					logger.Fatalf(true, err.Error())
+				}
+				return
+			}
+			if extractConceptName == "" {
+				exit(fmt.Errorf("Missing --extract-concept flag."), cmd.UsageString())
+			}
+			if refactorTo == "" {
+				exit(fmt.Errorf("Missing --to flag with the concept file to extract into."), cmd.UsageString())
+			}
+			file, startLine, endLine, err := parseLineRange(refactorFrom)
+			if err != nil {
+				exit(err, cmd.UsageString())
+			}
+			if err := refactor.ExtractConcept(file, startLine, endLine, extractConceptName, refactorTo, getSpecsDir(args), refactorDryRun); err != nil {
+				logger.Fatalf(true, err.Error())
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	extractConceptName string
+	refactorFrom       string
+	refactorTo         string
+	renameConcept      bool
+	refactorDryRun     bool
+	undoLast           bool
+
+	lineRangePattern = regexp.MustCompile(`^(.+):(\d+)-(\d+)$`)
+)
+
+// parseLineRange splits a "file:start-end" argument into its parts.
+func parseLineRange(value string) (string, int, int, error) {
+	match := lineRangePattern.FindStringSubmatch(value)
+	if match == nil {
+		return "", 0, 0, fmt.Errorf("Invalid --from value %q, expected <file>:<startLine>-<endLine>.", value)
+	}
+	startLine, _ := strconv.Atoi(match[2])
+	endLine, _ := strconv.Atoi(match[3])
+	return match[1], startLine, endLine, nil
+}
+
+func init() {
+	GaugeCmd.AddCommand(refactorCmd)
+	refactorCmd.Flags().StringVarP(&extractConceptName, "extract-concept", "", "", "Name of the concept to extract the steps into")
+	refactorCmd.Flags().StringVarP(&refactorFrom, "from", "", "", "Steps to extract, as <file>:<startLine>-<endLine>")
+	refactorCmd.Flags().StringVarP(&refactorTo, "to", "", "", "Concept (.cpt) file to write the extracted concept to")
+	refactorCmd.Flags().BoolVarP(&renameConcept, "rename-concept", "", false, "Rename the concept heading given by <old heading> <new heading>")
+	refactorCmd.Flags().BoolVarP(&refactorDryRun, "dry-run", "", false, "Print the diff of the files that would change without writing them")
+	refactorCmd.Flags().BoolVarP(&undoLast, "undo-last", "", false, "Revert the file modifications made by the last refactoring")
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/getgauge/gauge/gauge"
 
@@ -27,43 +28,71 @@ import (
 )
 
 const (
-	verboseDefault         = false
-	simpleConsoleDefault   = false
-	failedDefault          = false
-	repeatDefault          = false
-	parallelDefault        = false
-	sortDefault            = false
-	installPluginsDefault  = true
-	environmentDefault     = "default"
-	tagsDefault            = ""
-	rowsDefault            = ""
-	strategyDefault        = "lazy"
-	onlyDefault            = ""
-	groupDefault           = -1
-	maxRetriesCountDefault = 1
-	retryOnlyTagsDefault   = ""
-	failSafeDefault        = false
-	skipCommandSaveDefault = false
+	verboseDefault           = false
+	quietDefault             = false
+	simpleConsoleDefault     = false
+	failedDefault            = false
+	repeatDefault            = false
+	parallelDefault          = false
+	sortDefault              = ""
+	seedDefault              = int64(0)
+	installPluginsDefault    = true
+	environmentDefault       = "default"
+	tagsDefault              = ""
+	rowsDefault              = ""
+	strategyDefault          = "lazy"
+	onlyDefault              = ""
+	groupDefault             = -1
+	maxRetriesCountDefault   = 1
+	retryOnlyTagsDefault     = ""
+	failSafeDefault          = false
+	skipCommandSaveDefault   = false
+	dryRunDefault            = false
+	suiteTableDefault        = ""
+	repeatCountDefault       = 1
+	untilFailureDefault      = false
+	fuzzDefault              = false
+	fuzzSeedDefault          = int64(0)
+	maxDurationDefault       = time.Duration(0)
+	reproducibleDefault      = false
+	continueOnFailureDefault = ""
 
-	verboseName         = "verbose"
-	simpleConsoleName   = "simple-console"
-	failedName          = "failed"
-	repeatName          = "repeat"
-	parallelName        = "parallel"
-	sortName            = "sort"
-	installPluginsName  = "install-plugins"
-	environmentName     = "env"
-	tagsName            = "tags"
-	rowsName            = "table-rows"
-	strategyName        = "strategy"
-	groupName           = "group"
-	maxRetriesCountName = "max-retries-count"
-	retryOnlyTagsName   = "retry-only"
-	streamsName         = "n"
-	onlyName            = "only"
-	failSafeName        = "fail-safe"
-	skipCommandSaveName = "skip-save"
-	scenarioName        = "scenario"
+	verboseName           = "verbose"
+	quietName             = "quiet"
+	simpleConsoleName     = "simple-console"
+	failedName            = "failed"
+	repeatName            = "repeat"
+	parallelName          = "parallel"
+	sortName              = "sort"
+	seedName              = "seed"
+	installPluginsName    = "install-plugins"
+	environmentName       = "env"
+	tagsName              = "tags"
+	rowsName              = "table-rows"
+	strategyName          = "strategy"
+	groupName             = "group"
+	maxRetriesCountName   = "max-retries-count"
+	retryOnlyTagsName     = "retry-only"
+	streamsName           = "n"
+	onlyName              = "only"
+	failSafeName          = "fail-safe"
+	skipCommandSaveName   = "skip-save"
+	scenarioName          = "scenario"
+	dryRunName            = "dry-run"
+	suiteTableName        = "suite-table"
+	repeatCountName       = "repeat-count"
+	untilFailureName      = "until-failure"
+	fuzzName              = "fuzz"
+	fuzzSeedName          = "fuzz-seed"
+	maxDurationName       = "max-duration"
+	reproducibleName      = "reproducible"
+	continueOnFailureName = "continue-on-failure"
+	profileName           = "profile"
+	excludeName           = "exclude"
+	baselineName          = "baseline"
+	ciAnnotationsName     = "ci-annotations"
+	ciMessagesName        = "ci-messages"
+	notifyName            = "notify"
 )
 
 var overrideRerunFlags = []string{verboseName, simpleConsoleName, machineReadableName, dirName, logLevelName}
@@ -81,9 +110,15 @@ var (
 			if err := config.SetProjectRoot(args); err != nil {
 				exit(err, cmd.UsageString())
 			}
+			if profile != "" {
+				if err := applyProfile(cmd, profile); err != nil {
+					exit(err, "")
+				}
+			}
 			if er := handleConflictingParams(cmd.Flags(), args); er != nil {
 				exit(er, "")
 			}
+			util.ExcludedGlobs = excludeGlobs
 			if repeat {
 				repeatLastExecution(cmd)
 			} else if failed {
@@ -96,11 +131,13 @@ var (
 		DisableAutoGenTag: true,
 	}
 	verbose                    bool
+	quiet                      bool
 	simpleConsole              bool
 	failed                     bool
 	repeat                     bool
 	parallel                   bool
-	sort                       bool
+	sortOrder                  string
+	seed                       int64
 	installPlugins             bool
 	environment                string
 	tags                       string
@@ -108,25 +145,42 @@ var (
 	rows                       string
 	strategy                   string
 	streams                    int
+	streamsText                string
 	maxRetriesCount            int
 	retryOnlyTags              string
 	group                      int
 	failSafe                   bool
 	skipCommandSave            bool
+	dryRun                     bool
+	suiteTable                 string
+	repeatCount                int
+	untilFailure               bool
+	fuzz                       bool
+	fuzzSeed                   int64
+	maxDuration                time.Duration
+	reproducible               bool
+	continueOnFailure          string
+	profile                    string
+	baseline                   string
+	ciAnnotations              string
+	ciMessages                 string
+	notify                     bool
 	scenarios                  []string
 	scenarioNameDefault        []string
+	excludeGlobs               []string
 )
 
 func init() {
 	GaugeCmd.AddCommand(runCmd)
 	f := runCmd.Flags()
 	f.BoolVarP(&verbose, verboseName, "v", verboseDefault, "Enable step level reporting on console, default being scenario level")
+	f.BoolVarP(&quiet, quietName, "q", quietDefault, "Print only the final summary and errors on console, suppressing per-scenario output")
 	f.BoolVarP(&simpleConsole, simpleConsoleName, "", simpleConsoleDefault, "Removes colouring and simplifies the console output")
-	f.StringVarP(&environment, environmentName, "e", environmentDefault, "Specifies the environment to use")
+	f.StringVarP(&environment, environmentName, "e", environmentDefault, "Specifies the environment to use. A comma separated list overlays the environments, or executes the suite once per environment when the allow_multi_env_execution property is enabled")
 	f.StringVarP(&tags, tagsName, "t", tagsDefault, "Executes the specs and scenarios tagged with given tags")
-	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows. It can be specified by range as 2-4 or as list 2,4")
+	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows. Accepts lists and ranges like 1-3,7,10- and column filters like role=admin")
 	f.BoolVarP(&parallel, parallelName, "p", parallelDefault, "Execute specs in parallel")
-	f.IntVarP(&streams, streamsName, "n", streamsDefault, "Specify number of parallel execution streams")
+	f.StringVarP(&streamsText, streamsName, "n", strconv.Itoa(streamsDefault), "Specify number of parallel execution streams, or `auto` to adapt the number of active streams to machine load")
 	f.IntVarP(&maxRetriesCount, maxRetriesCountName, "c", maxRetriesCountDefault, "Max count of iterations for failed scenario")
 	f.StringVarP(&retryOnlyTags, retryOnlyTagsName, "", retryOnlyTagsDefault, "Retries the specs and scenarios tagged with given tags")
 	f.StringVarP(&tagsToFilterForParallelRun, onlyName, "o", onlyDefault, "Specify number of parallel execution streams")
@@ -136,19 +190,36 @@ func init() {
 	}
 	f.IntVarP(&group, groupName, "g", groupDefault, "Specify which group of specification to execute based on -n flag")
 	f.StringVarP(&strategy, strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`")
-	f.BoolVarP(&sort, sortName, "s", sortDefault, "Run specs in Alphabetical Order")
+	f.StringVarP(&sortOrder, sortName, "s", sortDefault, "Sort specs before execution. `alpha` runs them in alphabetical order, `random` shuffles them; combine random with --seed to reproduce an order")
+	f.Lookup(sortName).NoOptDefVal = "alpha"
+	f.Int64VarP(&seed, seedName, "", seedDefault, "Seed for --sort=random. The seed in use is printed with the result, 0 picks a fresh one")
 	f.BoolVarP(&installPlugins, installPluginsName, "i", installPluginsDefault, "Install All Missing Plugins")
 	f.BoolVarP(&failed, failedName, "f", failedDefault, "Run only the scenarios failed in previous run. This cannot be used in conjunction with any other argument")
 	f.BoolVarP(&repeat, repeatName, "", repeatDefault, "Repeat last run. This cannot be used in conjunction with any other argument")
 	f.BoolVarP(&hideSuggestion, hideSuggestionName, "", hideSuggestionDefault, "Hide step implementation stub for every unimplemented step")
 	f.BoolVarP(&failSafe, failSafeName, "", failSafeDefault, "Force return 0 exit code, even in case of failures.")
 	f.BoolVarP(&skipCommandSave, skipCommandSaveName, "", skipCommandSaveDefault, "Skip saving last command in lastRunCmd.json")
+	f.BoolVarP(&dryRun, dryRunName, "", dryRunDefault, "Walks the specs and validates every step without executing anything, marking all scenarios as skipped")
+	f.StringVarP(&suiteTable, suiteTableName, "", suiteTableDefault, "Path to a CSV parameter table; the suite is executed once per row with the row values exported as environment variables")
+	f.IntVarP(&repeatCount, repeatCountName, "", repeatCountDefault, "Execute the filtered specs the given number of times, for soak runs and flakiness detection. Prints a per scenario flakiness summary")
+	f.BoolVarP(&untilFailure, untilFailureName, "", untilFailureDefault, "Stop a --repeat-count run at the first failing iteration")
+	f.BoolVarP(&fuzz, fuzzName, "", fuzzDefault, "Append generated boundary and edge-case rows to spec data tables and report which generated inputs failed")
+	f.Int64VarP(&fuzzSeed, fuzzSeedName, "", fuzzSeedDefault, "Seed for --fuzz. The seed in use is printed with the result, 0 picks a fresh one")
+	f.DurationVarP(&maxDuration, maxDurationName, "", maxDurationDefault, "Time budget for the run, e.g. 20m. Specs not scheduled before the budget runs out are skipped and recorded for `gauge run --failed`")
+	f.BoolVarP(&reproducible, reproducibleName, "", reproducibleDefault, "Zero volatile fields (timestamps, durations) in the canonical run result so identical outcomes serialize to identical bytes")
+	f.StringVarP(&continueOnFailure, continueOnFailureName, "", continueOnFailureDefault, "Control how much execution continues after a step failure, overriding ContinueOnFailure attributes on step implementations. `scenario` continues with the remaining steps of the scenario, `spec` aborts the scenario but runs the remaining scenarios, `none` stops scheduling further specs")
+	f.StringVarP(&profile, profileName, "", "", "Apply a named execution profile from profiles.json. Flags given on the command line override profile values")
+	f.StringVarP(&baseline, baselineName, "", "", "Fail only on regressions relative to the given saved run result (.gauge/last_run_result); failures already in the baseline do not affect the exit code")
+	f.StringVarP(&ciAnnotations, ciAnnotationsName, "", "", "Emit CI annotations for parse errors, validation errors and scenario failures. Accepts github (workflow commands) or gitlab (code-quality JSON report)")
+	f.StringVarP(&ciMessages, ciMessagesName, "", "", "Report progress as CI service messages instead of the regular console output. Accepts teamcity (##teamcity[...] messages) or azure (Azure DevOps logging commands)")
+	f.BoolVarP(&notify, notifyName, "", false, "Email the execution summary to the distribution list configured via the smtp_* and notification_emails properties")
 	err = f.MarkHidden(skipCommandSaveName)
 	if err != nil {
 		logger.Errorf(false, fmt.Sprintf("Unable to mark '%s' flag as hidden: %s", skipCommandSaveName, err.Error()))
 	}
 
 	f.StringArrayVar(&scenarios, scenarioName, scenarioNameDefault, "Set scenarios for running specs with scenario name")
+	f.StringArrayVar(&excludeGlobs, excludeName, nil, "Exclude spec files matching the given glob, e.g. \"specs/legacy/**\". Applies in addition to .gaugeignore")
 }
 
 func executeFailed(cmd *cobra.Command) {
@@ -234,6 +305,9 @@ func execute(cmd *cobra.Command, args []string) {
 	if parallel && tagsToFilterForParallelRun != "" && !env.AllowFilteredParallelExecution() {
 		logger.Fatal(true, "Filtered parallel execution is a experimental feature. It can be enabled via allow_filtered_parallel_execution property.")
 	}
+	if allEnvs := splitCommaSeparated(environment); len(allEnvs) > 1 && env.AllowMultiEnvExecution() {
+		execution.Environments = allEnvs
+	}
 	specs := getSpecsDir(args)
 	rerun.SaveState(os.Args[1:], specs)
 
@@ -248,6 +322,33 @@ func execute(cmd *cobra.Command, args []string) {
 	os.Exit(exitCode)
 }
 
+// splitCommaSeparated splits a comma separated flag value into its trimmed,
+// non-empty parts, e.g. the environments of --env or the roots of --projects.
+// resolveStreams turns the -n flag value into a stream count. `auto` starts
+// with one stream per core and lets execution adapt the number of active
+// streams to machine load and runner startup failures.
+func resolveStreams(value string) int {
+	if strings.EqualFold(strings.TrimSpace(value), "auto") {
+		execution.AdaptiveStreams = true
+		return util.NumberOfCores()
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		logger.Fatalf(true, "Invalid input(%s) to -n flag, expected a number or auto.", value)
+	}
+	return n
+}
+
+func splitCommaSeparated(value string) []string {
+	var parts []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
 var repeatLastExecution = func(cmd *cobra.Command) {
 	lastState := rerun.ReadPrevArgs()
 	handleFlags(cmd, lastState)
@@ -277,5 +378,8 @@ func handleConflictingParams(setFlags *pflag.FlagSet, args []string) error {
 	if maxRetriesCount == 1 && retryOnlyTags != "" {
 		return fmt.Errorf("Invalid Command. flag --retry-only can be used only with --max-retry-count")
 	}
+	if untilFailure && repeatCount <= 1 {
+		return fmt.Errorf("Invalid Command. flag --until-failure can be used only with --repeat-count")
+	}
 	return nil
 }
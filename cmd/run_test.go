@@ -24,6 +24,7 @@ import (
 	"reflect"
 
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/util"
 	"github.com/spf13/cobra"
 )
 
@@ -232,7 +233,7 @@ func TestHandleRerunFlagsWithVerbose(t *testing.T) {
 		cmd.Flags().IntP(streamsName, "n", streamsDefault, "Specify number of parallel execution streams")
 		cmd.Flags().IntP(groupName, "g", groupDefault, "Specify which group of specification to execute based on -n flag")
 		cmd.Flags().StringP(strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`")
-		cmd.Flags().BoolP(sortName, "s", sortDefault, "Run specs in Alphabetical Order")
+		cmd.Flags().StringP(sortName, "s", sortDefault, "Sort specs before execution. `alpha` runs them in alphabetical order, `random` shuffles them; combine random with --seed to reproduce an order")
 		cmd.Flags().BoolP(installPluginsName, "i", installPluginsDefault, "Install All Missing Plugins")
 		cmd.Flags().BoolP(failedName, "f", failedDefault, "Run only the scenarios failed in previous run. This is an exclusive flag, it cannot be used in conjunction with any other argument")
 		cmd.Flags().BoolP(repeatName, "", repeatDefault, "Repeat last run. This is an exclusive flag, it cannot be used in conjunction with any other argument")
@@ -610,3 +611,35 @@ func TestAddingMultipleFlagsToExecutionArgs(t *testing.T) {
 		t.Fatalf("Expecting execution arg value tag1 but found %s", execution.ExecutionArgs[1].Value[0])
 	}
 }
+
+func TestSplitCommaSeparated(t *testing.T) {
+	environments := splitCommaSeparated("chrome, firefox ,safari")
+	if len(environments) != 3 || environments[0] != "chrome" || environments[1] != "firefox" || environments[2] != "safari" {
+		t.Errorf("splitCommaSeparated = %v, want the trimmed environments", environments)
+	}
+	if environments := splitCommaSeparated("default"); len(environments) != 1 {
+		t.Errorf("splitCommaSeparated = %v, want a single environment", environments)
+	}
+}
+
+func TestResolveStreamsParsesANumber(t *testing.T) {
+	execution.AdaptiveStreams = false
+
+	if got := resolveStreams("4"); got != 4 {
+		t.Errorf("resolveStreams(4) = %d, want 4", got)
+	}
+	if execution.AdaptiveStreams {
+		t.Error("Expected a numeric -n to not enable adaptive streams")
+	}
+}
+
+func TestResolveStreamsAutoEnablesAdaptiveStreams(t *testing.T) {
+	defer func() { execution.AdaptiveStreams = false }()
+
+	if got := resolveStreams("auto"); got != util.NumberOfCores() {
+		t.Errorf("resolveStreams(auto) = %d, want %d", got, util.NumberOfCores())
+	}
+	if !execution.AdaptiveStreams {
+		t.Error("Expected -n auto to enable adaptive streams")
+	}
+}
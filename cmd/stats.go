@@ -0,0 +1,50 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/stats"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsCmd = &cobra.Command{
+		Use:   "stats [flags] [args]",
+		Short: "Show trend statistics from recorded runs",
+		Long: `Show trend statistics from recorded runs: pass-rate over time, the slowest
+scenarios of the latest run, scenarios that started failing compared to the
+previous run, and flaky candidates whose outcome alternated between pass and
+fail. Runs are recorded when save_execution_result is enabled.`,
+		Example: `  gauge stats
+  gauge stats --tag-flaky`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			if tagFlaky {
+				if err := stats.TagFlaky(getSpecsDir(args)); err != nil {
+					logger.Fatalf(true, err.Error())
+				}
+				return
+			}
+			stats.JSONOutput = statsJSON
+			stats.Run()
+		},
+		DisableAutoGenTag: true,
+	}
+	statsJSON bool
+	tagFlaky  bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(statsCmd)
+	statsCmd.Flags().BoolVarP(&statsJSON, "json", "", false, "Print the statistics as JSON")
+	statsCmd.Flags().BoolVarP(&tagFlaky, "tag-flaky", "", false, "Tag flaky candidate scenarios as 'flaky' in the spec files")
+}
@@ -0,0 +1,75 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/projectInit"
+	"github.com/spf13/cobra"
+)
+
+var (
+	templateCmd = &cobra.Command{
+		Use:   "template [command]",
+		Short: "Author and publish project templates",
+		Long: `Author and publish project templates. Templates carry a metadata file
+describing variables and post install hooks; packed templates can be
+published to the templates URL that 'gauge init' fetches from.`,
+		Example: `  gauge template create my_template
+  gauge template pack my_template
+  gauge template publish my_template.zip`,
+		DisableAutoGenTag: true,
+	}
+	templateCreateCmd = &cobra.Command{
+		Use:   "create <name>",
+		Short: "Scaffold a new template directory",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 1 {
+				exit(fmt.Errorf("Missing argument <name>."), cmd.UsageString())
+			}
+			if err := projectInit.CreateTemplate(args[0]); err != nil {
+				logger.Fatalf(true, "Failed to create template. %s", err.Error())
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	templatePackCmd = &cobra.Command{
+		Use:   "pack <dir>",
+		Short: "Pack a template directory into a publishable zip",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 1 {
+				exit(fmt.Errorf("Missing argument <dir>."), cmd.UsageString())
+			}
+			if _, err := projectInit.PackTemplate(args[0]); err != nil {
+				logger.Fatalf(true, "Failed to pack template. %s", err.Error())
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	templatePublishCmd = &cobra.Command{
+		Use:   "publish <zip>",
+		Short: "Publish a packed template to the templates URL",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 1 {
+				exit(fmt.Errorf("Missing argument <zip>."), cmd.UsageString())
+			}
+			if err := projectInit.PublishTemplate(args[0]); err != nil {
+				logger.Fatalf(true, "Failed to publish template. %s", err.Error())
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+)
+
+func init() {
+	GaugeCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateCreateCmd)
+	templateCmd.AddCommand(templatePackCmd)
+	templateCmd.AddCommand(templatePublishCmd)
+}
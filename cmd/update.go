@@ -8,7 +8,9 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin/install"
 	"github.com/spf13/cobra"
 )
@@ -17,13 +19,22 @@ var (
 	updateCmd = &cobra.Command{
 		Use:   "update [flags] <plugin>",
 		Short: "Updates a plugin",
-		Long:  `Updates a plugin.`,
+		Long: `Updates a plugin.
+With --self, updates the gauge binary itself from the release server
+configured in gauge_update_url, following the given release channel.`,
 		Example: `  gauge update java
   gauge update -a
-  gauge update -c`,
+  gauge update -c
+  gauge update --self --channel nightly`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if self {
+				if err := install.SelfUpdate(channel, machineReadable); err != nil {
+					logger.Fatalf(true, "Failed to update gauge. %s", err.Error())
+				}
+				return
+			}
 			if all {
-				install.UpdatePlugins(machineReadable)
+				install.UpdatePlugins(machineReadable, updateDryRun)
 				return
 			} else if check {
 				install.PrintUpdateInfoWithDetails()
@@ -36,12 +47,18 @@ var (
 		},
 		DisableAutoGenTag: true,
 	}
-	all   bool
-	check bool
+	all          bool
+	check        bool
+	self         bool
+	updateDryRun bool
+	channel      string
 )
 
 func init() {
 	GaugeCmd.AddCommand(updateCmd)
 	updateCmd.Flags().BoolVarP(&all, "all", "a", false, "Updates all the installed Gauge plugins")
 	updateCmd.Flags().BoolVarP(&check, "check", "c", false, "Checks for Gauge and plugins updates")
+	updateCmd.Flags().BoolVarP(&updateDryRun, "dry-run", "", false, "With --all, only reports which plugins would be updated")
+	updateCmd.Flags().BoolVarP(&self, "self", "", false, "Updates the gauge binary itself")
+	updateCmd.Flags().StringVarP(&channel, "channel", "", "stable", fmt.Sprintf("Release channel a self-update follows, one of: %s", strings.Join(install.SelfUpdateChannels, ", ")))
 }
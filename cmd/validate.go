@@ -8,6 +8,7 @@ package cmd
 
 import (
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/validation"
 	"github.com/spf13/cobra"
 )
@@ -25,6 +26,9 @@ var (
 		Example: "  gauge validate specs/",
 		Run: func(cmd *cobra.Command, args []string) {
 			validation.HideSuggestion = hideSuggestion
+			validation.JSONOutput = jsonOutput
+			validation.GenerateStubsFile = stubsFile
+			parser.Strict = strict
 			if err := config.SetProjectRoot(args); err != nil {
 				exit(err, cmd.UsageString())
 			}
@@ -35,9 +39,16 @@ var (
 		DisableAutoGenTag: true,
 	}
 	hideSuggestion bool
+	jsonOutput     bool
+	stubsFile      string
+	strict         bool
 )
 
 func init() {
 	GaugeCmd.AddCommand(validateCmd)
 	validateCmd.Flags().BoolVarP(&hideSuggestion, "hide-suggestion", "", false, "Prints a step implementation stub for every unimplemented step")
+	validateCmd.Flags().BoolVarP(&jsonOutput, "json", "", false, "Print parse and validation errors as JSON with file, line and error codes")
+	validateCmd.Flags().StringVarP(&stubsFile, "generate-stubs", "", "", "Write implementation stubs for unimplemented steps to the given `file` instead of printing them")
+	validateCmd.Flags().Lookup("generate-stubs").NoOptDefVal = "step_implementation_stubs.txt"
+	validateCmd.Flags().BoolVarP(&strict, "strict", "", false, "Treat parse warnings (duplicate headings, empty scenarios) as errors")
 }
@@ -0,0 +1,55 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/plugin/install"
+	"github.com/getgauge/gauge/util"
+	"github.com/spf13/cobra"
+)
+
+var warmCmd = &cobra.Command{
+	Use:   "warm [flags]",
+	Short: "Primes plugin, runner and spec caches for offline runs",
+	Long: `Primes everything needed for fast offline runs: installs missing plugins and
+the language runner declared in the manifest, and parses the project's concepts
+and specs once so later runs start warm. Intended to bake into CI images.`,
+	Example: `  gauge warm`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.SetProjectRoot(args); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		loadEnvAndReinitLogger(cmd)
+		logger.Infof(true, "Installing missing plugins.")
+		install.AllPlugins(machineReadable, false)
+		warmSpecCache()
+		logger.Infof(true, "Project caches are warm.")
+	},
+	DisableAutoGenTag: true,
+}
+
+func warmSpecCache() {
+	conceptDict, _, err := parser.ParseConcepts()
+	if err != nil {
+		logger.Warningf(true, "Unable to parse concepts while warming cache: %s", err.Error())
+		return
+	}
+	specDirs := util.GetSpecDirs()
+	if len(specDirs) == 0 {
+		return
+	}
+	specs, _ := parser.ParseSpecs(specDirs, conceptDict, gauge.NewBuildErrors())
+	logger.Infof(true, "Parsed %d specifications.", len(specs))
+}
+
+func init() {
+	GaugeCmd.AddCommand(warmCmd)
+}
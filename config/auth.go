@@ -0,0 +1,84 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package config
+
+import (
+	"encoding/base64"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/common"
+)
+
+// Credentials for private plugin repositories, template servers and update
+// endpoints are configured per URL in the Gauge properties file:
+//
+//	auth.internal.url = https://plugins.example.com
+//	auth.internal.type = bearer
+//	auth.internal.token = env:INTERNAL_REPO_TOKEN
+//
+// type is bearer (default) or basic; basic uses auth.<name>.username and
+// auth.<name>.password instead of a token. A value prefixed with env: is
+// read from the named environment variable, so secrets can stay out of the
+// properties file.
+
+var authURLKey = regexp.MustCompile(`^auth\.([^.]+)\.url$`)
+
+var getAllFromConfig = func() map[string]string {
+	config, err := common.GetGaugeConfigurationFor(common.GaugePropertiesFile)
+	if err != nil {
+		APILog.Warningf("Failed to get configuration from Gauge properties file. Error: %s", err.Error())
+		return nil
+	}
+	return config
+}
+
+// AuthHeaderFor returns the Authorization header value configured for the
+// given URL, or an empty string when no configured URL prefix matches.
+// The longest matching URL prefix wins.
+func AuthHeaderFor(rawURL string) string {
+	config := getAllFromConfig()
+	var name string
+	var matchLen int
+	for key, value := range config {
+		match := authURLKey.FindStringSubmatch(key)
+		if match == nil {
+			continue
+		}
+		prefix := strings.TrimSpace(value)
+		if prefix != "" && strings.HasPrefix(rawURL, prefix) && len(prefix) > matchLen {
+			name, matchLen = match[1], len(prefix)
+		}
+	}
+	if name == "" {
+		return ""
+	}
+	switch authType := strings.ToLower(strings.TrimSpace(config["auth."+name+".type"])); authType {
+	case "", "bearer":
+		if token := credential(config, "auth."+name+".token"); token != "" {
+			return "Bearer " + token
+		}
+	case "basic":
+		username := credential(config, "auth."+name+".username")
+		password := credential(config, "auth."+name+".password")
+		if username != "" {
+			return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+		}
+	default:
+		APILog.Warningf("Unknown auth type '%s' for auth.%s, expected bearer or basic.", authType, name)
+	}
+	return ""
+}
+
+func credential(config map[string]string, key string) string {
+	value := strings.TrimSpace(config[key])
+	if strings.HasPrefix(value, "env:") {
+		return os.Getenv(strings.TrimPrefix(value, "env:"))
+	}
+	return value
+}
@@ -0,0 +1,87 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package config
+
+import (
+	"os"
+	"testing"
+)
+
+func stubAuthConfig(config map[string]string) func() {
+	old := getAllFromConfig
+	getAllFromConfig = func() map[string]string { return config }
+	return func() { getAllFromConfig = old }
+}
+
+func TestAuthHeaderForBearerToken(t *testing.T) {
+	defer stubAuthConfig(map[string]string{
+		"auth.internal.url":   "https://plugins.example.com",
+		"auth.internal.token": "s3cret",
+	})()
+
+	header := AuthHeaderFor("https://plugins.example.com/java/install.json")
+
+	if header != "Bearer s3cret" {
+		t.Errorf("Expected 'Bearer s3cret', got '%s'", header)
+	}
+}
+
+func TestAuthHeaderForBasicAuth(t *testing.T) {
+	defer stubAuthConfig(map[string]string{
+		"auth.internal.url":      "https://templates.example.com",
+		"auth.internal.type":     "basic",
+		"auth.internal.username": "gauge",
+		"auth.internal.password": "s3cret",
+	})()
+
+	header := AuthHeaderFor("https://templates.example.com/java.zip")
+
+	if header != "Basic Z2F1Z2U6czNjcmV0" {
+		t.Errorf("Expected basic auth header, got '%s'", header)
+	}
+}
+
+func TestAuthHeaderForUnmatchedURL(t *testing.T) {
+	defer stubAuthConfig(map[string]string{
+		"auth.internal.url":   "https://plugins.example.com",
+		"auth.internal.token": "s3cret",
+	})()
+
+	if header := AuthHeaderFor("https://other.example.com/file"); header != "" {
+		t.Errorf("Expected no auth header, got '%s'", header)
+	}
+}
+
+func TestAuthHeaderForLongestMatchingPrefix(t *testing.T) {
+	defer stubAuthConfig(map[string]string{
+		"auth.all.url":      "https://example.com",
+		"auth.all.token":    "general",
+		"auth.nested.url":   "https://example.com/private",
+		"auth.nested.token": "specific",
+	})()
+
+	header := AuthHeaderFor("https://example.com/private/plugin.zip")
+
+	if header != "Bearer specific" {
+		t.Errorf("Expected 'Bearer specific', got '%s'", header)
+	}
+}
+
+func TestAuthCredentialFromEnvironment(t *testing.T) {
+	defer stubAuthConfig(map[string]string{
+		"auth.internal.url":   "https://plugins.example.com",
+		"auth.internal.token": "env:GAUGE_TEST_REPO_TOKEN",
+	})()
+	os.Setenv("GAUGE_TEST_REPO_TOKEN", "fromenv")
+	defer os.Unsetenv("GAUGE_TEST_REPO_TOKEN")
+
+	header := AuthHeaderFor("https://plugins.example.com/java/install.json")
+
+	if header != "Bearer fromenv" {
+		t.Errorf("Expected 'Bearer fromenv', got '%s'", header)
+	}
+}
@@ -18,13 +18,32 @@ import (
 
 const (
 	gaugeRepositoryURL      = "gauge_repository_url"
+	pluginRepositoryURLs    = "plugin_repository_urls"
+	gaugeProxyURL           = "gauge_proxy_url"
+	customSchedulerCommand  = "custom_scheduler_command"
+	testSelectorURL         = "test_selector_url"
+	notifyOnRunCompletion   = "notify_on_run_completion"
+	notifyRunThreshold      = "notify_run_threshold"
 	gaugeTemplatesURL       = "gauge_templates_url"
+	gaugeUpdateURL          = "gauge_update_url"
 	runnerConnectionTimeout = "runner_connection_timeout"
 	pluginConnectionTimeout = "plugin_connection_timeout"
 	pluginKillTimeOut       = "plugin_kill_timeout"
+	pluginNotifyTimeout     = "plugin_notification_timeout"
 	runnerRequestTimeout    = "runner_request_timeout"
 	ideRequestTimeout       = "ide_request_timeout"
 	checkUpdates            = "check_updates"
+	connectionRetryAttempts = "connection_retry_attempts"
+	connectionRetryInterval = "connection_retry_interval"
+	connectionRetryJitter   = "connection_retry_jitter"
+	scenarioRegressionLimit = "scenario_regression_threshold"
+	partialResultsInterval  = "partial_results_interval"
+	partialResultsSpecCount = "partial_results_spec_count"
+	resultWebhookURLs       = "result_webhook_urls"
+	gaugePortRange          = "gauge_port_range"
+	apiTLSCert              = "api_tls_cert"
+	apiTLSKey               = "api_tls_key"
+	apiAuthToken            = "api_auth_token"
 
 	defaultRunnerConnectionTimeout = time.Second * 25
 	defaultPluginConnectionTimeout = time.Second * 10
@@ -32,6 +51,11 @@ const (
 	defaultRefactorTimeout         = time.Second * 10
 	defaultRunnerRequestTimeout    = time.Second * 30
 	defaultIdeRequestTimeout       = time.Second * 30
+	defaultConnectionRetryAttempts = 3
+	defaultConnectionRetryInterval = time.Millisecond * 500
+	defaultConnectionRetryJitter   = time.Millisecond * 100
+	defaultRegressionThreshold     = 50
+	defaultNotifyRunThreshold      = time.Minute * 2
 	LayoutForTimeStamp             = "Jan 2, 2006 at 3:04pm"
 )
 
@@ -56,6 +80,80 @@ func PluginKillTimeout() time.Duration {
 	return convertToTime(intervalString, defaultPluginKillTimeout, pluginKillTimeOut)
 }
 
+// PluginNotificationTimeout gets the time in milliseconds a plugin is given to handle
+// an execution notification before it is skipped with a warning. 0 waits indefinitely.
+func PluginNotificationTimeout() time.Duration {
+	intervalString := getFromConfig(pluginNotifyTimeout)
+	if intervalString == "" {
+		return 0
+	}
+	return convertToTime(intervalString, 0, pluginNotifyTimeout)
+}
+
+// ConnectionRetryAttempts gets the number of times a runner/plugin connection is attempted before giving up
+func ConnectionRetryAttempts() int {
+	attempts := getFromConfig(connectionRetryAttempts)
+	return convertToInt(attempts, connectionRetryAttempts, defaultConnectionRetryAttempts)
+}
+
+// ConnectionRetryInterval gets the base interval in milliseconds between runner/plugin connection attempts
+func ConnectionRetryInterval() time.Duration {
+	intervalString := getFromConfig(connectionRetryInterval)
+	return convertToTime(intervalString, defaultConnectionRetryInterval, connectionRetryInterval)
+}
+
+// ConnectionRetryJitter gets the maximum random jitter in milliseconds added to each retry interval
+func ConnectionRetryJitter() time.Duration {
+	jitterString := getFromConfig(connectionRetryJitter)
+	return convertToTime(jitterString, defaultConnectionRetryJitter, connectionRetryJitter)
+}
+
+// ScenarioRegressionThreshold gets the percentage by which a scenario may run slower
+// than its historical baseline before it is flagged as a duration regression. 0 disables the check.
+func ScenarioRegressionThreshold() int {
+	threshold := getFromConfig(scenarioRegressionLimit)
+	return convertToInt(threshold, scenarioRegressionLimit, defaultRegressionThreshold)
+}
+
+// PartialResultsInterval gets the interval in minutes at which intermediate
+// aggregated results are published during a run. 0 disables time based publishing.
+func PartialResultsInterval() time.Duration {
+	minutes := convertToInt(getFromConfig(partialResultsInterval), partialResultsInterval, 0)
+	return time.Minute * time.Duration(minutes)
+}
+
+// PartialResultsSpecCount gets the number of executed specs after which intermediate
+// aggregated results are published during a run. 0 disables count based publishing.
+func PartialResultsSpecCount() int {
+	return convertToInt(getFromConfig(partialResultsSpecCount), partialResultsSpecCount, 0)
+}
+
+// ResultWebhookURLs gets the URLs to which execution results are POSTed as JSON,
+// both intermediate and final. Multiple URLs are comma separated.
+func ResultWebhookURLs() []string {
+	var urls []string
+	for _, url := range strings.Split(getFromConfig(resultWebhookURLs), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// NotifyOnRunCompletion determines if a desktop notification and terminal
+// bell should be raised when a long run finishes. Disabled by default.
+func NotifyOnRunCompletion() bool {
+	allow := getFromConfig(notifyOnRunCompletion)
+	return convertToBool(allow, notifyOnRunCompletion, false)
+}
+
+// NotifyRunThreshold gets the minimum execution time in milliseconds a run
+// must take before a completion notification is raised.
+func NotifyRunThreshold() time.Duration {
+	intervalString := getFromConfig(notifyRunThreshold)
+	return convertToTime(intervalString, defaultNotifyRunThreshold, notifyRunThreshold)
+}
+
 // CheckUpdates determines if update check is enabled
 func CheckUpdates() bool {
 	allow := getFromConfig(checkUpdates)
@@ -90,11 +188,116 @@ func GaugeRepositoryUrl() string {
 	return getFromConfig(gaugeRepositoryURL)
 }
 
+// PluginRepositoryUrls fetches the plugin repository URLs to try in order when
+// installing plugins. Multiple URLs are comma separated, so self-hosted mirrors
+// can be listed before or instead of the public repository. Falls back to
+// gauge_repository_url when not set. Credentials for a repository are picked up
+// from its auth.<name>.url configuration.
+func PluginRepositoryUrls() []string {
+	var urls []string
+	for _, url := range strings.Split(getFromConfig(pluginRepositoryURLs), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	if len(urls) == 0 && GaugeRepositoryUrl() != "" {
+		urls = append(urls, GaugeRepositoryUrl())
+	}
+	return urls
+}
+
+// GaugeProxyURL fetches the proxy URL for outgoing HTTP requests. When unset,
+// the standard proxy environment variables apply.
+func GaugeProxyURL() string {
+	return getFromConfig(gaugeProxyURL)
+}
+
+// TimeoutProperties lists the configuration keys that must hold a timeout in
+// milliseconds, for diagnostics that validate configured values.
+func TimeoutProperties() []string {
+	return []string{runnerConnectionTimeout, pluginConnectionTimeout, pluginKillTimeOut, runnerRequestTimeout, ideRequestTimeout}
+}
+
+// CustomSchedulerCommand fetches the command that distributes specs across
+// parallel execution streams. When unset, the built-in round-robin
+// distribution is used.
+func CustomSchedulerCommand() string {
+	return getFromConfig(customSchedulerCommand)
+}
+
+// TestSelectorURL fetches the endpoint of a test impact analysis service
+// that is asked which of the candidate scenarios should run. When unset,
+// every candidate runs.
+func TestSelectorURL() string {
+	return getFromConfig(testSelectorURL)
+}
+
+// PortRange returns the inclusive range dynamically allocated listener ports
+// (runner handshake, plugin connections, API) are drawn from, configured as
+// gauge_port_range=48000-48100 for restrictive containers and firewalled CI
+// agents. ok is false when no range is configured; an unparsable range is
+// treated as unset.
+func PortRange() (start, end int, ok bool) {
+	value := os.Getenv(gaugePortRange)
+	if strings.TrimSpace(value) == "" {
+		value = getFromConfig(gaugePortRange)
+	}
+	if strings.TrimSpace(value) == "" {
+		return 0, 0, false
+	}
+	parts := strings.SplitN(value, "-", 2)
+	if len(parts) != 2 {
+		APILog.Warningf("Incorrect value for %s. Expected a range like 48000-48100, got %s", gaugePortRange, value)
+		return 0, 0, false
+	}
+	start, startErr := strconv.Atoi(strings.TrimSpace(parts[0]))
+	end, endErr := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if startErr != nil || endErr != nil || start < 1 || end > 65535 || start > end {
+		APILog.Warningf("Incorrect value for %s. Expected a range like 48000-48100, got %s", gaugePortRange, value)
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// APITLSCert returns the path of the certificate the daemon API listener
+// serves TLS with. TLS is enabled only when both api_tls_cert and
+// api_tls_key are configured.
+func APITLSCert() string {
+	if cert := strings.TrimSpace(os.Getenv(apiTLSCert)); cert != "" {
+		return cert
+	}
+	return strings.TrimSpace(getFromConfig(apiTLSCert))
+}
+
+// APITLSKey returns the path of the private key belonging to api_tls_cert.
+func APITLSKey() string {
+	if key := strings.TrimSpace(os.Getenv(apiTLSKey)); key != "" {
+		return key
+	}
+	return strings.TrimSpace(getFromConfig(apiTLSKey))
+}
+
+// APIAuthToken returns the shared token daemon API clients must present
+// before any of their messages are processed. Empty (the default) disables
+// the handshake.
+func APIAuthToken() string {
+	if token := strings.TrimSpace(os.Getenv(apiAuthToken)); token != "" {
+		return token
+	}
+	return strings.TrimSpace(getFromConfig(apiAuthToken))
+}
+
 // GaugeTemplatesUrl fetches the URL to be used to download project templates
 func GaugeTemplatesUrl() string {
 	return getFromConfig(gaugeTemplatesURL)
 }
 
+// GaugeUpdateUrl fetches the base URL gauge self-updates download release
+// manifests and binaries from.
+func GaugeUpdateUrl() string {
+	return getFromConfig(gaugeUpdateURL)
+}
+
 // SetProjectRoot sets project root location in ENV.
 func SetProjectRoot(args []string) error {
 	if ProjectRoot != "" {
@@ -125,6 +328,18 @@ func convertToTime(value string, defaultValue time.Duration, name string) time.D
 	return time.Millisecond * time.Duration(intValue)
 }
 
+func convertToInt(value string, name string, defaultValue int) int {
+	if strings.TrimSpace(value) == "" {
+		return defaultValue
+	}
+	intValue, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		APILog.Warningf("Incorrect value for %s in property file. Cannot convert %s to int", name, value)
+		return defaultValue
+	}
+	return intValue
+}
+
 func convertToBool(value string, property string, defaultValue bool) bool {
 	boolValue, err := strconv.ParseBool(strings.TrimSpace(value))
 	if err != nil {
@@ -50,6 +50,33 @@ func TestRunnerRequestTimeout(t *testing.T) {
 	}
 }
 
+func TestPluginRepositoryUrls(t *testing.T) {
+	defer func() { getFromConfig = stubGetFromConfig }()
+	getFromConfig = func(propertyName string) string {
+		switch propertyName {
+		case pluginRepositoryURLs:
+			return "https://mirror.example.com/plugin, https://downloads.gauge.org/plugin"
+		default:
+			return ""
+		}
+	}
+	urls := PluginRepositoryUrls()
+	if len(urls) != 2 || urls[0] != "https://mirror.example.com/plugin" {
+		t.Errorf("Expected configured repository urls in order, got %v", urls)
+	}
+
+	getFromConfig = func(propertyName string) string {
+		if propertyName == gaugeRepositoryURL {
+			return "https://downloads.gauge.org/plugin"
+		}
+		return ""
+	}
+	urls = PluginRepositoryUrls()
+	if len(urls) != 1 || urls[0] != "https://downloads.gauge.org/plugin" {
+		t.Errorf("Expected fallback to gauge_repository_url, got %v", urls)
+	}
+}
+
 func TestAllowUpdates(t *testing.T) {
 	getFromConfig = stubGetFromConfig
 	if !CheckUpdates() {
@@ -71,3 +98,50 @@ func TestAllowUpdates(t *testing.T) {
 		t.Error("Expected CheckUpdates=true, got false")
 	}
 }
+
+func TestPortRange(t *testing.T) {
+	defer func() { getFromConfig = stubGetFromConfig }()
+	getFromConfig = func(propertyName string) string {
+		if propertyName == gaugePortRange {
+			return "48000-48100"
+		}
+		return ""
+	}
+
+	start, end, ok := PortRange()
+
+	if !ok || start != 48000 || end != 48100 {
+		t.Errorf("PortRange() = %d, %d, %t, want 48000, 48100, true", start, end, ok)
+	}
+}
+
+func TestPortRangeUnset(t *testing.T) {
+	getFromConfig = stubGetFromConfig
+
+	if _, _, ok := PortRange(); ok {
+		t.Errorf("Expected PortRange to report no configured range")
+	}
+}
+
+func TestPortRangeFromEnvironment(t *testing.T) {
+	getFromConfig = stubGetFromConfig
+	os.Setenv(gaugePortRange, " 49000 - 49010 ")
+	defer os.Unsetenv(gaugePortRange)
+
+	start, end, ok := PortRange()
+
+	if !ok || start != 49000 || end != 49010 {
+		t.Errorf("PortRange() = %d, %d, %t, want 49000, 49010, true", start, end, ok)
+	}
+}
+
+func TestPortRangeInvalidValuesAreTreatedAsUnset(t *testing.T) {
+	defer func() { getFromConfig = stubGetFromConfig }()
+	for _, value := range []string{"48100-48000", "abc-def", "48000", "0-70000"} {
+		value := value
+		getFromConfig = func(propertyName string) string { return value }
+		if _, _, ok := PortRange(); ok {
+			t.Errorf("Expected PortRange to treat %q as unset", value)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Property values referencing an external secret store are resolved when the
+// environment is loaded:
+//
+//	db_password = vault:secret/ci#password
+//	api_token = ssm:/ci/gauge/api-token
+//
+// The part before the first colon names the resolver. Resolved values are
+// masked in gauge's logs.
+
+// SecretResolver resolves property values held in an external secret store.
+// Register custom resolvers with RegisterSecretResolver.
+type SecretResolver interface {
+	// Scheme is the value prefix this resolver handles, without the colon.
+	Scheme() string
+	// Resolve fetches the secret for a reference with the scheme stripped,
+	// e.g. "secret/ci#password" for vault:secret/ci#password.
+	Resolve(ref string) (string, error)
+}
+
+var secretResolversMutex sync.Mutex
+var secretResolvers = make(map[string]SecretResolver)
+
+func init() {
+	RegisterSecretResolver(vaultResolver{})
+	RegisterSecretResolver(ssmResolver{})
+}
+
+// RegisterSecretResolver registers a resolver for its scheme, replacing any
+// resolver previously registered for the same scheme.
+func RegisterSecretResolver(r SecretResolver) {
+	secretResolversMutex.Lock()
+	defer secretResolversMutex.Unlock()
+	secretResolvers[r.Scheme()] = r
+}
+
+// ResolveSecret resolves a property value when its scheme matches a
+// registered secret resolver. It reports whether the value was a secret
+// reference; values with no matching scheme are returned unchanged.
+func ResolveSecret(value string) (string, bool, error) {
+	trimmed := strings.TrimSpace(value)
+	idx := strings.Index(trimmed, ":")
+	if idx <= 0 {
+		return value, false, nil
+	}
+	secretResolversMutex.Lock()
+	resolver, ok := secretResolvers[trimmed[:idx]]
+	secretResolversMutex.Unlock()
+	if !ok {
+		return value, false, nil
+	}
+	resolved, err := resolver.Resolve(trimmed[idx+1:])
+	if err != nil {
+		return "", true, fmt.Errorf("Failed to resolve secret '%s'. %s", trimmed, err.Error())
+	}
+	return resolved, true, nil
+}
+
+// vaultResolver reads secrets from HashiCorp Vault over its HTTP API, using
+// the address and token in VAULT_ADDR and VAULT_TOKEN. The reference is the
+// secret path followed by # and the field name, e.g. secret/ci#password.
+// Both KV version 1 and 2 response shapes are understood.
+type vaultResolver struct{}
+
+func (vaultResolver) Scheme() string { return "vault" }
+
+func (vaultResolver) Resolve(ref string) (string, error) {
+	parts := strings.SplitN(ref, "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("expected a reference like vault:secret/ci#password")
+	}
+	path, field := parts[0], parts[1]
+	addr, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		return "", fmt.Errorf("VAULT_ADDR and VAULT_TOKEN must be set")
+	}
+	req, err := http.NewRequest("GET", strings.TrimSuffix(addr, "/")+"/v1/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned %s for %s", resp.Status, path)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	var payload struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("vault returned an unexpected response for %s", path)
+	}
+	data := payload.Data
+	// KV version 2 nests the fields one level deeper under data.
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		data = nested
+	}
+	value, ok := data[field].(string)
+	if !ok {
+		return "", fmt.Errorf("field '%s' not found at %s", field, path)
+	}
+	return value, nil
+}
+
+// ssmResolver reads secrets from AWS SSM Parameter Store by shelling out to
+// the AWS CLI, avoiding an SDK dependency. The reference is the parameter
+// name, e.g. ssm:/ci/gauge/api-token; credentials and region come from the
+// CLI's own configuration.
+type ssmResolver struct{}
+
+func (ssmResolver) Scheme() string { return "ssm" }
+
+func (ssmResolver) Resolve(ref string) (string, error) {
+	if strings.TrimSpace(ref) == "" {
+		return "", fmt.Errorf("expected a reference like ssm:/ci/gauge/api-token")
+	}
+	cmd := ssmCommand(ref)
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("aws ssm get-parameter failed: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("aws ssm get-parameter failed: %s", err.Error())
+	}
+	return strings.TrimRight(string(out), "\r\n"), nil
+}
+
+var ssmCommand = func(name string) *exec.Cmd {
+	return exec.Command("aws", "ssm", "get-parameter", "--name", name, "--with-decryption", "--query", "Parameter.Value", "--output", "text")
+}
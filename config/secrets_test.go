@@ -0,0 +1,137 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+type stubResolver struct {
+	scheme string
+	value  string
+	err    error
+}
+
+func (r stubResolver) Scheme() string { return r.scheme }
+
+func (r stubResolver) Resolve(ref string) (string, error) { return r.value, r.err }
+
+func TestResolveSecretLeavesUnknownSchemesAlone(t *testing.T) {
+	for _, value := range []string{"plaintext", "https://example.com", "1-3,7", ""} {
+		resolved, isSecret, err := ResolveSecret(value)
+		if err != nil {
+			t.Errorf("ResolveSecret(%q) returned error: %s", value, err.Error())
+		}
+		if isSecret {
+			t.Errorf("ResolveSecret(%q) reported a secret reference", value)
+		}
+		if resolved != value {
+			t.Errorf("ResolveSecret(%q) = %q, want the value unchanged", value, resolved)
+		}
+	}
+}
+
+func TestResolveSecretUsesRegisteredResolver(t *testing.T) {
+	RegisterSecretResolver(stubResolver{scheme: "stub", value: "resolved"})
+
+	resolved, isSecret, err := ResolveSecret("stub:some/ref")
+	if err != nil {
+		t.Fatalf("ResolveSecret returned error: %s", err.Error())
+	}
+	if !isSecret || resolved != "resolved" {
+		t.Errorf("ResolveSecret = %q, isSecret %v; want resolved, true", resolved, isSecret)
+	}
+}
+
+func TestResolveSecretReportsResolverFailure(t *testing.T) {
+	RegisterSecretResolver(stubResolver{scheme: "stub", err: fmt.Errorf("store unreachable")})
+
+	_, isSecret, err := ResolveSecret("stub:some/ref")
+	if !isSecret || err == nil {
+		t.Fatalf("ResolveSecret should fail for a failing resolver, got isSecret %v, err %v", isSecret, err)
+	}
+	want := "Failed to resolve secret 'stub:some/ref'. store unreachable"
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestVaultResolverReadsFieldFromKVResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/ci" {
+			t.Errorf("vault request path = %s, want /v1/secret/ci", r.URL.Path)
+		}
+		if r.Header.Get("X-Vault-Token") != "token" {
+			t.Errorf("vault token header = %s, want token", r.Header.Get("X-Vault-Token"))
+		}
+		fmt.Fprint(w, `{"data":{"password":"s3cret"}}`)
+	}))
+	defer server.Close()
+	os.Setenv("VAULT_ADDR", server.URL)
+	os.Setenv("VAULT_TOKEN", "token")
+	defer os.Unsetenv("VAULT_ADDR")
+	defer os.Unsetenv("VAULT_TOKEN")
+
+	value, err := vaultResolver{}.Resolve("secret/ci#password")
+	if err != nil {
+		t.Fatalf("Resolve returned error: %s", err.Error())
+	}
+	if value != "s3cret" {
+		t.Errorf("Resolve = %q, want s3cret", value)
+	}
+}
+
+func TestVaultResolverReadsFieldFromKVV2Response(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"data":{"password":"s3cret"},"metadata":{"version":2}}}`)
+	}))
+	defer server.Close()
+	os.Setenv("VAULT_ADDR", server.URL)
+	os.Setenv("VAULT_TOKEN", "token")
+	defer os.Unsetenv("VAULT_ADDR")
+	defer os.Unsetenv("VAULT_TOKEN")
+
+	value, err := vaultResolver{}.Resolve("secret/data/ci#password")
+	if err != nil {
+		t.Fatalf("Resolve returned error: %s", err.Error())
+	}
+	if value != "s3cret" {
+		t.Errorf("Resolve = %q, want s3cret", value)
+	}
+}
+
+func TestVaultResolverRejectsMalformedReference(t *testing.T) {
+	if _, err := (vaultResolver{}).Resolve("secret/ci"); err == nil {
+		t.Error("Resolve should fail for a reference without a field")
+	}
+}
+
+func TestSSMResolverUsesCLIOutput(t *testing.T) {
+	old := ssmCommand
+	var requested string
+	ssmCommand = func(name string) *exec.Cmd {
+		requested = name
+		return exec.Command("echo", "s3cret")
+	}
+	defer func() { ssmCommand = old }()
+
+	value, err := ssmResolver{}.Resolve("/ci/gauge/api-token")
+	if err != nil {
+		t.Fatalf("Resolve returned error: %s", err.Error())
+	}
+	if value != "s3cret" {
+		t.Errorf("Resolve = %q, want s3cret", value)
+	}
+	if requested != "/ci/gauge/api-token" {
+		t.Errorf("requested parameter = %q, want /ci/gauge/api-token", requested)
+	}
+}
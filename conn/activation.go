@@ -0,0 +1,83 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package conn
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// Pre-bound listener FDs follow the systemd socket activation protocol:
+// LISTEN_PID holds the pid the FDs are meant for, LISTEN_FDS the number of
+// FDs passed, and the FDs themselves start at 3. A parent orchestrator (or
+// systemd itself) can thus bind the API and runner ports before gauge starts,
+// making port management in containers deterministic. Each FD is handed out
+// once, in the order the listeners are requested.
+const listenFdsStart = 3
+
+type activatedListeners struct {
+	mutex   sync.Mutex
+	checked bool
+	fds     int
+	next    int
+}
+
+var activation = &activatedListeners{}
+
+func (a *activatedListeners) take() *net.TCPListener {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if !a.checked {
+		a.checked = true
+		a.fds = listenFds()
+	}
+	for a.next < a.fds {
+		fd := listenFdsStart + a.next
+		a.next++
+		file := os.NewFile(uintptr(fd), "LISTEN_FD_"+strconv.Itoa(fd))
+		if file == nil {
+			continue
+		}
+		listener, err := net.FileListener(file)
+		file.Close()
+		if err != nil {
+			logger.Warningf(true, "Unable to use pre-bound listener FD %d: %s", fd, err.Error())
+			continue
+		}
+		tcpListener, ok := listener.(*net.TCPListener)
+		if !ok {
+			logger.Warningf(true, "Pre-bound listener FD %d is not a TCP listener, ignoring it", fd)
+			listener.Close()
+			continue
+		}
+		logger.Debugf(true, "Using pre-bound listener FD %d at %s", fd, tcpListener.Addr())
+		return tcpListener
+	}
+	return nil
+}
+
+func listenFds() int {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return 0
+	}
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds < 1 {
+		return 0
+	}
+	return fds
+}
+
+// nextActivatedListener gives the next unused pre-bound listener FD, or nil
+// when none were passed to this process.
+func nextActivatedListener() *net.TCPListener {
+	return activation.take()
+}
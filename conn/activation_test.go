@@ -0,0 +1,44 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package conn
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestListenFdsIgnoresFdsMeantForAnotherProcess(t *testing.T) {
+	os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()+1))
+	os.Setenv("LISTEN_FDS", "2")
+	defer os.Unsetenv("LISTEN_PID")
+	defer os.Unsetenv("LISTEN_FDS")
+
+	if got := listenFds(); got != 0 {
+		t.Errorf("Expected 0 listen fds for another pid, got %d", got)
+	}
+}
+
+func TestListenFdsForCurrentProcess(t *testing.T) {
+	os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+	os.Setenv("LISTEN_FDS", "2")
+	defer os.Unsetenv("LISTEN_PID")
+	defer os.Unsetenv("LISTEN_FDS")
+
+	if got := listenFds(); got != 2 {
+		t.Errorf("Expected 2 listen fds, got %d", got)
+	}
+}
+
+func TestListenFdsWithoutActivationEnv(t *testing.T) {
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+
+	if got := listenFds(); got != 0 {
+		t.Errorf("Expected 0 listen fds without activation env, got %d", got)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
 
 	"github.com/golang/protobuf/proto"
@@ -22,11 +23,22 @@ type messageHandler interface {
 }
 
 type GaugeConnectionHandler struct {
-	tcpListener    *net.TCPListener
+	tcpListener    net.Listener
 	messageHandler messageHandler
+	authToken      string
 }
 
 func NewGaugeConnectionHandler(port int, messageHandler messageHandler) (*GaugeConnectionHandler, error) {
+	if listener := nextActivatedListener(); listener != nil {
+		return &GaugeConnectionHandler{tcpListener: listener, messageHandler: messageHandler}, nil
+	}
+	if start, end, ok := config.PortRange(); ok && port == 0 {
+		listener, err := listenInPortRange(start, end)
+		if err != nil {
+			return nil, err
+		}
+		return &GaugeConnectionHandler{tcpListener: listener, messageHandler: messageHandler}, nil
+	}
 	// port = 0 means GO will find a unused port
 	address, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("127.0.0.1:%d", port))
 	if err != nil {
@@ -40,6 +52,22 @@ func NewGaugeConnectionHandler(port int, messageHandler messageHandler) (*GaugeC
 	return &GaugeConnectionHandler{tcpListener: listener, messageHandler: messageHandler}, nil
 }
 
+// listenInPortRange binds to the first free port in the configured
+// gauge_port_range, so all dynamically allocated listeners stay within the
+// ports a restrictive container or firewalled CI agent allows.
+func listenInPortRange(start, end int) (*net.TCPListener, error) {
+	for port := start; port <= end; port++ {
+		address, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+		if err != nil {
+			return nil, err
+		}
+		if listener, err := net.ListenTCP("tcp", address); err == nil {
+			return listener, nil
+		}
+	}
+	return nil, fmt.Errorf("no free port in the configured gauge_port_range %d-%d", start, end)
+}
+
 func (connectionHandler *GaugeConnectionHandler) AcceptConnection(connectionTimeOut time.Duration, errChannel chan error) (net.Conn, error) {
 	connectionChannel := make(chan net.Conn)
 
@@ -92,6 +120,9 @@ func (connectionHandler *GaugeConnectionHandler) acceptConnectionWithoutTimeout(
 }
 
 func (connectionHandler *GaugeConnectionHandler) handleConnectionMessages(conn net.Conn) {
+	if !connectionHandler.authenticate(conn) {
+		return
+	}
 	buffer := new(bytes.Buffer)
 	data := make([]byte, 8192)
 	for {
@@ -0,0 +1,72 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package conn
+
+import (
+	"net"
+	"os"
+	"testing"
+)
+
+func TestListenInPortRangeBindsWithinTheRange(t *testing.T) {
+	listener, err := listenInPortRange(47500, 47520)
+	if err != nil {
+		t.Fatalf("listenInPortRange failed: %s", err.Error())
+	}
+	defer listener.Close()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	if port < 47500 || port > 47520 {
+		t.Errorf("port = %d, want one within 47500-47520", port)
+	}
+}
+
+func TestListenInPortRangeSkipsBusyPorts(t *testing.T) {
+	first, err := listenInPortRange(47530, 47531)
+	if err != nil {
+		t.Fatalf("listenInPortRange failed: %s", err.Error())
+	}
+	defer first.Close()
+
+	second, err := listenInPortRange(47530, 47531)
+	if err != nil {
+		t.Fatalf("listenInPortRange failed: %s", err.Error())
+	}
+	defer second.Close()
+
+	if first.Addr().(*net.TCPAddr).Port == second.Addr().(*net.TCPAddr).Port {
+		t.Errorf("Expected the second listener to skip the busy port")
+	}
+}
+
+func TestListenInPortRangeFailsWhenRangeIsExhausted(t *testing.T) {
+	listener, err := listenInPortRange(47540, 47540)
+	if err != nil {
+		t.Fatalf("listenInPortRange failed: %s", err.Error())
+	}
+	defer listener.Close()
+
+	if _, err := listenInPortRange(47540, 47540); err == nil {
+		t.Errorf("Expected an error when no port in the range is free")
+	}
+}
+
+func TestNewGaugeConnectionHandlerHonoursPortRange(t *testing.T) {
+	os.Setenv("gauge_port_range", "47550-47560")
+	defer os.Unsetenv("gauge_port_range")
+
+	handler, err := NewGaugeConnectionHandler(0, nil)
+	if err != nil {
+		t.Fatalf("NewGaugeConnectionHandler failed: %s", err.Error())
+	}
+	defer handler.tcpListener.Close()
+
+	port := handler.ConnectionPortNumber()
+	if port < 47550 || port > 47560 {
+		t.Errorf("port = %d, want one within 47550-47560", port)
+	}
+}
@@ -0,0 +1,46 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package conn
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+)
+
+// AttemptWithRetry runs connect until it succeeds, retrying up to the configured
+// number of attempts with the configured interval plus a random jitter between
+// attempts. Transient port/timing failures on loaded machines thus get another
+// chance instead of failing the run. The error of the last attempt is returned.
+func AttemptWithRetry(description string, connect func() error) error {
+	return attemptWithRetry(description, config.ConnectionRetryAttempts(), config.ConnectionRetryInterval(), config.ConnectionRetryJitter(), connect)
+}
+
+func attemptWithRetry(description string, attempts int, interval, jitter time.Duration, connect func() error) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = connect()
+		if err == nil {
+			return nil
+		}
+		if attempt == attempts {
+			break
+		}
+		wait := interval
+		if jitter > 0 {
+			wait += time.Duration(rand.Int63n(int64(jitter)))
+		}
+		logger.Debugf(true, "Attempt %d to connect to %s failed: %s. Retrying in %s.", attempt, description, err.Error(), wait)
+		time.Sleep(wait)
+	}
+	return err
+}
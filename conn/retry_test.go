@@ -0,0 +1,60 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package conn
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAttemptWithRetryStopsAfterSuccess(t *testing.T) {
+	calls := 0
+	err := attemptWithRetry("test", 3, 0, 0, func() error {
+		calls++
+		if calls < 2 {
+			return fmt.Errorf("transient failure")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Expected success after retry, got error: %s", err.Error())
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestAttemptWithRetryReturnsLastError(t *testing.T) {
+	calls := 0
+	err := attemptWithRetry("test", 3, 0, 0, func() error {
+		calls++
+		return fmt.Errorf("failure %d", calls)
+	})
+
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+	if err == nil || err.Error() != "failure 3" {
+		t.Errorf("Expected last error to be returned, got %v", err)
+	}
+}
+
+func TestAttemptWithRetryTreatsNonPositiveAttemptsAsOne(t *testing.T) {
+	calls := 0
+	err := attemptWithRetry("test", 0, 0, 0, func() error {
+		calls++
+		return fmt.Errorf("failure")
+	})
+
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+	if err == nil {
+		t.Errorf("Expected error, got none")
+	}
+}
@@ -0,0 +1,100 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package conn
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+)
+
+// authHandshakeTimeout bounds how long a client gets to present the API auth
+// token, so unauthenticated connections cannot hold the listener hostage.
+const authHandshakeTimeout = 10 * time.Second
+
+// maxAuthTokenLength caps the handshake read; tokens are short shared
+// secrets, anything longer is a client speaking the wrong protocol.
+const maxAuthTokenLength = 512
+
+// NewSecureGaugeConnectionHandler is NewGaugeConnectionHandler for the
+// daemon API port: it serves TLS when api_tls_cert and api_tls_key are
+// configured and requires clients to send the api_auth_token followed by a
+// newline before any of their messages are processed, so the spec-editing
+// API is not an unauthenticated plaintext socket on shared machines.
+func NewSecureGaugeConnectionHandler(port int, messageHandler messageHandler) (*GaugeConnectionHandler, error) {
+	handler, err := NewGaugeConnectionHandler(port, messageHandler)
+	if err != nil {
+		return nil, err
+	}
+	certFile, keyFile := config.APITLSCert(), config.APITLSKey()
+	if certFile != "" && keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			if e := handler.tcpListener.Close(); e != nil {
+				logger.Debugf(false, "Unable to close listener: %s", e.Error())
+			}
+			return nil, fmt.Errorf("Unable to load the API TLS certificate. %s", err.Error())
+		}
+		handler.tcpListener = tls.NewListener(handler.tcpListener, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+		logger.Debugf(true, "API connections are TLS encrypted.")
+	}
+	handler.authToken = config.APIAuthToken()
+	return handler, nil
+}
+
+// authenticate performs the shared-token handshake on a fresh connection:
+// the client's first bytes must be the api_auth_token terminated by a
+// newline. Without a configured token every connection is accepted.
+func (connectionHandler *GaugeConnectionHandler) authenticate(conn net.Conn) bool {
+	if connectionHandler.authToken == "" {
+		return true
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(authHandshakeTimeout)); err != nil {
+		logger.Debugf(false, "Unable to set handshake deadline: %s", err.Error())
+	}
+	defer func() {
+		if err := conn.SetReadDeadline(time.Time{}); err != nil {
+			logger.Debugf(false, "Unable to clear handshake deadline: %s", err.Error())
+		}
+	}()
+	var token []byte
+	buffer := make([]byte, 1)
+	for {
+		if _, err := conn.Read(buffer); err != nil {
+			logger.Warningf(false, "Closing connection [%s], no auth token received: %s", conn.RemoteAddr(), err.Error())
+			closeAuthFailedConnection(conn)
+			return false
+		}
+		if buffer[0] == '\n' {
+			break
+		}
+		token = append(token, buffer[0])
+		if len(token) > maxAuthTokenLength {
+			logger.Warningf(false, "Closing connection [%s], auth token too long", conn.RemoteAddr())
+			closeAuthFailedConnection(conn)
+			return false
+		}
+	}
+	if subtle.ConstantTimeCompare(bytes.TrimSpace(token), []byte(connectionHandler.authToken)) != 1 {
+		logger.Warningf(false, "Closing connection [%s], auth token mismatch", conn.RemoteAddr())
+		closeAuthFailedConnection(conn)
+		return false
+	}
+	return true
+}
+
+func closeAuthFailedConnection(conn net.Conn) {
+	if err := conn.Close(); err != nil {
+		logger.Debugf(false, "Connection already closed, %s", err.Error())
+	}
+}
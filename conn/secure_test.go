@@ -0,0 +1,72 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package conn
+
+import (
+	"net"
+	"os"
+	"testing"
+)
+
+func authenticateResult(t *testing.T, token, clientSends string) bool {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	handler := &GaugeConnectionHandler{authToken: token}
+	resultChan := make(chan bool)
+	go func() { resultChan <- handler.authenticate(server) }()
+	if clientSends != "" {
+		if _, err := client.Write([]byte(clientSends)); err != nil {
+			t.Fatalf("client write failed: %s", err.Error())
+		}
+	}
+	return <-resultChan
+}
+
+func TestAuthenticateWithoutTokenAcceptsConnection(t *testing.T) {
+	if !authenticateResult(t, "", "") {
+		t.Errorf("Expected connections to be accepted when no token is configured")
+	}
+}
+
+func TestAuthenticateAcceptsMatchingToken(t *testing.T) {
+	if !authenticateResult(t, "secret", "secret\n") {
+		t.Errorf("Expected the matching token to be accepted")
+	}
+}
+
+func TestAuthenticateRejectsWrongToken(t *testing.T) {
+	if authenticateResult(t, "secret", "guess\n") {
+		t.Errorf("Expected a wrong token to be rejected")
+	}
+}
+
+func TestNewSecureGaugeConnectionHandlerFailsOnUnreadableCertificate(t *testing.T) {
+	os.Setenv("api_tls_cert", "no-such-cert.pem")
+	os.Setenv("api_tls_key", "no-such-key.pem")
+	defer os.Unsetenv("api_tls_cert")
+	defer os.Unsetenv("api_tls_key")
+
+	if _, err := NewSecureGaugeConnectionHandler(0, nil); err == nil {
+		t.Errorf("Expected an error when the TLS certificate cannot be loaded")
+	}
+}
+
+func TestNewSecureGaugeConnectionHandlerPicksUpAuthToken(t *testing.T) {
+	os.Setenv("api_auth_token", "secret")
+	defer os.Unsetenv("api_auth_token")
+
+	handler, err := NewSecureGaugeConnectionHandler(0, nil)
+	if err != nil {
+		t.Fatalf("NewSecureGaugeConnectionHandler failed: %s", err.Error())
+	}
+	defer handler.tcpListener.Close()
+
+	if handler.authToken != "secret" {
+		t.Errorf("authToken = %s, want secret", handler.authToken)
+	}
+}
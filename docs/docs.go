@@ -0,0 +1,91 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*Package docs renders the project's specifications to static HTML or GitHub
+  flavored Markdown for documentation portals. Concepts are resolved inline
+  and tags are displayed; no execution run or documentation plugin is needed.
+*/
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// The built-in output formats.
+const (
+	HTMLFormat     = "html"
+	MarkdownFormat = "markdown"
+)
+
+// Export renders every spec in specDirs to outDir, one file per spec with
+// the spec file's name and the format's extension. It returns an error when
+// the format is unknown, parsing fails or files cannot be written.
+func Export(specDirs []string, format, outDir string) error {
+	var render func(spec *gauge.Specification) string
+	var extension string
+	switch format {
+	case HTMLFormat:
+		render, extension = renderHTML, ".html"
+	case MarkdownFormat:
+		render, extension = renderMarkdown, ".md"
+	default:
+		return fmt.Errorf("unknown docs format %q, supported formats are %s and %s", format, HTMLFormat, MarkdownFormat)
+	}
+	specs, err := parseSpecs(specDirs)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(outDir, common.NewDirectoryPermissions); err != nil {
+		return fmt.Errorf("failed to create %s. %s", outDir, err.Error())
+	}
+	for _, spec := range specs {
+		name := strings.TrimSuffix(filepath.Base(spec.FileName), filepath.Ext(spec.FileName)) + extension
+		outFile := filepath.Join(outDir, name)
+		if err := common.SaveFile(outFile, render(spec), false); err != nil {
+			return fmt.Errorf("failed to write %s. %s", outFile, err.Error())
+		}
+		logger.Debugf(true, "Rendered %s to %s", util.RelPathToProjectRoot(spec.FileName), outFile)
+	}
+	logger.Infof(true, "Rendered %d spec(s) to %s.", len(specs), outDir)
+	return nil
+}
+
+func parseSpecs(specDirs []string) ([]*gauge.Specification, error) {
+	conceptDict, _, err := parser.ParseConcepts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse concepts. %s", err.Error())
+	}
+	buildErrors := gauge.NewBuildErrors()
+	specs, _ := parser.ParseSpecs(specDirs, conceptDict, buildErrors)
+	if len(buildErrors.SpecErrs) > 0 {
+		var messages []string
+		for _, errs := range buildErrors.SpecErrs {
+			for _, e := range errs {
+				messages = append(messages, e.Error())
+			}
+		}
+		sort.Strings(messages)
+		return nil, fmt.Errorf("failed to parse specs:\n%s", strings.Join(messages, "\n"))
+	}
+	return specs, nil
+}
+
+func tagValues(tags *gauge.Tags) []string {
+	if tags == nil {
+		return nil
+	}
+	return tags.Values()
+}
@@ -0,0 +1,88 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package docs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func exampleSpec() *gauge.Specification {
+	concept := &gauge.Step{
+		LineText:  "Log in as admin",
+		IsConcept: true,
+		ConceptSteps: []*gauge.Step{
+			{LineText: "Open the login page"},
+			{LineText: "Submit admin credentials"},
+		},
+	}
+	return &gauge.Specification{
+		FileName: "specs/login.spec",
+		Heading:  &gauge.Heading{Value: "Login"},
+		Tags:     &gauge.Tags{RawValues: [][]string{{"auth", "smoke"}}},
+		Scenarios: []*gauge.Scenario{
+			{
+				Heading: &gauge.Heading{Value: "Successful login"},
+				Steps:   []*gauge.Step{concept, {LineText: "See the dashboard"}},
+			},
+		},
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	got := renderMarkdown(exampleSpec())
+
+	for _, want := range []string{
+		"# Login",
+		"> Tags: auth, smoke",
+		"## Successful login",
+		"- Log in as admin",
+		"  - Open the login page",
+		"- See the dashboard",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected rendered markdown to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderMarkdownEscapesTableCells(t *testing.T) {
+	spec := exampleSpec()
+	table := gauge.NewTable([]string{"expr"}, [][]gauge.TableCell{{{Value: "a|b", CellType: gauge.Static}}}, 3)
+	spec.DataTable.Table = *table
+
+	got := renderMarkdown(spec)
+
+	if !strings.Contains(got, `| a\|b |`) {
+		t.Errorf("Expected pipe in cell to be escaped, got:\n%s", got)
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	got := renderHTML(exampleSpec())
+
+	for _, want := range []string{
+		"<title>Login</title>",
+		`<span class="tag">auth</span>`,
+		"<h2>Successful login</h2>",
+		"Open the login page",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected rendered HTML to contain %q", want)
+		}
+	}
+}
+
+func TestExportRejectsUnknownFormat(t *testing.T) {
+	err := Export([]string{"specs"}, "pdf", "out")
+
+	if err == nil || !strings.Contains(err.Error(), `unknown docs format "pdf"`) {
+		t.Errorf("Expected unknown format error, got %v", err)
+	}
+}
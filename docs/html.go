@@ -0,0 +1,126 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package docs
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+type specView struct {
+	Heading   string
+	Tags      []string
+	Table     *tableView
+	Contexts  []*stepView
+	Scenarios []*scenarioView
+	Teardown  []*stepView
+}
+
+type scenarioView struct {
+	Heading string
+	Tags    []string
+	Table   *tableView
+	Steps   []*stepView
+}
+
+type stepView struct {
+	Text         string
+	ConceptSteps []*stepView
+}
+
+type tableView struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// renderHTML renders a spec as a standalone HTML page.
+func renderHTML(spec *gauge.Specification) string {
+	var b bytes.Buffer
+	if err := docsTemplate.Execute(&b, newSpecView(spec)); err != nil {
+		logger.Warningf(true, "Failed to render %s: %s", spec.FileName, err.Error())
+	}
+	return b.String()
+}
+
+func newSpecView(spec *gauge.Specification) *specView {
+	view := &specView{
+		Heading:  spec.Heading.Value,
+		Tags:     tagValues(spec.Tags),
+		Table:    newTableView(&spec.DataTable.Table),
+		Contexts: newStepViews(spec.Contexts),
+		Teardown: newStepViews(spec.TearDownSteps),
+	}
+	for _, scn := range spec.Scenarios {
+		view.Scenarios = append(view.Scenarios, &scenarioView{
+			Heading: scn.Heading.Value,
+			Tags:    tagValues(scn.Tags),
+			Table:   newTableView(&scn.DataTable.Table),
+			Steps:   newStepViews(scn.Steps),
+		})
+	}
+	return view
+}
+
+func newStepViews(steps []*gauge.Step) []*stepView {
+	views := make([]*stepView, 0, len(steps))
+	for _, step := range steps {
+		view := &stepView{Text: step.GetLineText()}
+		if step.IsConcept {
+			view.ConceptSteps = newStepViews(step.ConceptSteps)
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+func newTableView(table *gauge.Table) *tableView {
+	if table == nil || !table.IsInitialized() {
+		return nil
+	}
+	return &tableView{Headers: table.Headers, Rows: table.Rows()}
+}
+
+var docsTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8"/>
+<title>{{.Heading}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Roboto, sans-serif; margin: 2em auto; max-width: 60em; color: #333; }
+h1 { border-bottom: 2px solid #eee; padding-bottom: 0.2em; }
+h2 { margin-bottom: 0.2em; }
+.tag { background: #e8f0fe; color: #1a56a8; border-radius: 1em; padding: 0.1em 0.8em; font-size: 0.8em; margin-right: 0.3em; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+th, td { border: 1px solid #ddd; padding: 0.3em 0.8em; text-align: left; }
+th { background: #f5f5f5; }
+ul.steps { list-style: none; padding-left: 1em; }
+ul.steps > li { padding: 0.15em 0; }
+ul.steps > li:before { content: "\25B8"; color: #bbb; margin-right: 0.5em; }
+details { margin-left: 1em; }
+summary { cursor: pointer; color: #666; font-size: 0.85em; }
+.section { color: #666; font-size: 0.85em; text-transform: uppercase; letter-spacing: 0.05em; margin-top: 1em; }
+</style>
+</head>
+<body>
+<h1>{{.Heading}}</h1>
+<div>{{range .Tags}}<span class="tag">{{.}}</span>{{end}}</div>
+{{template "table" .Table}}
+{{if .Contexts}}<div class="section">Context</div>{{template "steps" .Contexts}}{{end}}
+{{range .Scenarios}}
+<h2>{{.Heading}}</h2>
+<div>{{range .Tags}}<span class="tag">{{.}}</span>{{end}}</div>
+{{template "table" .Table}}
+{{template "steps" .Steps}}
+{{end}}
+{{if .Teardown}}<div class="section">Teardown</div>{{template "steps" .Teardown}}{{end}}
+</body>
+</html>
+{{define "table"}}{{if .}}<table><tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>{{end}}</table>{{end}}{{end}}
+{{define "steps"}}<ul class="steps">{{range .}}<li>{{.Text}}{{if .ConceptSteps}}<details><summary>concept</summary>{{template "steps" .ConceptSteps}}</details>{{end}}</li>{{end}}</ul>{{end}}`))
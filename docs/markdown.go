@@ -0,0 +1,84 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+// renderMarkdown renders a spec as GitHub flavored Markdown. Concept steps
+// become list items with their resolved steps as a nested list.
+func renderMarkdown(spec *gauge.Specification) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# %s\n\n", spec.Heading.Value)
+	writeMarkdownTags(&b, spec.Tags)
+	writeMarkdownTable(&b, &spec.DataTable.Table)
+	if len(spec.Contexts) > 0 {
+		b.WriteString("**Context**\n\n")
+		writeMarkdownSteps(&b, spec.Contexts, 0)
+		b.WriteString("\n")
+	}
+	for _, scenario := range spec.Scenarios {
+		fmt.Fprintf(&b, "## %s\n\n", scenario.Heading.Value)
+		writeMarkdownTags(&b, scenario.Tags)
+		writeMarkdownTable(&b, &scenario.DataTable.Table)
+		writeMarkdownSteps(&b, scenario.Steps, 0)
+		b.WriteString("\n")
+	}
+	if len(spec.TearDownSteps) > 0 {
+		b.WriteString("**Teardown**\n\n")
+		writeMarkdownSteps(&b, spec.TearDownSteps, 0)
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+func writeMarkdownTags(b *bytes.Buffer, tags *gauge.Tags) {
+	values := tagValues(tags)
+	if len(values) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "> Tags: %s\n\n", strings.Join(values, ", "))
+}
+
+func writeMarkdownSteps(b *bytes.Buffer, steps []*gauge.Step, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for _, step := range steps {
+		fmt.Fprintf(b, "%s- %s\n", indent, step.GetLineText())
+		if step.IsConcept {
+			writeMarkdownSteps(b, step.ConceptSteps, depth+1)
+		}
+	}
+}
+
+func writeMarkdownTable(b *bytes.Buffer, table *gauge.Table) {
+	if table == nil || !table.IsInitialized() {
+		return
+	}
+	writeMarkdownRow(b, table.Headers)
+	separator := make([]string, len(table.Headers))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeMarkdownRow(b, separator)
+	for _, row := range table.Rows() {
+		writeMarkdownRow(b, row)
+	}
+	b.WriteString("\n")
+}
+
+func writeMarkdownRow(b *bytes.Buffer, cells []string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.Replace(cell, "|", "\\|", -1)
+	}
+	fmt.Fprintf(b, "| %s |\n", strings.Join(escaped, " | "))
+}
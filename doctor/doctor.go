@@ -0,0 +1,229 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package doctor runs environment diagnostics for a Gauge project: project
+// structure, manifest validity, runner and plugin health, port availability
+// and config sanity, printing an actionable fix for everything it flags.
+package doctor
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/plugin/install"
+	"github.com/getgauge/gauge/version"
+)
+
+// problem describes one failed diagnostic along with how to fix it.
+type problem struct {
+	message string
+	fix     string
+}
+
+type check struct {
+	name string
+	run  func() []problem
+}
+
+var checks = []check{
+	{"Project structure", checkProjectStructure},
+	{"Manifest", checkManifest},
+	{"Language runner", checkRunner},
+	{"Plugins", checkPlugins},
+	{"Port availability", checkPorts},
+	{"Configuration", checkConfiguration},
+}
+
+// RunDiagnostics runs every diagnostic check and prints the outcome with
+// actionable fixes. It returns 0 when all checks pass, 1 otherwise.
+func RunDiagnostics() int {
+	exitCode := 0
+	for _, c := range checks {
+		problems := c.run()
+		if len(problems) == 0 {
+			logger.Infof(true, "[ OK ] %s", c.name)
+			continue
+		}
+		exitCode = 1
+		logger.Infof(true, "[FAIL] %s", c.name)
+		for _, p := range problems {
+			logger.Infof(true, "       %s", p.message)
+			if p.fix != "" {
+				logger.Infof(true, "       Fix: %s", p.fix)
+			}
+		}
+	}
+	return exitCode
+}
+
+func checkProjectStructure() []problem {
+	var problems []problem
+	if config.ProjectRoot == "" {
+		return append(problems, problem{
+			message: "Not in a Gauge project directory.",
+			fix:     "Run this command inside a Gauge project, or create one with `gauge init <template>`.",
+		})
+	}
+	if !common.FileExists(filepath.Join(config.ProjectRoot, common.ManifestFile)) {
+		problems = append(problems, problem{
+			message: fmt.Sprintf("%s not found in %s.", common.ManifestFile, config.ProjectRoot),
+			fix:     "Re-create the project with `gauge init <template>` or restore manifest.json.",
+		})
+	}
+	if !common.DirExists(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName)) {
+		problems = append(problems, problem{
+			message: "No specs directory found in the project.",
+			fix:     "Create a `specs` directory, or pass spec locations as arguments when running.",
+		})
+	}
+	return problems
+}
+
+func checkManifest() []problem {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return []problem{{
+			message: fmt.Sprintf("Unable to read manifest.json: %s", err.Error()),
+			fix:     "Fix the JSON syntax in manifest.json.",
+		}}
+	}
+	var problems []problem
+	if m.Language == "" {
+		problems = append(problems, problem{
+			message: "No language set in manifest.json.",
+			fix:     `Set the "Language" field in manifest.json, e.g. "java".`,
+		})
+	}
+	for pluginID, constraintText := range m.PluginConstraints {
+		if _, err := version.ParseConstraint(constraintText); err != nil {
+			problems = append(problems, problem{
+				message: fmt.Sprintf("Invalid version constraint %q for plugin %s.", constraintText, pluginID),
+				fix:     `Use a range like ">=4.0 <5" in PluginConstraints.`,
+			})
+		}
+	}
+	return problems
+}
+
+func checkRunner() []problem {
+	m, err := manifest.ProjectManifest()
+	if err != nil || m.Language == "" {
+		return nil // already reported by the manifest check
+	}
+	if _, err := plugin.GetLanguageJSONFilePath(m.Language); err != nil {
+		return []problem{{
+			message: fmt.Sprintf("Language runner %s is not installed.", m.Language),
+			fix:     fmt.Sprintf("Run `gauge install %s`.", m.Language),
+		}}
+	}
+	if !install.IsCompatiblePluginInstalled(m.Language, true) {
+		return []problem{{
+			message: fmt.Sprintf("Installed %s runner is not compatible with Gauge %s.", m.Language, version.FullVersion()),
+			fix:     fmt.Sprintf("Run `gauge update %s` to install a compatible version.", m.Language),
+		}}
+	}
+	return nil
+}
+
+func checkPlugins() []problem {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return nil // already reported by the manifest check
+	}
+	var problems []problem
+	for _, pluginID := range m.Plugins {
+		pluginVersion, err := plugin.ResolvePluginVersion(m, pluginID)
+		if err != nil {
+			problems = append(problems, problem{
+				message: err.Error(),
+				fix:     fmt.Sprintf("Run `gauge install %s`.", pluginID),
+			})
+			continue
+		}
+		pd, err := plugin.GetPluginDescriptor(pluginID, pluginVersion)
+		if err != nil {
+			problems = append(problems, problem{
+				message: fmt.Sprintf("Plugin %s is not installed.", pluginID),
+				fix:     fmt.Sprintf("Run `gauge install %s`.", pluginID),
+			})
+			continue
+		}
+		if err := version.CheckCompatibility(version.CurrentGaugeVersion, &pd.GaugeVersionSupport); err != nil {
+			problems = append(problems, problem{
+				message: fmt.Sprintf("Plugin %s %s is not compatible with Gauge %s.", pd.Name, pd.Version, version.FullVersion()),
+				fix:     fmt.Sprintf("Run `gauge update %s` to install a compatible version.", pluginID),
+			})
+		}
+	}
+	return problems
+}
+
+// checkPorts verifies that a TCP port can be opened, and that any explicitly
+// configured API port is free.
+func checkPorts() []problem {
+	var problems []problem
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		problems = append(problems, problem{
+			message: fmt.Sprintf("Unable to open a local TCP port: %s", err.Error()),
+			fix:     "Check firewall or security software restrictions on loopback connections.",
+		})
+	} else {
+		listener.Close()
+	}
+	if apiPort := os.Getenv(common.APIPortEnvVariableName); apiPort != "" {
+		listener, err := net.Listen("tcp", "127.0.0.1:"+apiPort)
+		if err != nil {
+			problems = append(problems, problem{
+				message: fmt.Sprintf("Configured API port %s is not available: %s", apiPort, err.Error()),
+				fix:     fmt.Sprintf("Free the port or unset %s to use a random free port.", common.APIPortEnvVariableName),
+			})
+		} else {
+			listener.Close()
+		}
+	}
+	return problems
+}
+
+// checkConfiguration verifies the timeout properties hold positive numbers,
+// so misconfigured values surface here instead of as silent fallbacks to
+// defaults at run time.
+func checkConfiguration() []problem {
+	var problems []problem
+	for _, name := range config.TimeoutProperties() {
+		value, err := config.GetProperty(name)
+		if err != nil {
+			problems = append(problems, problem{
+				message: fmt.Sprintf("Unable to read property %s: %s", name, err.Error()),
+				fix:     "Fix or remove the gauge.properties file in the Gauge configuration directory.",
+			})
+			continue
+		}
+		ms, err := strconv.Atoi(value)
+		if err != nil {
+			problems = append(problems, problem{
+				message: fmt.Sprintf("Property %s is %q, which is not a number.", name, value),
+				fix:     fmt.Sprintf("Set %s to a timeout in milliseconds, e.g. `gauge config %s 30000`.", name, name),
+			})
+			continue
+		}
+		if ms <= 0 {
+			problems = append(problems, problem{
+				message: fmt.Sprintf("Property %s is %d, timeouts must be positive.", name, ms),
+				fix:     fmt.Sprintf("Set %s to a timeout in milliseconds, e.g. `gauge config %s 30000`.", name, name),
+			})
+		}
+	}
+	return problems
+}
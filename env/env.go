@@ -40,17 +40,60 @@ const (
 	CsvDelimiter                   = "csv_delimiter"
 	allowMultilineStep             = "allow_multiline_step"
 	allowScenarioDatatable         = "allow_scenario_datatable"
+	allowTagInheritance            = "allow_tag_inheritance"
+	executionPriority              = "execution_priority"
+	specResultOrdering             = "spec_result_ordering"
+	slowestStepsCount              = "slowest_steps_count"
 	allowFilteredParallelExecution = "allow_filtered_parallel_execution"
+	allowMultiEnvExecution         = "allow_multi_env_execution"
 	enableMultithreading           = "enable_multithreading"
 	// GaugeScreenshotsDir holds the location of screenshots dir
-	GaugeScreenshotsDir     = "gauge_screenshots_dir"
+	GaugeScreenshotsDir = "gauge_screenshots_dir"
+	// GaugeArtifactsDir holds the staging dir runners and plugins drop
+	// per-scenario artifacts into
+	GaugeArtifactsDir       = "gauge_artifacts_dir"
 	gaugeSpecFileExtensions = "gauge_spec_file_extensions"
+	keepFailedTempDir       = "keep_failed_scenario_tempdir"
+	requireSignedPlugins    = "require_signed_plugins"
+	auditEnvMutations       = "audit_env_mutations"
+	stepBulletStyle         = "step_bullet_style"
+	notificationWebhookURL  = "notification_webhook_url"
+	notificationTemplate    = "notification_message_template"
+	notificationEmails      = "notification_emails"
+	traceabilityTagPrefixes = "traceability_tag_prefixes"
+	traceabilityIDPattern   = "traceability_id_pattern"
+	traceabilityMatrix      = "generate_traceability_matrix"
+	smtpHost                = "smtp_host"
+	smtpPort                = "smtp_port"
+	smtpUsername            = "smtp_username"
+	smtpPassword            = "smtp_password"
+	smtpFrom                = "smtp_from"
+	resultUploader          = "result_uploader"
+	resultUploaderURL       = "result_uploader_url"
+	resultUploaderUsername  = "result_uploader_username"
+	resultUploaderToken     = "result_uploader_token"
+	resultUploaderTagPrefix = "result_uploader_tag_prefix"
+	resultUploaderDryRun    = "result_uploader_dry_run"
+	beforeSuiteCommand      = "before_suite_command"
+	afterSuiteCommand       = "after_suite_command"
+	beforeStreamCommand     = "before_stream_command"
+	afterStreamCommand      = "after_stream_command"
+	screenshotProvider      = "screenshot_provider_command"
+	screenshotFormat        = "screenshot_format"
+	screenshotQuality       = "screenshot_quality"
+	// GaugeDBCommand holds the command used to materialize db-backed data tables.
+	// It reads a SQL query on stdin and prints the result set as CSV.
+	GaugeDBCommand = "gauge_db_command"
 )
 
 var envVars map[string]string
 
 var currentEnvironments = []string{}
 
+// loadedEnvVars are the vars the last LoadEnv call actually exported, so a
+// multi-environment run can unload one environment before loading the next.
+var loadedEnvVars []string
+
 // LoadEnv first generates the map of the env vars that needs to be set.
 // It starts by populating the map with the env passed by the user in --env flag.
 // It then adds the default values of the env vars which are required by Gauge,
@@ -109,8 +152,13 @@ func loadDefaultEnvVars() {
 	addEnvVar(saveExecutionResult, "false")
 	addEnvVar(CsvDelimiter, ",")
 	addEnvVar(allowMultilineStep, "false")
-	addEnvVar(allowScenarioDatatable, "false")
+	addEnvVar(allowScenarioDatatable, "true")
+	addEnvVar(allowTagInheritance, "true")
 	addEnvVar(allowFilteredParallelExecution, "false")
+	addEnvVar(allowMultiEnvExecution, "false")
+	addEnvVar(keepFailedTempDir, "false")
+	addEnvVar(traceabilityMatrix, "false")
+	addEnvVar(resultUploaderDryRun, "false")
 	defaultScreenshotDir := filepath.Join(config.ProjectRoot, common.DotGauge, "screenshots")
 	addEnvVar(GaugeScreenshotsDir, defaultScreenshotDir)
 	addEnvVar(gaugeSpecFileExtensions, ".spec, .md")
@@ -118,6 +166,12 @@ func loadDefaultEnvVars() {
 	if err != nil {
 		logger.Warningf(true, "Could not create screenshot dir at %s", err.Error())
 	}
+	defaultArtifactsDir := filepath.Join(config.ProjectRoot, common.DotGauge, "artifacts")
+	addEnvVar(GaugeArtifactsDir, defaultArtifactsDir)
+	err = os.MkdirAll(defaultArtifactsDir, 0750)
+	if err != nil {
+		logger.Warningf(true, "Could not create artifacts dir at %s", err.Error())
+	}
 }
 
 func loadEnvDir(envName string) error {
@@ -148,12 +202,34 @@ func loadEnvFile(path string, info os.FileInfo, err error) error {
 	}
 
 	for property, value := range gaugeProperties {
+		value, err := resolveValue(property, value)
+		if err != nil {
+			return fmt.Errorf("%s. %s", path, err.Error())
+		}
 		addEnvVar(property, value)
 	}
 
 	return nil
 }
 
+// resolveValue resolves a property value read from a properties file,
+// decrypting ENC(...) values and fetching secret store references. Values
+// fetched from a secret store are masked in gauge's logs.
+func resolveValue(property, value string) (string, error) {
+	value, err := decryptIfEncrypted(property, value)
+	if err != nil {
+		return "", err
+	}
+	resolved, isSecret, err := config.ResolveSecret(value)
+	if err != nil {
+		return "", fmt.Errorf("Failed to resolve property '%s'. %s", property, err.Error())
+	}
+	if isSecret {
+		logger.MaskSecret(resolved)
+	}
+	return resolved, nil
+}
+
 func addEnvVar(name, value string) {
 	if _, ok := envVars[name]; !ok {
 		envVars[name] = value
@@ -164,6 +240,41 @@ func isPropertiesFile(path string) bool {
 	return filepath.Ext(path) == ".properties"
 }
 
+// PropertiesOf returns the properties declared in the given environment's
+// directory without loading them into the process environment. Spec-scoped
+// environment overlays use it to apply an environment for one spec only.
+var PropertiesOf = func(envName string) (map[string]string, error) {
+	envDirPath := filepath.Join(config.ProjectRoot, common.EnvDirectoryName, envName)
+	if !common.DirExists(envDirPath) {
+		return nil, fmt.Errorf("%s environment does not exist", envName)
+	}
+	props := make(map[string]string)
+	err := filepath.Walk(envDirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !isPropertiesFile(path) {
+			return nil
+		}
+		envProperties, err := properties.Load(path)
+		if err != nil {
+			return fmt.Errorf("Failed to parse: %s. %s", path, err.Error())
+		}
+		for property, value := range envProperties {
+			value, err := resolveValue(property, value)
+			if err != nil {
+				return fmt.Errorf("%s. %s", path, err.Error())
+			}
+			props[property] = value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
 func substituteEnvVars() error {
 	for name, value := range envVars {
 		contains, matches := containsEnvVar(value)
@@ -190,17 +301,33 @@ func substituteEnvVars() error {
 }
 
 func setEnvVars() error {
+	loadedEnvVars = nil
 	for name, value := range envVars {
 		if !isPropertySet(name) {
 			err := common.SetEnvVariable(name, value)
 			if err != nil {
 				return fmt.Errorf("%s", err.Error())
 			}
+			loadedEnvVars = append(loadedEnvVars, name)
 		}
 	}
 	return nil
 }
 
+// UnloadEnv unsets the vars exported by the last LoadEnv call, so a
+// subsequent LoadEnv starts from a clean shell instead of inheriting the
+// previous environment's values. Vars that were already set in the shell
+// before LoadEnv are left alone.
+func UnloadEnv() {
+	for _, name := range loadedEnvVars {
+		if err := os.Unsetenv(name); err != nil {
+			logger.Warningf(true, "Unable to unset %s: %s", name, err.Error())
+		}
+	}
+	loadedEnvVars = nil
+	currentEnvironments = []string{}
+}
+
 func isPropertySet(property string) bool {
 	return len(os.Getenv(property)) > 0
 }
@@ -238,14 +365,29 @@ func convertToBool(property string, defaultValue bool) bool {
 	return boolValue
 }
 
+// AllowTagInheritance - scenarios inherit their spec's tags, enabled by
+// default. Set allow_tag_inheritance=false to filter scenarios on their own
+// tags only; scenarios can also suppress a single inherited tag with `!tag`.
+var AllowTagInheritance = func() bool {
+	return convertToBool(allowTagInheritance, true)
+}
+
 // AllowFilteredParallelExecution - feature toggle for filtered parallel execution
 var AllowFilteredParallelExecution = func() bool {
 	return convertToBool(allowFilteredParallelExecution, false)
 }
 
-// AllowScenarioDatatable -feature toggle for datatables in scenario
+// AllowMultiEnvExecution - feature toggle for executing the suite once per
+// environment when --env lists several, instead of overlaying them.
+var AllowMultiEnvExecution = func() bool {
+	return convertToBool(allowMultiEnvExecution, false)
+}
+
+// AllowScenarioDatatable - datatables in scenario, enabled by default.
+// Each table row is executed and reported as a separate scenario result.
+// Set allow_scenario_datatable=false to opt out.
 var AllowScenarioDatatable = func() bool {
-	return convertToBool(allowScenarioDatatable, false)
+	return convertToBool(allowScenarioDatatable, true)
 }
 
 // AllowMultiLineStep - feature toggle for newline in step text
@@ -253,11 +395,269 @@ var AllowMultiLineStep = func() bool {
 	return convertToBool(allowMultilineStep, false)
 }
 
+// StepBulletStyle - the bullet character used for steps. Steps are written
+// with "*" by default; set step_bullet_style to "-" to let the parser accept
+// and the formatter emit markdown style dash bullets.
+var StepBulletStyle = func() string {
+	if os.Getenv(stepBulletStyle) == "-" {
+		return "-"
+	}
+	return "*"
+}
+
+// ExecutionPriority - tag-based scheduling priorities as `tag:number` pairs,
+// e.g. execution_priority=smoke:1,regression:5. Specs carrying a lower number
+// run first; unlisted specs run last.
+var ExecutionPriority = func() string {
+	return strings.TrimSpace(os.Getenv(executionPriority))
+}
+
+// SpecResultOrdering - how spec results are ordered in the final suite
+// result. `file` preserves spec-file order so reports are diffable across
+// runs, `failure-first` lists failed specs first and `duration` slowest
+// first. Empty keeps the completion order of the execution streams.
+var SpecResultOrdering = func() string {
+	return strings.ToLower(strings.TrimSpace(os.Getenv(specResultOrdering)))
+}
+
+// BeforeSuiteCommand - shell command gauge runs before the suite, e.g. to
+// start services or seed a database. A failure fails the suite.
+var BeforeSuiteCommand = func() string {
+	return strings.TrimSpace(os.Getenv(beforeSuiteCommand))
+}
+
+// AfterSuiteCommand - shell command gauge runs after the suite, e.g. to tear
+// down what before_suite_command started.
+var AfterSuiteCommand = func() string {
+	return strings.TrimSpace(os.Getenv(afterSuiteCommand))
+}
+
+// BeforeStreamCommand - shell command gauge runs before each parallel
+// execution stream, with GAUGE_STREAM set to the stream number, e.g. to
+// allocate a browser instance or database schema per stream.
+var BeforeStreamCommand = func() string {
+	return strings.TrimSpace(os.Getenv(beforeStreamCommand))
+}
+
+// AfterStreamCommand - shell command gauge runs after each parallel
+// execution stream, to release what before_stream_command allocated.
+var AfterStreamCommand = func() string {
+	return strings.TrimSpace(os.Getenv(afterStreamCommand))
+}
+
+// ScreenshotOnFailureEnabled - whether a screenshot should be captured when
+// a scenario fails, enabled by default.
+var ScreenshotOnFailureEnabled = func() bool {
+	return convertToBool(ScreenshotOnFailure, true)
+}
+
+// ScreenshotProviderCommand - external command gauge invokes to capture a
+// screenshot when a scenario fails. The command gets the target file,
+// format and quality in GAUGE_SCREENSHOT_FILE, GAUGE_SCREENSHOT_FORMAT and
+// GAUGE_SCREENSHOT_QUALITY. Empty when no provider is configured.
+var ScreenshotProviderCommand = func() string {
+	return strings.TrimSpace(os.Getenv(screenshotProvider))
+}
+
+// ScreenshotFormat - image format the screenshot provider should produce,
+// png (default) or jpeg.
+var ScreenshotFormat = func() string {
+	format := strings.ToLower(strings.TrimSpace(os.Getenv(screenshotFormat)))
+	if format == "jpeg" || format == "jpg" {
+		return "jpeg"
+	}
+	return "png"
+}
+
+// ScreenshotQuality - image quality (1-100) the screenshot provider should
+// use, 100 by default. Only meaningful for lossy formats.
+var ScreenshotQuality = func() int {
+	v := strings.TrimSpace(os.Getenv(screenshotQuality))
+	if v == "" {
+		return 100
+	}
+	quality, err := strconv.Atoi(v)
+	if err != nil || quality < 1 || quality > 100 {
+		logger.Warningf(true, "Incorrect value for %s in property file. Using default value 100.", screenshotQuality)
+		return 100
+	}
+	return quality
+}
+
+// SlowestStepsCount - number of steps the "slowest steps" section lists in
+// the console summary after a run. 0, the default, disables the section.
+var SlowestStepsCount = func() int {
+	v := strings.TrimSpace(os.Getenv(slowestStepsCount))
+	if v == "" {
+		return 0
+	}
+	count, err := strconv.Atoi(v)
+	if err != nil || count < 0 {
+		logger.Warningf(true, "Incorrect value for %s in property file. Using default value 0.", slowestStepsCount)
+		return 0
+	}
+	return count
+}
+
+// RequireSignedPlugins - when enabled, plugin installs refuse artifacts that
+// do not publish a signature. Checked directly against the process
+// environment since plugin installs can run outside a project.
+var RequireSignedPlugins = func() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv(requireSignedPlugins)), "true")
+}
+
+// KeepFailedScenarioTempDir determines if a failed scenario's temp directory
+// should be retained for inspection instead of being cleaned up
+var KeepFailedScenarioTempDir = func() bool {
+	return convertToBool(keepFailedTempDir, false)
+}
+
+// AuditEnvMutations - when enabled, environment variables mutated during
+// execution are detected at scenario boundaries and reported in the suite
+// result, to help debug state leaking between scenarios and streams.
+var AuditEnvMutations = func() bool {
+	return convertToBool(auditEnvMutations, false)
+}
+
 // SaveExecutionResult determines if last run result should be saved
 var SaveExecutionResult = func() bool {
 	return convertToBool(saveExecutionResult, false)
 }
 
+// NotificationWebhookURL returns the Slack or Microsoft Teams incoming
+// webhook the run summary is posted to after a suite finishes. Empty (the
+// default) disables the notification.
+var NotificationWebhookURL = func() string {
+	return strings.TrimSpace(os.Getenv(notificationWebhookURL))
+}
+
+// NotificationMessageTemplate returns the template of the posted run summary.
+// The placeholders {{status}}, {{passed}}, {{failed}}, {{skipped}},
+// {{totalTime}} and {{reportLink}} are replaced with the run's values.
+var NotificationMessageTemplate = func() string {
+	if template := strings.TrimSpace(os.Getenv(notificationTemplate)); template != "" {
+		return template
+	}
+	return "Gauge run {{status}}: {{passed}} passed, {{failed}} failed, {{skipped}} skipped in {{totalTime}}. Report: {{reportLink}}"
+}
+
+// TraceabilityTagPrefixes returns the tag prefixes that link scenarios to
+// requirement or issue IDs, e.g. jira in @jira:PROJ-123.
+var TraceabilityTagPrefixes = func() []string {
+	prefixes := os.Getenv(traceabilityTagPrefixes)
+	if strings.TrimSpace(prefixes) == "" {
+		prefixes = "jira, issue, req"
+	}
+	var result []string
+	for _, prefix := range strings.Split(prefixes, ",") {
+		if prefix = strings.ToLower(strings.TrimSpace(prefix)); prefix != "" {
+			result = append(result, prefix)
+		}
+	}
+	return result
+}
+
+// TraceabilityIDPattern returns the regular expression a requirement ID in a
+// traceability tag must match.
+var TraceabilityIDPattern = func() string {
+	if pattern := strings.TrimSpace(os.Getenv(traceabilityIDPattern)); pattern != "" {
+		return pattern
+	}
+	return "[A-Za-z][A-Za-z0-9]*-[0-9]+"
+}
+
+// GenerateTraceabilityMatrix determines if a scenario-to-requirement matrix
+// should be written to the reports directory after a run.
+var GenerateTraceabilityMatrix = func() bool {
+	return convertToBool(traceabilityMatrix, false)
+}
+
+// NotificationEmails returns the distribution list the post-run email
+// summary is sent to, configured as a comma separated list of addresses.
+var NotificationEmails = func() []string {
+	var emails []string
+	for _, email := range strings.Split(os.Getenv(notificationEmails), ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+// SMTPHost returns the SMTP server the email summary is sent through. Empty
+// disables the emailer.
+var SMTPHost = func() string {
+	return strings.TrimSpace(os.Getenv(smtpHost))
+}
+
+// SMTPPort returns the SMTP server port, defaulting to 587 (submission).
+var SMTPPort = func() int {
+	port, err := strconv.Atoi(strings.TrimSpace(os.Getenv(smtpPort)))
+	if err != nil || port < 1 {
+		return 587
+	}
+	return port
+}
+
+// SMTPUsername returns the SMTP user; empty sends without authentication.
+var SMTPUsername = func() string {
+	return strings.TrimSpace(os.Getenv(smtpUsername))
+}
+
+// SMTPPassword returns the SMTP password belonging to smtp_username.
+var SMTPPassword = func() string {
+	return os.Getenv(smtpPassword)
+}
+
+// SMTPFrom returns the sender address of the email summary, falling back to
+// the SMTP username.
+var SMTPFrom = func() string {
+	if from := strings.TrimSpace(os.Getenv(smtpFrom)); from != "" {
+		return from
+	}
+	return SMTPUsername()
+}
+
+// ResultUploader returns the name of the result uploader per-scenario
+// outcomes are posted to after a run, e.g. testrail or xray. Empty (the
+// default) disables the upload.
+var ResultUploader = func() string {
+	return strings.ToLower(strings.TrimSpace(os.Getenv(resultUploader)))
+}
+
+// ResultUploaderURL returns the endpoint the result uploader posts to.
+var ResultUploaderURL = func() string {
+	return strings.TrimSpace(os.Getenv(resultUploaderURL))
+}
+
+// ResultUploaderUsername returns the user the result uploader authenticates
+// as; TestRail pairs it with the API key, Xray only needs the token.
+var ResultUploaderUsername = func() string {
+	return strings.TrimSpace(os.Getenv(resultUploaderUsername))
+}
+
+// ResultUploaderToken returns the API key or bearer token of the result
+// uploader.
+var ResultUploaderToken = func() string {
+	return strings.TrimSpace(os.Getenv(resultUploaderToken))
+}
+
+// ResultUploaderTagPrefix returns the tag prefix that maps a scenario to an
+// external test case ID, e.g. testrail in @testrail:C123. Defaults to the
+// uploader's name.
+var ResultUploaderTagPrefix = func() string {
+	if prefix := strings.ToLower(strings.TrimSpace(os.Getenv(resultUploaderTagPrefix))); prefix != "" {
+		return prefix
+	}
+	return ResultUploader()
+}
+
+// ResultUploaderDryRun determines if the result uploader should log the
+// outcomes it would post instead of calling the endpoint.
+var ResultUploaderDryRun = func() bool {
+	return convertToBool(resultUploaderDryRun, false)
+}
+
 // EnableMultiThreadedExecution determines if threads should be used instead of process
 // for each parallel stream
 var EnableMultiThreadedExecution = func() bool {
@@ -279,3 +279,55 @@ func (s *MySuite) TestShouldNotGetDefaultExtensionsWhenEnvIsSet(c *C) {
 		c.Assert(contains(exts, expected), Equals, false)
 	}
 }
+
+func (s *MySuite) TestScreenshotFormatDefaultsToPng(c *C) {
+	os.Clearenv()
+
+	c.Assert(ScreenshotFormat(), Equals, "png")
+}
+
+func (s *MySuite) TestScreenshotFormatNormalizesJpg(c *C) {
+	os.Clearenv()
+	os.Setenv(screenshotFormat, "JPG")
+
+	c.Assert(ScreenshotFormat(), Equals, "jpeg")
+}
+
+func (s *MySuite) TestScreenshotQualityDefaultsOnInvalidValue(c *C) {
+	os.Clearenv()
+	os.Setenv(screenshotQuality, "150")
+
+	c.Assert(ScreenshotQuality(), Equals, 100)
+}
+
+func (s *MySuite) TestScreenshotQuality(c *C) {
+	os.Clearenv()
+	os.Setenv(screenshotQuality, "80")
+
+	c.Assert(ScreenshotQuality(), Equals, 80)
+}
+
+func (s *MySuite) TestUnloadEnvAllowsLoadingAnotherEnvironment(c *C) {
+	os.Clearenv()
+	config.ProjectRoot = "_testdata/proj2"
+	c.Assert(LoadEnv("bar"), Equals, nil)
+	c.Assert(os.Getenv("logs_directory"), Equals, "bar/logs")
+
+	UnloadEnv()
+
+	c.Assert(os.Getenv("logs_directory"), Equals, "")
+	c.Assert(LoadEnv(common.DefaultEnvDir), Equals, nil)
+	c.Assert(os.Getenv("logs_directory"), Equals, "logs")
+	c.Assert(CurrentEnvironments(), Equals, common.DefaultEnvDir)
+}
+
+func (s *MySuite) TestUnloadEnvLeavesShellVariablesAlone(c *C) {
+	os.Clearenv()
+	config.ProjectRoot = "_testdata/proj2"
+	os.Setenv("logs_directory", "from/shell")
+	c.Assert(LoadEnv("bar"), Equals, nil)
+
+	UnloadEnv()
+
+	c.Assert(os.Getenv("logs_directory"), Equals, "from/shell")
+}
@@ -0,0 +1,104 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package env
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Property values wrapped in ENC(...) are decrypted when the environment is
+// loaded, so credentials in env/default.properties need not be stored in
+// plaintext. Values are encrypted with AES-256-GCM; the key is derived from
+// the passphrase in the GAUGE_ENCRYPTION_KEY environment variable, which can
+// be populated from an OS keychain or secret store by the shell. Use
+// 'gauge config encrypt <key>=<value>' to produce an encrypted property line.
+
+const (
+	// EncryptionKeyEnv names the environment variable holding the
+	// passphrase used to encrypt and decrypt ENC(...) property values.
+	EncryptionKeyEnv = "GAUGE_ENCRYPTION_KEY"
+
+	encryptedValuePrefix = "ENC("
+	encryptedValueSuffix = ")"
+)
+
+// IsEncrypted tells if a property value is an ENC(...) encrypted value.
+func IsEncrypted(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, encryptedValuePrefix) && strings.HasSuffix(value, encryptedValueSuffix)
+}
+
+// Encrypt encrypts a plaintext property value and returns it wrapped in
+// ENC(...), ready to be pasted into a properties file.
+func Encrypt(plaintext string) (string, error) {
+	aead, err := encryptionCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return encryptedValuePrefix + base64.StdEncoding.EncodeToString(sealed) + encryptedValueSuffix, nil
+}
+
+// Decrypt decrypts an ENC(...) property value produced by Encrypt.
+func Decrypt(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	encoded := strings.TrimSuffix(strings.TrimPrefix(value, encryptedValuePrefix), encryptedValueSuffix)
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("value is not a valid encrypted value")
+	}
+	aead, err := encryptionCipher()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("value is not a valid encrypted value")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decryption failed, the encryption key does not match")
+	}
+	return string(plaintext), nil
+}
+
+// decryptIfEncrypted resolves a property value read from a properties file,
+// decrypting it when it is an ENC(...) value.
+func decryptIfEncrypted(property, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	plaintext, err := Decrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("Failed to decrypt property '%s'. %s", property, err.Error())
+	}
+	return plaintext, nil
+}
+
+func encryptionCipher() (cipher.AEAD, error) {
+	passphrase := os.Getenv(EncryptionKeyEnv)
+	if passphrase == "" {
+		return nil, fmt.Errorf("%s is not set, it is required to handle ENC(...) property values", EncryptionKeyEnv)
+	}
+	key := sha256.Sum256([]byte(passphrase))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
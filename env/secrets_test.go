@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package env
+
+import (
+	"os"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestEncryptDecryptRoundTrip(c *C) {
+	os.Setenv(EncryptionKeyEnv, "passphrase")
+	defer os.Unsetenv(EncryptionKeyEnv)
+
+	encrypted, err := Encrypt("s3cr3t")
+	c.Assert(err, Equals, nil)
+	c.Assert(IsEncrypted(encrypted), Equals, true)
+	c.Assert(strings.Contains(encrypted, "s3cr3t"), Equals, false)
+
+	plaintext, err := Decrypt(encrypted)
+	c.Assert(err, Equals, nil)
+	c.Assert(plaintext, Equals, "s3cr3t")
+}
+
+func (s *MySuite) TestDecryptWithWrongKeyFails(c *C) {
+	os.Setenv(EncryptionKeyEnv, "passphrase")
+	encrypted, err := Encrypt("s3cr3t")
+	c.Assert(err, Equals, nil)
+
+	os.Setenv(EncryptionKeyEnv, "wrong passphrase")
+	defer os.Unsetenv(EncryptionKeyEnv)
+	_, err = Decrypt(encrypted)
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "decryption failed, the encryption key does not match")
+}
+
+func (s *MySuite) TestEncryptWithoutKeyFails(c *C) {
+	os.Unsetenv(EncryptionKeyEnv)
+	_, err := Encrypt("s3cr3t")
+	c.Assert(err, NotNil)
+	c.Assert(strings.Contains(err.Error(), EncryptionKeyEnv), Equals, true)
+}
+
+func (s *MySuite) TestIsEncrypted(c *C) {
+	c.Assert(IsEncrypted("ENC(abcd)"), Equals, true)
+	c.Assert(IsEncrypted(" ENC(abcd) "), Equals, true)
+	c.Assert(IsEncrypted("plaintext"), Equals, false)
+	c.Assert(IsEncrypted("ENC(abcd"), Equals, false)
+}
+
+func (s *MySuite) TestDecryptIfEncryptedLeavesPlaintextAlone(c *C) {
+	value, err := decryptIfEncrypted("db_password", "plaintext")
+	c.Assert(err, Equals, nil)
+	c.Assert(value, Equals, "plaintext")
+}
+
+func (s *MySuite) TestDecryptIfEncryptedReportsTheProperty(c *C) {
+	os.Setenv(EncryptionKeyEnv, "passphrase")
+	defer os.Unsetenv(EncryptionKeyEnv)
+	_, err := decryptIfEncrypted("db_password", "ENC(not-base64!)")
+	c.Assert(err, NotNil)
+	c.Assert(strings.Contains(err.Error(), "db_password"), Equals, true)
+}
@@ -0,0 +1,137 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// AdaptiveStreams is set by `-n auto`. The run starts with one stream per
+// core and the number of concurrently active streams adapts to CPU and
+// memory pressure and to runner startup failures instead of staying fixed.
+var AdaptiveStreams bool
+
+const (
+	// streamAdaptInterval is how often system pressure is re-evaluated.
+	streamAdaptInterval = 5 * time.Second
+	// streamSlotPollInterval is how long a parked stream waits before
+	// re-checking whether it may pick up work again.
+	streamSlotPollInterval = 500 * time.Millisecond
+	// highLoadPerCore is the 1-minute load average per core above which a
+	// stream is parked.
+	highLoadPerCore = 1.5
+	// lowLoadPerCore is the load per core below which a parked stream is
+	// released again.
+	lowLoadPerCore = 0.7
+	// lowMemoryFraction is the fraction of available memory below which a
+	// stream is parked.
+	lowMemoryFraction = 0.1
+)
+
+// streamGovernor bounds how many execution streams may pick up specs.
+// Streams above the limit park until the limit rises or the specs run out;
+// the highest-numbered streams are parked first.
+type streamGovernor struct {
+	max   int32
+	limit int32
+	done  chan struct{}
+}
+
+var governor *streamGovernor
+
+// startStreamGovernor begins adapting the number of active streams, starting
+// from maxStreams.
+func startStreamGovernor(maxStreams int) {
+	g := &streamGovernor{max: int32(maxStreams), limit: int32(maxStreams), done: make(chan struct{})}
+	governor = g
+	go func() {
+		ticker := time.NewTicker(streamAdaptInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-g.done:
+				return
+			case <-ticker.C:
+				g.adapt()
+			}
+		}
+	}()
+}
+
+func stopStreamGovernor() {
+	if governor != nil {
+		close(governor.done)
+		governor = nil
+	}
+}
+
+// waitForStreamSlot parks the stream while it is above the governed limit.
+// It returns as soon as the stream may run or there are no specs left to
+// pick up, so a parked stream can never hold back the end of the run.
+func waitForStreamSlot(stream int, sc *gauge.SpecCollection) {
+	g := governor
+	if g == nil {
+		return
+	}
+	for atomic.LoadInt32(&g.limit) < int32(stream) && sc.HasNext() {
+		time.Sleep(streamSlotPollInterval)
+	}
+}
+
+// reportRunnerStartFailure lowers the stream limit when a runner fails to
+// come up, typically a sign the machine is out of resources.
+func reportRunnerStartFailure() {
+	if governor != nil {
+		governor.shrink("a runner failed to start")
+	}
+}
+
+func (g *streamGovernor) adapt() {
+	load1, memAvailable, ok := sampleSystemPressure()
+	if !ok {
+		return
+	}
+	loadPerCore := load1 / float64(runtime.NumCPU())
+	if loadPerCore > highLoadPerCore {
+		g.shrink("high CPU load")
+	} else if memAvailable < lowMemoryFraction {
+		g.shrink("low available memory")
+	} else if loadPerCore < lowLoadPerCore && memAvailable > 2*lowMemoryFraction {
+		g.grow()
+	}
+}
+
+func (g *streamGovernor) shrink(reason string) {
+	for {
+		current := atomic.LoadInt32(&g.limit)
+		if current <= 1 {
+			return
+		}
+		if atomic.CompareAndSwapInt32(&g.limit, current, current-1) {
+			logger.Debugf(true, "Reducing active execution streams to %d: %s.", current-1, reason)
+			return
+		}
+	}
+}
+
+func (g *streamGovernor) grow() {
+	for {
+		current := atomic.LoadInt32(&g.limit)
+		if current >= g.max {
+			return
+		}
+		if atomic.CompareAndSwapInt32(&g.limit, current, current+1) {
+			logger.Debugf(true, "Raising active execution streams to %d.", current+1)
+			return
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestStreamGovernorShrinksToAtLeastOneStream(t *testing.T) {
+	g := &streamGovernor{max: 2, limit: 2, done: make(chan struct{})}
+
+	g.shrink("test")
+	g.shrink("test")
+	g.shrink("test")
+
+	if g.limit != 1 {
+		t.Errorf("limit = %d, want 1", g.limit)
+	}
+}
+
+func TestStreamGovernorGrowsBackToTheConfiguredMaximum(t *testing.T) {
+	g := &streamGovernor{max: 3, limit: 1, done: make(chan struct{})}
+
+	g.grow()
+	g.grow()
+	g.grow()
+
+	if g.limit != 3 {
+		t.Errorf("limit = %d, want 3", g.limit)
+	}
+}
+
+func TestWaitForStreamSlotReturnsWhenNoSpecsAreLeft(t *testing.T) {
+	governor = &streamGovernor{max: 4, limit: 1, done: make(chan struct{})}
+	defer stopStreamGovernor()
+	sc := gauge.NewSpecCollection(nil, true)
+
+	waitForStreamSlot(4, sc)
+}
+
+func TestWaitForStreamSlotLetsStreamsWithinTheLimitRun(t *testing.T) {
+	governor = &streamGovernor{max: 4, limit: 2, done: make(chan struct{})}
+	defer stopStreamGovernor()
+	sc := gauge.NewSpecCollection([]*gauge.Specification{{FileName: "a.spec"}}, true)
+
+	waitForStreamSlot(2, sc)
+
+	if !sc.HasNext() {
+		t.Error("Expected the spec to still be available for pickup")
+	}
+}
+
+func TestReportRunnerStartFailureLowersTheLimit(t *testing.T) {
+	governor = &streamGovernor{max: 4, limit: 4, done: make(chan struct{})}
+	defer stopStreamGovernor()
+
+	reportRunnerStartFailure()
+
+	if governor.limit != 3 {
+		t.Errorf("limit = %d, want 3", governor.limit)
+	}
+}
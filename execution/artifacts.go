@@ -0,0 +1,77 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+const artifactsDirName = "artifacts"
+
+var artifactNameSanitizer = regexp.MustCompile(`[^a-zA-Z0-9-_.]+`)
+
+// collectScenarioArtifacts moves the files that runners or plugins dropped
+// into the artifacts staging dir (gauge_artifacts_dir) during the scenario
+// into reports/artifacts/<spec>/<scenario>/, and records their paths,
+// relative to the reports dir, in the scenario result for report plugins.
+func collectScenarioArtifacts(specFileName string, scenario *gauge.Scenario, scenarioResult *result.ScenarioResult) {
+	stagingDir := os.Getenv(env.GaugeArtifactsDir)
+	if stagingDir == "" {
+		return
+	}
+	entries, err := ioutil.ReadDir(stagingDir)
+	if err != nil || len(entries) == 0 {
+		return
+	}
+	specName := strings.TrimSuffix(filepath.Base(specFileName), filepath.Ext(specFileName))
+	relDir := filepath.Join(artifactsDirName, sanitizeArtifactName(specName), sanitizeArtifactName(scenario.Heading.Value))
+	destDir := filepath.Join(reportsDir(), relDir)
+	if err := os.MkdirAll(destDir, 0750); err != nil {
+		logger.Warningf(true, "Could not create artifacts dir %s: %s", destDir, err.Error())
+		return
+	}
+	for _, entry := range entries {
+		src := filepath.Join(stagingDir, entry.Name())
+		dest := filepath.Join(destDir, entry.Name())
+		if err := os.Rename(src, dest); err != nil {
+			logger.Warningf(true, "Could not collect artifact %s: %s", src, err.Error())
+			continue
+		}
+		scenarioResult.ProtoScenario.ArtifactFiles = append(scenarioResult.ProtoScenario.ArtifactFiles, filepath.Join(relDir, entry.Name()))
+	}
+	if len(scenarioResult.ProtoScenario.ArtifactFiles) > 0 {
+		logger.Debugf(true, "Collected %d artifact(s) for scenario %q into %s", len(scenarioResult.ProtoScenario.ArtifactFiles), scenario.Heading.Value, destDir)
+	}
+}
+
+// sanitizeArtifactName makes a spec or scenario heading safe to use as a
+// directory name.
+func sanitizeArtifactName(name string) string {
+	sanitized := artifactNameSanitizer.ReplaceAllString(strings.TrimSpace(name), "_")
+	return strings.Trim(sanitized, "_")
+}
+
+func reportsDir() string {
+	dir := os.Getenv(env.GaugeReportsDir)
+	if dir == "" {
+		dir = "reports"
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(config.ProjectRoot, dir)
+	}
+	return dir
+}
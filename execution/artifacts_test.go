@@ -0,0 +1,78 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestCollectScenarioArtifactsMovesStagedFiles(t *testing.T) {
+	projectRoot, err := ioutil.TempDir("", "gauge-artifacts")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(projectRoot)
+	stagingDir := filepath.Join(projectRoot, "staging")
+	if err := os.MkdirAll(stagingDir, 0750); err != nil {
+		t.Fatalf("unable to create staging dir: %s", err.Error())
+	}
+	os.Setenv(env.GaugeArtifactsDir, stagingDir)
+	os.Setenv(env.GaugeReportsDir, filepath.Join(projectRoot, "reports"))
+	defer os.Unsetenv(env.GaugeArtifactsDir)
+	defer os.Unsetenv(env.GaugeReportsDir)
+	if err := ioutil.WriteFile(filepath.Join(stagingDir, "trace.har"), []byte("har"), 0644); err != nil {
+		t.Fatalf("unable to stage artifact: %s", err.Error())
+	}
+
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My Scenario"}}
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{})
+	collectScenarioArtifacts(filepath.Join("specs", "example.spec"), scenario, scenarioResult)
+
+	wantRel := filepath.Join("artifacts", "example", "My_Scenario", "trace.har")
+	if len(scenarioResult.ProtoScenario.ArtifactFiles) != 1 || scenarioResult.ProtoScenario.ArtifactFiles[0] != wantRel {
+		t.Errorf("want artifact files [%s], got %v", wantRel, scenarioResult.ProtoScenario.ArtifactFiles)
+	}
+	if _, err := os.Stat(filepath.Join(projectRoot, "reports", wantRel)); err != nil {
+		t.Errorf("expected artifact to be moved into reports dir: %s", err.Error())
+	}
+	entries, _ := ioutil.ReadDir(stagingDir)
+	if len(entries) != 0 {
+		t.Errorf("expected staging dir to be emptied, got %d entries", len(entries))
+	}
+}
+
+func TestCollectScenarioArtifactsWithEmptyStagingDir(t *testing.T) {
+	stagingDir, err := ioutil.TempDir("", "gauge-artifacts")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(stagingDir)
+	os.Setenv(env.GaugeArtifactsDir, stagingDir)
+	defer os.Unsetenv(env.GaugeArtifactsDir)
+
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My Scenario"}}
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{})
+	collectScenarioArtifacts("example.spec", scenario, scenarioResult)
+
+	if len(scenarioResult.ProtoScenario.ArtifactFiles) != 0 {
+		t.Errorf("expected no artifact files, got %v", scenarioResult.ProtoScenario.ArtifactFiles)
+	}
+}
+
+func TestSanitizeArtifactName(t *testing.T) {
+	if got := sanitizeArtifactName("Say hello / goodbye!"); got != "Say_hello_goodbye" {
+		t.Errorf("want Say_hello_goodbye, got %s", got)
+	}
+}
@@ -0,0 +1,106 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+// BaselineFile holds the path to a saved run result (the .gauge/last_run_result
+// written when save_execution_result is enabled) the current run is compared
+// against. When set, the exit code reflects only scenarios that fail now but
+// did not fail in the baseline, so failures already present on the base branch
+// do not break a PR build. The reported results still include all failures.
+var BaselineFile string
+
+// checkAgainstBaseline compares the suite result's failures with the
+// baseline's and returns Success when every failure is already present in
+// the baseline. It returns ExecutionFailed when regressions exist or the
+// baseline cannot be read.
+func checkAgainstBaseline(suiteResult *result.SuiteResult) int {
+	baseline, err := loadBaselineFailures(BaselineFile)
+	if err != nil {
+		logger.Errorf(true, "Unable to read baseline %s. %s", BaselineFile, err.Error())
+		return ExecutionFailed
+	}
+	regressions := newlyFailedScenarios(suiteResult, baseline)
+	if len(regressions) == 0 {
+		logger.Infof(true, "\nAll failures are present in the baseline, no regressions.")
+		return Success
+	}
+	logger.Infof(true, "\nRegressions relative to the baseline:")
+	for _, scenario := range regressions {
+		logger.Infof(true, "\t%s", scenario)
+	}
+	return ExecutionFailed
+}
+
+// loadBaselineFailures reads a saved run result and returns the keys of the
+// scenarios that failed in it.
+func loadBaselineFailures(resultFile string) (map[string]bool, error) {
+	contents, err := ioutil.ReadFile(resultFile)
+	if err != nil {
+		return nil, err
+	}
+	baselineResult := &gauge_messages.ProtoSuiteResult{}
+	if err := proto.Unmarshal(contents, baselineResult); err != nil {
+		return nil, fmt.Errorf("not a saved run result: %s", err.Error())
+	}
+	failures := make(map[string]bool)
+	for _, specResult := range baselineResult.GetSpecResults() {
+		spec := specResult.GetProtoSpec()
+		for _, scenario := range scenariosOf(spec) {
+			if scenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED {
+				failures[scenarioKey(spec.GetSpecHeading(), scenario.GetScenarioHeading())] = true
+			}
+		}
+	}
+	return failures, nil
+}
+
+// newlyFailedScenarios lists the scenarios failing in the suite result that
+// did not fail in the baseline, sorted for stable output.
+func newlyFailedScenarios(suiteResult *result.SuiteResult, baseline map[string]bool) []string {
+	var regressions []string
+	for _, specResult := range suiteResult.SpecResults {
+		spec := specResult.ProtoSpec
+		for _, scenario := range scenariosOf(spec) {
+			if scenario.GetExecutionStatus() != gauge_messages.ExecutionStatus_FAILED {
+				continue
+			}
+			if key := scenarioKey(spec.GetSpecHeading(), scenario.GetScenarioHeading()); !baseline[key] {
+				regressions = append(regressions, key)
+			}
+		}
+	}
+	sort.Strings(regressions)
+	return regressions
+}
+
+func scenarioKey(specHeading, scenarioHeading string) string {
+	return specHeading + ": " + scenarioHeading
+}
+
+func scenariosOf(spec *gauge_messages.ProtoSpec) []*gauge_messages.ProtoScenario {
+	var scenarios []*gauge_messages.ProtoScenario
+	for _, item := range spec.GetItems() {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Scenario:
+			scenarios = append(scenarios, item.GetScenario())
+		case gauge_messages.ProtoItem_TableDrivenScenario:
+			scenarios = append(scenarios, item.GetTableDrivenScenario().GetScenario())
+		}
+	}
+	return scenarios
+}
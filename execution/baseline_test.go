@@ -0,0 +1,88 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/golang/protobuf/proto"
+)
+
+func specWithScenarios(heading string, scenarios map[string]gauge_messages.ExecutionStatus) *gauge_messages.ProtoSpec {
+	spec := &gauge_messages.ProtoSpec{SpecHeading: heading}
+	for scenario, status := range scenarios {
+		spec.Items = append(spec.Items, &gauge_messages.ProtoItem{
+			ItemType: gauge_messages.ProtoItem_Scenario,
+			Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: scenario, ExecutionStatus: status},
+		})
+	}
+	return spec
+}
+
+func writeBaselineFile(t *testing.T, dir string, specs ...*gauge_messages.ProtoSpec) string {
+	t.Helper()
+	suiteResult := &gauge_messages.ProtoSuiteResult{}
+	for _, spec := range specs {
+		suiteResult.SpecResults = append(suiteResult.SpecResults, &gauge_messages.ProtoSpecResult{ProtoSpec: spec})
+	}
+	contents, err := proto.Marshal(suiteResult)
+	if err != nil {
+		t.Fatalf("unable to marshal baseline: %s", err.Error())
+	}
+	file := filepath.Join(dir, "last_run_result")
+	if err := ioutil.WriteFile(file, contents, 0640); err != nil {
+		t.Fatalf("unable to write baseline: %s", err.Error())
+	}
+	return file
+}
+
+func TestLoadBaselineFailures(t *testing.T) {
+	file := writeBaselineFile(t, t.TempDir(), specWithScenarios("My Spec", map[string]gauge_messages.ExecutionStatus{
+		"Failing":  gauge_messages.ExecutionStatus_FAILED,
+		"Passing":  gauge_messages.ExecutionStatus_PASSED,
+		"Skipping": gauge_messages.ExecutionStatus_SKIPPED,
+	}))
+
+	failures, err := loadBaselineFailures(file)
+	if err != nil {
+		t.Fatalf("loadBaselineFailures returned error: %s", err.Error())
+	}
+	if len(failures) != 1 || !failures["My Spec: Failing"] {
+		t.Errorf("failures = %v, want only 'My Spec: Failing'", failures)
+	}
+}
+
+func TestLoadBaselineFailuresRejectsGarbage(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "last_run_result")
+	if err := ioutil.WriteFile(file, []byte("not a proto result \x01\x02"), 0640); err != nil {
+		t.Fatalf("unable to write file: %s", err.Error())
+	}
+	if _, err := loadBaselineFailures(file); err == nil {
+		t.Error("loadBaselineFailures should fail for a file that is not a saved run result")
+	}
+}
+
+func TestNewlyFailedScenariosIgnoresBaselineFailures(t *testing.T) {
+	suiteResult := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		{ProtoSpec: specWithScenarios("My Spec", map[string]gauge_messages.ExecutionStatus{
+			"Known failure": gauge_messages.ExecutionStatus_FAILED,
+			"New failure":   gauge_messages.ExecutionStatus_FAILED,
+			"Passing":       gauge_messages.ExecutionStatus_PASSED,
+		})},
+	}}
+	baseline := map[string]bool{"My Spec: Known failure": true}
+
+	regressions := newlyFailedScenarios(suiteResult, baseline)
+
+	if len(regressions) != 1 || regressions[0] != "My Spec: New failure" {
+		t.Errorf("regressions = %v, want only 'My Spec: New failure'", regressions)
+	}
+}
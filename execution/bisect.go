@@ -0,0 +1,166 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sync"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// BisectScenario is the failing scenario whose order dependency is being
+// hunted. When set, executions record the executed scenario order and the
+// target's outcome, so Bisect can re-run subsets of the preceding scenarios.
+var BisectScenario string
+
+var bisectObservation struct {
+	mutex        sync.Mutex
+	order        []string
+	targetFailed bool
+}
+
+// Bisect finds a minimal set of scenarios that, when executed before the
+// failing scenario, make it fail. It first runs the suite to record the
+// executed order and reproduce the failure, then repeatedly re-runs the
+// target with subsets of the preceding scenarios - halving the set while the
+// failure reproduces, then dropping leftover scenarios one at a time. This
+// automates the hunt for test pollution in order-dependent failures.
+func Bisect(specDirs []string) int {
+	logger.Infof(true, "Running the suite to record scenario order and reproduce the failure of '%s'.", BisectScenario)
+	order, failed := bisectRun(specDirs, nil)
+	candidates, executed := scenariosBefore(order, BisectScenario)
+	if !executed {
+		logger.Errorf(true, "Scenario '%s' was not executed. Check the scenario heading.", BisectScenario)
+		return ExecutionFailed
+	}
+	if !failed {
+		logger.Errorf(true, "Scenario '%s' passed in the recorded order. Nothing to bisect.", BisectScenario)
+		return ExecutionFailed
+	}
+	if len(candidates) == 0 {
+		logger.Infof(true, "Scenario '%s' fails with no preceding scenarios. The failure is not order-dependent.", BisectScenario)
+		return Success
+	}
+	minimal, confirmed := reduceCandidates(specDirs, candidates)
+	if !confirmed {
+		logger.Errorf(true, "Could not reproduce the failure of '%s' with any subset of the preceding scenarios. The failure may depend on the complete order or be flaky.", BisectScenario)
+		return ExecutionFailed
+	}
+	logger.Infof(true, "\nScenario '%s' fails when preceded by %d scenario(s):", BisectScenario, len(minimal))
+	for _, scenario := range minimal {
+		logger.Infof(true, "\t%s", scenario)
+	}
+	return Success
+}
+
+// reduceCandidates shrinks the preceding set while the target keeps failing.
+// It reports whether the failure was reproduced with the returned set.
+func reduceCandidates(specDirs []string, candidates []string) ([]string, bool) {
+	confirmed := false
+	for len(candidates) > 1 {
+		half := len(candidates) / 2
+		if _, failed := bisectRun(specDirs, candidates[:half]); failed {
+			candidates = candidates[:half]
+			confirmed = true
+			continue
+		}
+		if _, failed := bisectRun(specDirs, candidates[half:]); failed {
+			candidates = candidates[half:]
+			confirmed = true
+			continue
+		}
+		// the dependency spans both halves; fall back to dropping
+		// scenarios one at a time.
+		break
+	}
+	for i := 0; i < len(candidates) && len(candidates) > 1; {
+		trial := append(append([]string{}, candidates[:i]...), candidates[i+1:]...)
+		if _, failed := bisectRun(specDirs, trial); failed {
+			candidates = trial
+			confirmed = true
+		} else {
+			i++
+		}
+	}
+	if !confirmed {
+		_, confirmed = bisectRun(specDirs, candidates)
+	}
+	return candidates, confirmed
+}
+
+// bisectRun executes the specs, limited to the given preceding scenarios plus
+// the target when a subset is given, and reports the executed scenario order
+// and whether the target failed.
+func bisectRun(specDirs []string, preceding []string) ([]string, bool) {
+	if preceding != nil {
+		filter.ScenariosName = append(append([]string{}, preceding...), BisectScenario)
+		logger.Infof(true, "Re-running '%s' preceded by %d scenario(s).", BisectScenario, len(preceding))
+	}
+	bisectObservation.mutex.Lock()
+	bisectObservation.order = nil
+	bisectObservation.targetFailed = false
+	bisectObservation.mutex.Unlock()
+	executeSpecs(specDirs)
+	bisectObservation.mutex.Lock()
+	defer bisectObservation.mutex.Unlock()
+	return bisectObservation.order, bisectObservation.targetFailed
+}
+
+// scenariosBefore returns the scenarios executed before the first occurrence
+// of the target, and whether the target was executed at all.
+func scenariosBefore(order []string, target string) ([]string, bool) {
+	var preceding []string
+	for _, scenario := range order {
+		if scenario == target {
+			return preceding, true
+		}
+		preceding = append(preceding, scenario)
+	}
+	return preceding, false
+}
+
+// ListenScenarioEndForBisect records the executed scenario order and the
+// target scenario's outcome during a bisect run.
+func ListenScenarioEndForBisect(wg *sync.WaitGroup) {
+	if BisectScenario == "" {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.ScenarioEnd:
+				recordScenarioForBisect(e)
+			case event.SuiteEnd:
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func recordScenarioForBisect(e event.ExecutionEvent) {
+	res := e.Result.(*result.ScenarioResult)
+	if res.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		return
+	}
+	heading := res.ProtoScenario.GetScenarioHeading()
+	bisectObservation.mutex.Lock()
+	defer bisectObservation.mutex.Unlock()
+	bisectObservation.order = append(bisectObservation.order, heading)
+	if heading == BisectScenario && res.GetFailed() {
+		bisectObservation.targetFailed = true
+	}
+}
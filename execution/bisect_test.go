@@ -0,0 +1,36 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScenariosBeforeReturnsThePrecedingScenarios(t *testing.T) {
+	order := []string{"first", "second", "target", "later"}
+
+	preceding, executed := scenariosBefore(order, "target")
+
+	if !executed {
+		t.Error("expected target to be reported as executed")
+	}
+	if !reflect.DeepEqual(preceding, []string{"first", "second"}) {
+		t.Errorf("expected preceding scenarios [first second], got %v", preceding)
+	}
+}
+
+func TestScenariosBeforeWhenTargetWasNotExecuted(t *testing.T) {
+	preceding, executed := scenariosBefore([]string{"first", "second"}, "target")
+
+	if executed {
+		t.Error("expected target to be reported as not executed")
+	}
+	if len(preceding) != 2 {
+		t.Errorf("expected all scenarios as preceding, got %v", preceding)
+	}
+}
@@ -0,0 +1,112 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/util"
+)
+
+// Reproducible is the value of --reproducible. When set, volatile fields
+// (timestamps, durations) are zeroed in the canonical run result so two runs
+// with the same outcome serialize to the same bytes.
+var Reproducible bool
+
+// canonicalRunResult is the canonical JSON serialization of the last run,
+// written next to the protobuf result so CI systems can cache and compare
+// results byte-wise.
+const canonicalRunResult = "last_run_result.json"
+
+type canonicalScenarioResult struct {
+	Heading string `json:"heading"`
+	Status  string `json:"status"`
+}
+
+type canonicalSpecResult struct {
+	FileName      string                    `json:"fileName"`
+	Heading       string                    `json:"heading"`
+	Failed        bool                      `json:"failed"`
+	Skipped       bool                      `json:"skipped"`
+	ExecutionTime int64                     `json:"executionTime"`
+	Scenarios     []canonicalScenarioResult `json:"scenarios"`
+}
+
+type canonicalSuiteResult struct {
+	ProjectName       string                `json:"projectName"`
+	Environment       string                `json:"environment"`
+	Tags              string                `json:"tags"`
+	Timestamp         string                `json:"timestamp"`
+	Failed            bool                  `json:"failed"`
+	SpecsFailedCount  int                   `json:"specsFailedCount"`
+	SpecsSkippedCount int                   `json:"specsSkippedCount"`
+	ExecutionTime     int64                 `json:"executionTime"`
+	Specs             []canonicalSpecResult `json:"specs"`
+}
+
+// canonicalSuiteResultJSON serializes a suite result deterministically:
+// specs in file order, scenarios in document order, slash-separated relative
+// paths. With reproducible set, timestamps and durations are zeroed.
+func canonicalSuiteResultJSON(res *result.SuiteResult, reproducible bool) ([]byte, error) {
+	canonical := canonicalSuiteResult{
+		ProjectName:       res.ProjectName,
+		Environment:       res.Environment,
+		Tags:              res.Tags,
+		Timestamp:         res.Timestamp,
+		Failed:            res.IsFailed,
+		SpecsFailedCount:  res.SpecsFailedCount,
+		SpecsSkippedCount: res.SpecsSkippedCount,
+		ExecutionTime:     res.ExecutionTime,
+		Specs:             make([]canonicalSpecResult, 0, len(res.SpecResults)),
+	}
+	for _, specResult := range res.SpecResults {
+		spec := canonicalSpecResult{
+			FileName:      filepath.ToSlash(util.RelPathToProjectRoot(specResult.ProtoSpec.GetFileName())),
+			Heading:       specResult.ProtoSpec.GetSpecHeading(),
+			Failed:        specResult.IsFailed,
+			Skipped:       specResult.Skipped,
+			ExecutionTime: specResult.ExecutionTime,
+			Scenarios:     make([]canonicalScenarioResult, 0),
+		}
+		for _, item := range specResult.ProtoSpec.GetItems() {
+			var scenario *gauge_messages.ProtoScenario
+			switch item.GetItemType() {
+			case gauge_messages.ProtoItem_Scenario:
+				scenario = item.GetScenario()
+			case gauge_messages.ProtoItem_TableDrivenScenario:
+				scenario = item.GetTableDrivenScenario().GetScenario()
+			default:
+				continue
+			}
+			spec.Scenarios = append(spec.Scenarios, canonicalScenarioResult{
+				Heading: scenario.GetScenarioHeading(),
+				Status:  strings.ToLower(scenario.GetExecutionStatus().String()),
+			})
+		}
+		canonical.Specs = append(canonical.Specs, spec)
+	}
+	sort.SliceStable(canonical.Specs, func(i, j int) bool {
+		return canonical.Specs[i].FileName < canonical.Specs[j].FileName
+	})
+	if reproducible {
+		canonical.Timestamp = ""
+		canonical.ExecutionTime = 0
+		for i := range canonical.Specs {
+			canonical.Specs[i].ExecutionTime = 0
+		}
+	}
+	serialized, err := json.MarshalIndent(canonical, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(serialized, '\n'), nil
+}
@@ -0,0 +1,86 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func canonicalTestSuiteResult(specOrder ...string) *result.SuiteResult {
+	res := &result.SuiteResult{ProjectName: "sample", Environment: "default", Timestamp: "Aug 30, 2026 at 10:00am", ExecutionTime: 1200}
+	executionTimes := map[string]int64{"a.spec": 100, "b.spec": 200}
+	for _, fileName := range specOrder {
+		res.SpecResults = append(res.SpecResults, &result.SpecResult{
+			ProtoSpec: &gauge_messages.ProtoSpec{
+				FileName:    fileName,
+				SpecHeading: "Spec " + fileName,
+				Items: []*gauge_messages.ProtoItem{{
+					ItemType: gauge_messages.ProtoItem_Scenario,
+					Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "First", ExecutionStatus: gauge_messages.ExecutionStatus_PASSED},
+				}},
+			},
+			ExecutionTime: executionTimes[fileName],
+		})
+	}
+	return res
+}
+
+func TestCanonicalResultIsIndependentOfCompletionOrder(t *testing.T) {
+	first, err := canonicalSuiteResultJSON(canonicalTestSuiteResult("a.spec", "b.spec"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := canonicalSuiteResultJSON(canonicalTestSuiteResult("b.spec", "a.spec"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(first, second) {
+		t.Errorf("Expected identical canonical bytes regardless of completion order:\n%s\n%s", first, second)
+	}
+}
+
+func TestReproducibleCanonicalResultZeroesVolatileFields(t *testing.T) {
+	serialized, err := canonicalSuiteResultJSON(canonicalTestSuiteResult("a.spec"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var canonical canonicalSuiteResult
+	if err := json.Unmarshal(serialized, &canonical); err != nil {
+		t.Fatal(err)
+	}
+	if canonical.Timestamp != "" || canonical.ExecutionTime != 0 {
+		t.Errorf("Expected timestamp and execution time to be zeroed, got %q and %d", canonical.Timestamp, canonical.ExecutionTime)
+	}
+	if canonical.Specs[0].ExecutionTime != 0 {
+		t.Errorf("Expected spec execution time to be zeroed, got %d", canonical.Specs[0].ExecutionTime)
+	}
+}
+
+func TestCanonicalResultRecordsScenarioStatuses(t *testing.T) {
+	serialized, err := canonicalSuiteResultJSON(canonicalTestSuiteResult("a.spec"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var canonical canonicalSuiteResult
+	if err := json.Unmarshal(serialized, &canonical); err != nil {
+		t.Fatal(err)
+	}
+	if len(canonical.Specs[0].Scenarios) != 1 {
+		t.Fatalf("Expected 1 scenario, got %d", len(canonical.Specs[0].Scenarios))
+	}
+	if canonical.Specs[0].Scenarios[0].Status != "passed" {
+		t.Errorf("Scenario status = %s, want passed", canonical.Specs[0].Scenarios[0].Status)
+	}
+}
@@ -0,0 +1,108 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/getgauge/gauge/execution/rerun"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/validation"
+)
+
+// Modes accepted by --continue-on-failure. The mode decides how much of the
+// run keeps executing after a step fails, overriding the ContinueOnFailure
+// attributes declared on individual step implementations.
+const (
+	// scenarioGranularity keeps executing the remaining steps of the failing
+	// scenario, treating every step failure as recoverable.
+	scenarioGranularity = "scenario"
+	// specGranularity aborts the failing scenario but executes the remaining
+	// scenarios, suppressing per-implementation recoverable attributes.
+	specGranularity = "spec"
+	// noneGranularity stops scheduling new work after the first failure; the
+	// remaining specs are skipped and recorded for `gauge run --failed`.
+	noneGranularity = "none"
+)
+
+// ContinueOnFailure is the value of --continue-on-failure. Empty leaves the
+// per-implementation ContinueOnFailure attributes in effect.
+var ContinueOnFailure string
+
+const failureAbortSkipReason = "Skipped: an earlier failure aborted the run (--continue-on-failure=none)"
+
+var runHasFailure int32
+var failureAbortNotice sync.Once
+
+// startFailureTracking resets the failure flag when a run starts, so repeated
+// and rerun executions begin with a clean slate.
+func startFailureTracking() {
+	atomic.StoreInt32(&runHasFailure, 0)
+	failureAbortNotice = sync.Once{}
+}
+
+func isValidContinueOnFailure(mode string) bool {
+	switch strings.ToLower(mode) {
+	case "", scenarioGranularity, specGranularity, noneGranularity:
+		return true
+	}
+	return false
+}
+
+// overrideRecoverable applies the --continue-on-failure mode to the
+// recoverable verdict of a failed step. Without a mode the verdict of the
+// step implementation stands.
+func overrideRecoverable(recoverable bool) bool {
+	switch strings.ToLower(ContinueOnFailure) {
+	case scenarioGranularity:
+		return true
+	case specGranularity, noneGranularity:
+		return false
+	default:
+		return recoverable
+	}
+}
+
+// recordFailureForAbort remembers that the run has seen a failure, so
+// --continue-on-failure=none can stop scheduling further work.
+func recordFailureForAbort() {
+	atomic.StoreInt32(&runHasFailure, 1)
+}
+
+// abortOnFailure reports whether the run should stop scheduling new work
+// because a failure occurred under --continue-on-failure=none.
+func abortOnFailure() bool {
+	return strings.ToLower(ContinueOnFailure) == noneGranularity && atomic.LoadInt32(&runHasFailure) == 1
+}
+
+// skipSpecForAbort marks every scenario of spec as skipped, the same way
+// validation errors do, so the spec still flows through execution events and
+// reports. The spec is recorded in the failed-run metadata so the next
+// `gauge run --failed` executes the remainder.
+func skipSpecForAbort(spec *gauge.Specification, errMap *gauge.BuildErrors) {
+	failureAbortNotice.Do(func() {
+		logger.Infof(true, "A failure occurred, skipping the remaining specifications (--continue-on-failure=none).")
+	})
+	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: spec.Heading.LineNo, LineText: spec.Heading.Value},
+		failureAbortSkipReason, spec.FileName, nil, "")
+	for _, scenario := range spec.Scenarios {
+		errMap.ScenarioErrs[scenario] = []error{validationError}
+	}
+	errMap.SpecErrs[spec] = []error{validationError}
+	rerun.MarkForRerun(spec.FileName)
+}
+
+// skipScenarioForAbort marks a single scenario as skipped when an earlier
+// failure aborted the run mid-spec.
+func skipScenarioForAbort(spec *gauge.Specification, scenario *gauge.Scenario, errMap *gauge.BuildErrors) {
+	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: scenario.Heading.LineNo, LineText: scenario.Heading.Value},
+		failureAbortSkipReason, spec.FileName, nil, "")
+	errMap.ScenarioErrs[scenario] = []error{validationError}
+}
@@ -0,0 +1,117 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestContinueOnFailureModeValidation(t *testing.T) {
+	for _, mode := range []string{"", "scenario", "spec", "none", "Scenario", "NONE"} {
+		if !isValidContinueOnFailure(mode) {
+			t.Errorf("Expected %q to be a valid --continue-on-failure mode", mode)
+		}
+	}
+	if isValidContinueOnFailure("step") {
+		t.Error("Expected 'step' to be rejected by --continue-on-failure validation")
+	}
+}
+
+func TestOverrideRecoverableLeavesTheVerdictWithoutAMode(t *testing.T) {
+	ContinueOnFailure = ""
+
+	if !overrideRecoverable(true) || overrideRecoverable(false) {
+		t.Error("Expected the per-implementation recoverable verdict to stand without a mode")
+	}
+}
+
+func TestOverrideRecoverablePerMode(t *testing.T) {
+	defer func() { ContinueOnFailure = "" }()
+
+	ContinueOnFailure = "scenario"
+	if !overrideRecoverable(false) {
+		t.Error("Expected scenario granularity to treat every failure as recoverable")
+	}
+
+	ContinueOnFailure = "spec"
+	if overrideRecoverable(true) {
+		t.Error("Expected spec granularity to suppress recoverable attributes")
+	}
+
+	ContinueOnFailure = "none"
+	if overrideRecoverable(true) {
+		t.Error("Expected none granularity to suppress recoverable attributes")
+	}
+}
+
+func TestAbortOnFailureOnlyUnderNoneGranularity(t *testing.T) {
+	defer func() { ContinueOnFailure = "" }()
+	startFailureTracking()
+	recordFailureForAbort()
+
+	ContinueOnFailure = "spec"
+	if abortOnFailure() {
+		t.Error("Expected no abort when --continue-on-failure is not none")
+	}
+
+	ContinueOnFailure = "none"
+	if !abortOnFailure() {
+		t.Error("Expected an abort after a failure under --continue-on-failure=none")
+	}
+
+	startFailureTracking()
+	if abortOnFailure() {
+		t.Error("Expected a fresh run to start without a recorded failure")
+	}
+}
+
+func TestSkipSpecForAbortMarksEveryScenarioSkipped(t *testing.T) {
+	scenario1 := &gauge.Scenario{Heading: &gauge.Heading{Value: "First"}}
+	scenario2 := &gauge.Scenario{Heading: &gauge.Heading{Value: "Second"}}
+	spec := &gauge.Specification{
+		Heading:   &gauge.Heading{Value: "Spec", LineNo: 1},
+		FileName:  "example.spec",
+		Scenarios: []*gauge.Scenario{scenario1, scenario2},
+	}
+	errMap := gauge.NewBuildErrors()
+
+	skipSpecForAbort(spec, errMap)
+
+	if len(errMap.SpecErrs[spec]) != 1 {
+		t.Fatalf("Expected 1 spec error, got %d", len(errMap.SpecErrs[spec]))
+	}
+	for _, scenario := range spec.Scenarios {
+		if len(errMap.ScenarioErrs[scenario]) != 1 {
+			t.Errorf("Expected scenario %s to carry a skip reason", scenario.Heading.Value)
+		}
+	}
+}
+
+func TestSkipScenarioForAbortMarksASingleScenario(t *testing.T) {
+	scenario1 := &gauge.Scenario{Heading: &gauge.Heading{Value: "First"}}
+	scenario2 := &gauge.Scenario{Heading: &gauge.Heading{Value: "Second"}}
+	spec := &gauge.Specification{
+		Heading:   &gauge.Heading{Value: "Spec", LineNo: 1},
+		FileName:  "example.spec",
+		Scenarios: []*gauge.Scenario{scenario1, scenario2},
+	}
+	errMap := gauge.NewBuildErrors()
+
+	skipScenarioForAbort(spec, scenario2, errMap)
+
+	if len(errMap.ScenarioErrs[scenario2]) != 1 {
+		t.Fatalf("Expected the aborted scenario to carry a skip reason")
+	}
+	if len(errMap.ScenarioErrs[scenario1]) != 0 {
+		t.Error("Expected the other scenario to stay untouched")
+	}
+	if len(errMap.SpecErrs[spec]) != 0 {
+		t.Error("Expected no spec level error for a mid-spec abort")
+	}
+}
@@ -0,0 +1,80 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"time"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+)
+
+// DryRun walks the specs without sending any execution messages to the runner.
+// Specs are parsed, concepts and data tables resolved and steps validated as
+// usual, but every scenario ends up skipped in the suite result. Useful for CI
+// gating on spec integrity without executing anything.
+var DryRun bool
+
+type dryRunExecution struct {
+	specCollection *gauge.SpecCollection
+	runner         runner.Runner
+	errMaps        *gauge.BuildErrors
+	suiteResult    *result.SuiteResult
+	startTime      time.Time
+}
+
+func newDryRunExecution(executionInfo *executionInfo) *dryRunExecution {
+	return &dryRunExecution{
+		specCollection: executionInfo.specs,
+		runner:         executionInfo.runner,
+		errMaps:        executionInfo.errMaps,
+	}
+}
+
+func (e *dryRunExecution) run() *result.SuiteResult {
+	e.startTime = time.Now()
+	logger.Debug(true, "Dry run started, no steps will be executed.")
+	event.Notify(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, gauge_messages.ExecutionInfo{}))
+	e.suiteResult = result.NewSuiteResult(ExecuteTags, e.startTime)
+	for e.specCollection.HasNext() {
+		for _, spec := range e.specCollection.Next() {
+			e.suiteResult.AddSpecResult(skippedSpecResult(spec))
+		}
+	}
+	e.suiteResult.UpdateExecTime(e.startTime)
+	e.suiteResult.SetSpecsSkippedCount()
+	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, gauge_messages.ExecutionInfo{}))
+	if err := e.runner.Kill(); err != nil {
+		logger.Errorf(true, "Failed to kill Runner: %s", err.Error())
+	}
+	return e.suiteResult
+}
+
+func skippedSpecResult(spec *gauge.Specification) *result.SpecResult {
+	protoSpec := gauge.ConvertToProtoSpec(spec)
+	for _, item := range protoSpec.Items {
+		scenario := item.GetScenario()
+		if scenario == nil && item.GetTableDrivenScenario() != nil {
+			scenario = item.GetTableDrivenScenario().GetScenario()
+		}
+		if scenario != nil {
+			scenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
+			scenario.Skipped = true
+			scenario.SkipErrors = []string{"Dry run, skipping execution"}
+		}
+	}
+	return &result.SpecResult{
+		ProtoSpec:            protoSpec,
+		Skipped:              true,
+		ScenarioCount:        len(spec.Scenarios),
+		ScenarioSkippedCount: len(spec.Scenarios),
+	}
+}
@@ -0,0 +1,91 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+const scenarioDurations = "scenario_durations.json"
+
+// minimum baseline duration considered for regression alerts; very short
+// scenarios are too noisy to compare meaningfully.
+const minBaselineDuration = int64(500)
+
+type durationBaseline map[string]int64
+
+// checkDurationRegressions compares each passed scenario's duration against the
+// baseline recorded in earlier runs and flags the ones that slowed down by more
+// than the configured threshold. The alerts are kept on the suite result for
+// reporters and the baseline is updated with the latest durations.
+func checkDurationRegressions(res *result.SuiteResult) {
+	threshold := config.ScenarioRegressionThreshold()
+	baseline := loadDurationBaseline()
+	for _, specRes := range res.SpecResults {
+		if specRes.Skipped || specRes.ProtoSpec == nil {
+			continue
+		}
+		for _, item := range specRes.ProtoSpec.Items {
+			scenario := item.GetScenario()
+			if scenario == nil && item.GetTableDrivenScenario() != nil {
+				scenario = item.GetTableDrivenScenario().GetScenario()
+			}
+			if scenario == nil || scenario.GetExecutionStatus() != gauge_messages.ExecutionStatus_PASSED {
+				continue
+			}
+			key := fmt.Sprintf("%s::%s", specRes.ProtoSpec.GetFileName(), scenario.GetScenarioHeading())
+			duration := scenario.GetExecutionTime()
+			if old, ok := baseline[key]; ok && threshold > 0 && old >= minBaselineDuration {
+				allowed := old + old*int64(threshold)/100
+				if duration > allowed {
+					alert := fmt.Sprintf("%s: took %dms, baseline is %dms (threshold %d%%)", key, duration, old, threshold)
+					res.DurationRegressions = append(res.DurationRegressions, alert)
+					logger.Warningf(true, "Scenario duration regression => %s", alert)
+				}
+			}
+			baseline[key] = duration
+		}
+	}
+	saveDurationBaseline(baseline)
+}
+
+func durationBaselineFile() string {
+	return filepath.Join(config.ProjectRoot, dotGauge, scenarioDurations)
+}
+
+func loadDurationBaseline() durationBaseline {
+	baseline := durationBaseline{}
+	contents, err := ioutil.ReadFile(durationBaselineFile())
+	if err != nil {
+		return baseline
+	}
+	if err = json.Unmarshal(contents, &baseline); err != nil {
+		logger.Debugf(true, "Unable to read scenario duration baseline: %s", err.Error())
+		return durationBaseline{}
+	}
+	return baseline
+}
+
+func saveDurationBaseline(baseline durationBaseline) {
+	contents, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		logger.Debugf(true, "Unable to marshal scenario duration baseline: %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(durationBaselineFile(), contents, common.NewFilePermissions); err != nil {
+		logger.Debugf(true, "Unable to save scenario duration baseline: %s", err.Error())
+	}
+}
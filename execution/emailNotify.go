@@ -0,0 +1,130 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+// Notify enables the post-run email summary, set from the --notify flag. The
+// SMTP server and the distribution list come from the smtp_* and
+// notification_emails properties.
+var Notify bool
+
+// sendMail delivers the rendered message; a variable so tests can capture it
+// without a real SMTP server.
+var sendMail = smtp.SendMail
+
+// emailBodyTemplate renders the HTML summary from the suite result.
+var emailBodyTemplate = template.Must(template.New("emailBody").Parse(`<html>
+<body>
+<h2>Gauge run {{.Status}}</h2>
+<table>
+<tr><td>Scenarios passed</td><td>{{.Passed}}</td></tr>
+<tr><td>Scenarios failed</td><td>{{.Failed}}</td></tr>
+<tr><td>Scenarios skipped</td><td>{{.Skipped}}</td></tr>
+<tr><td>Total time</td><td>{{.TotalTime}}</td></tr>
+</table>
+<p>Report: <a href="{{.ReportLink}}">{{.ReportLink}}</a></p>
+</body>
+</html>
+`))
+
+type emailSummary struct {
+	Status     string
+	Passed     int
+	Failed     int
+	Skipped    int
+	TotalTime  string
+	ReportLink string
+}
+
+// notifyByEmail sends the execution summary to the configured distribution
+// list when --notify is given. Delivery failures are only logged; they never
+// fail the run.
+func notifyByEmail(suiteResult *result.SuiteResult) {
+	if !Notify {
+		return
+	}
+	host := env.SMTPHost()
+	if host == "" {
+		logger.Warningf(true, "Cannot send the email summary, smtp_host is not configured.")
+		return
+	}
+	recipients := env.NotificationEmails()
+	if len(recipients) == 0 {
+		logger.Warningf(true, "Cannot send the email summary, notification_emails is not configured.")
+		return
+	}
+	message, err := emailMessage(suiteResult, recipients)
+	if err != nil {
+		logger.Warningf(true, "Unable to build the email summary: %s", err.Error())
+		return
+	}
+	var auth smtp.Auth
+	if username := env.SMTPUsername(); username != "" {
+		auth = smtp.PlainAuth("", username, env.SMTPPassword(), host)
+	}
+	addr := fmt.Sprintf("%s:%d", host, env.SMTPPort())
+	if err := sendMail(addr, auth, env.SMTPFrom(), recipients, message); err != nil {
+		logger.Warningf(true, "Unable to send the email summary: %s", err.Error())
+		return
+	}
+	logger.Infof(true, "Email summary sent to %s", strings.Join(recipients, ", "))
+}
+
+// emailMessage renders the full RFC 822 message: headers plus the templated
+// HTML body.
+func emailMessage(suiteResult *result.SuiteResult, recipients []string) ([]byte, error) {
+	summary := summarize(suiteResult)
+	body := &bytes.Buffer{}
+	if err := emailBodyTemplate.Execute(body, summary); err != nil {
+		return nil, err
+	}
+	headers := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/html; charset=UTF-8\r\n\r\n",
+		env.SMTPFrom(), strings.Join(recipients, ", "), emailSubject(summary))
+	return append([]byte(headers), body.Bytes()...), nil
+}
+
+func emailSubject(summary emailSummary) string {
+	return fmt.Sprintf("Gauge run %s: %d passed, %d failed, %d skipped", summary.Status, summary.Passed, summary.Failed, summary.Skipped)
+}
+
+func summarize(suiteResult *result.SuiteResult) emailSummary {
+	executed, failed, skipped := 0, 0, 0
+	for _, specResult := range suiteResult.SpecResults {
+		executed += specResult.ScenarioCount
+		failed += specResult.ScenarioFailedCount
+		skipped += specResult.ScenarioSkippedCount
+	}
+	executed -= skipped
+	passed := executed - failed
+	if passed < 0 {
+		passed = 0
+	}
+	status := "passed"
+	if suiteResult.IsFailed {
+		status = "failed"
+	}
+	return emailSummary{
+		Status:     status,
+		Passed:     passed,
+		Failed:     failed,
+		Skipped:    skipped,
+		TotalTime:  fmt.Sprint(time.Millisecond * time.Duration(suiteResult.ExecutionTime)),
+		ReportLink: reportLink(),
+	}
+}
@@ -0,0 +1,88 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+)
+
+func stubSMTPProperties(t *testing.T, host string, recipients []string) {
+	t.Helper()
+	oldHost, oldEmails, oldFrom, oldNotify := env.SMTPHost, env.NotificationEmails, env.SMTPFrom, Notify
+	env.SMTPHost = func() string { return host }
+	env.NotificationEmails = func() []string { return recipients }
+	env.SMTPFrom = func() string { return "gauge@example.com" }
+	Notify = true
+	t.Cleanup(func() {
+		env.SMTPHost = oldHost
+		env.NotificationEmails = oldEmails
+		env.SMTPFrom = oldFrom
+		Notify = oldNotify
+	})
+}
+
+func TestNotifyByEmailSendsRenderedSummary(t *testing.T) {
+	stubSMTPProperties(t, "mail.example.com", []string{"team@example.com"})
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotMessage []byte
+	oldSendMail := sendMail
+	sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotMessage = addr, from, to, msg
+		return nil
+	}
+	defer func() { sendMail = oldSendMail }()
+
+	notifyByEmail(suiteResultWithCounts(true))
+
+	if gotAddr != "mail.example.com:587" || gotFrom != "gauge@example.com" {
+		t.Errorf("sent via %s from %s, want mail.example.com:587 from gauge@example.com", gotAddr, gotFrom)
+	}
+	if len(gotTo) != 1 || gotTo[0] != "team@example.com" {
+		t.Errorf("recipients = %v, want the configured distribution list", gotTo)
+	}
+	message := string(gotMessage)
+	for _, fragment := range []string{
+		"Subject: Gauge run failed: 2 passed, 1 failed, 1 skipped",
+		"Content-Type: text/html",
+		"<h2>Gauge run failed</h2>",
+		"html-report",
+	} {
+		if !strings.Contains(message, fragment) {
+			t.Errorf("message does not contain %q:\n%s", fragment, message)
+		}
+	}
+}
+
+func TestNotifyByEmailIsGatedBehindNotifyFlag(t *testing.T) {
+	stubSMTPProperties(t, "mail.example.com", []string{"team@example.com"})
+	Notify = false
+	oldSendMail := sendMail
+	sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		t.Error("no mail should be sent without --notify")
+		return nil
+	}
+	defer func() { sendMail = oldSendMail }()
+
+	notifyByEmail(suiteResultWithCounts(false))
+}
+
+func TestNotifyByEmailWarnsOnMissingConfiguration(t *testing.T) {
+	stubSMTPProperties(t, "", nil)
+	oldSendMail := sendMail
+	sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		t.Error("no mail should be sent without an SMTP host")
+		return nil
+	}
+	defer func() { sendMail = oldSendMail }()
+
+	notifyByEmail(suiteResultWithCounts(false))
+}
@@ -0,0 +1,118 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+type envAudit struct {
+	mutex     sync.Mutex
+	snapshot  map[string]string
+	mutations []string
+}
+
+// ListenForEnvMutations watches the environment across scenario boundaries
+// and records variables that were added, removed or changed while the suite
+// ran. Mutations are attributed to the scenario after which they were first
+// observed, reported in the suite result and logged, to help debug state
+// leaking between scenarios and streams.
+func ListenForEnvMutations(wg *sync.WaitGroup) {
+	if !env.AuditEnvMutations() {
+		return
+	}
+	audit := &envAudit{snapshot: environSnapshot()}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.ScenarioEnd:
+				heading := ""
+				if scenario := e.ExecutionInfo.GetCurrentScenario(); scenario != nil {
+					heading = scenario.GetName()
+				}
+				where := fmt.Sprintf("scenario %s: %s", e.ExecutionInfo.GetCurrentSpec().GetFileName(), heading)
+				audit.recordMutations(where)
+			case event.SuiteEnd:
+				audit.recordMutations("suite teardown")
+				audit.report(e.Result.(*result.SuiteResult))
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func environSnapshot() map[string]string {
+	snapshot := make(map[string]string)
+	for _, entry := range os.Environ() {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 2 {
+			snapshot[parts[0]] = parts[1]
+		}
+	}
+	return snapshot
+}
+
+// recordMutations diffs the current environment against the last snapshot and
+// attributes every difference to the given execution point. The snapshot then
+// moves forward, so later changes are attributed to later scenarios.
+func (a *envAudit) recordMutations(where string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	current := environSnapshot()
+	var keys []string
+	for key := range a.snapshot {
+		keys = append(keys, key)
+	}
+	for key := range current {
+		if _, ok := a.snapshot[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		old, hadOld := a.snapshot[key]
+		value, hasNew := current[key]
+		switch {
+		case !hadOld:
+			a.mutations = append(a.mutations, fmt.Sprintf("%s was set to %q during %s", key, value, where))
+		case !hasNew:
+			a.mutations = append(a.mutations, fmt.Sprintf("%s (was %q) was unset during %s", key, old, where))
+		case old != value:
+			a.mutations = append(a.mutations, fmt.Sprintf("%s was changed from %q to %q during %s", key, old, value, where))
+		}
+	}
+	a.snapshot = current
+}
+
+func (a *envAudit) report(res *result.SuiteResult) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	res.EnvMutations = a.mutations
+	if len(a.mutations) == 0 {
+		logger.Debugf(true, "Environment audit: no mutations detected during execution.")
+		return
+	}
+	logger.Warningf(true, "\nEnvironment variables mutated during execution:")
+	for _, mutation := range a.mutations {
+		logger.Warningf(true, "\t%s", mutation)
+	}
+}
@@ -0,0 +1,56 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRecordMutationsDetectsSetChangeAndUnset(t *testing.T) {
+	defer os.Unsetenv("gauge_env_audit_test_added")
+	defer os.Unsetenv("gauge_env_audit_test_changed")
+	os.Setenv("gauge_env_audit_test_changed", "before")
+	os.Setenv("gauge_env_audit_test_removed", "doomed")
+	audit := &envAudit{snapshot: environSnapshot()}
+
+	os.Setenv("gauge_env_audit_test_added", "new")
+	os.Setenv("gauge_env_audit_test_changed", "after")
+	os.Unsetenv("gauge_env_audit_test_removed")
+	audit.recordMutations("scenario one.spec: First")
+
+	if len(audit.mutations) != 3 {
+		t.Fatalf("Expected 3 mutations, got %v", audit.mutations)
+	}
+	expectations := []string{
+		`gauge_env_audit_test_added was set to "new" during scenario one.spec: First`,
+		`gauge_env_audit_test_changed was changed from "before" to "after" during scenario one.spec: First`,
+		`gauge_env_audit_test_removed (was "doomed") was unset during scenario one.spec: First`,
+	}
+	for i, expected := range expectations {
+		if audit.mutations[i] != expected {
+			t.Errorf("Expected mutation %q, got %q", expected, audit.mutations[i])
+		}
+	}
+}
+
+func TestRecordMutationsAttributesChangesToTheScenarioObservedAfter(t *testing.T) {
+	defer os.Unsetenv("gauge_env_audit_test_later")
+	audit := &envAudit{snapshot: environSnapshot()}
+
+	audit.recordMutations("scenario one.spec: First")
+	os.Setenv("gauge_env_audit_test_later", "leak")
+	audit.recordMutations("scenario one.spec: Second")
+
+	if len(audit.mutations) != 1 {
+		t.Fatalf("Expected 1 mutation, got %v", audit.mutations)
+	}
+	if !strings.Contains(audit.mutations[0], "scenario one.spec: Second") {
+		t.Errorf("Expected mutation attributed to the second scenario, got %q", audit.mutations[0])
+	}
+}
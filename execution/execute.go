@@ -40,6 +40,7 @@ import (
 	"path/filepath"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/annotations"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/event"
@@ -48,7 +49,9 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin/install"
+	"github.com/getgauge/gauge/project"
 	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/stats"
 	"github.com/getgauge/gauge/validation"
 )
 
@@ -78,6 +81,13 @@ var MachineReadable bool
 
 var ExecutionArgs []*gauge.ExecutionArg
 
+// CustomListeners holds listener registrations supplied by embedders of
+// gauge (see pkg/gauge). The event registry is recreated for every suite
+// run, so subscribing up front is not possible; functions appended here are
+// invoked alongside gauge's own listeners each time a run initialises the
+// registry.
+var CustomListeners []func(wg *sync.WaitGroup)
+
 type suiteExecutor interface {
 	run() *result.SuiteResult
 }
@@ -86,6 +96,17 @@ type executor interface {
 	execute(i gauge.Item, r result.Result)
 }
 
+// ExecuteSpecsInProject activates the given project and executes the specs
+// in it. It is the project-scoped entry point replacing the package-level
+// globals ExecuteSpecs still reads, so embedders can run several projects
+// from one process.
+func ExecuteSpecsInProject(p *project.Project, specDirs []string) (int, error) {
+	if err := p.Activate(); err != nil {
+		return ExecutionFailed, err
+	}
+	return ExecuteSpecs(specDirs), nil
+}
+
 // ExecuteSpecs : Check for updates, validates the specs (by invoking the respective language runners), initiates the registry which is needed for console reporting, execution API and Rerunning of specs
 // and finally saves the execution result as binary in .gauge folder.
 var ExecuteSpecs = func(specDirs []string) int {
@@ -99,7 +120,20 @@ var ExecuteSpecs = func(specDirs []string) int {
 		defer i.PrintUpdateBuffer()
 	}
 	skel.SetupPlugins(MachineReadable)
-	err = os.Setenv(gaugeParallelStreamCountEnv, strconv.Itoa(NumberOfExecutionStreams))
+	if len(Environments) > 1 {
+		return executePerEnvironment(specDirs)
+	}
+	if SuiteTableFile != "" {
+		return executeSuiteDataDriven(specDirs)
+	}
+	if RepeatCount > 1 {
+		return executeRepeatedly(specDirs)
+	}
+	return executeSpecs(specDirs)
+}
+
+func executeSpecs(specDirs []string) int {
+	err := os.Setenv(gaugeParallelStreamCountEnv, strconv.Itoa(NumberOfExecutionStreams))
 	if err != nil {
 		logger.Fatalf(true, "failed to set env %s. %s", gaugeParallelStreamCountEnv, err.Error())
 	}
@@ -126,26 +160,43 @@ var ExecuteSpecs = func(specDirs []string) int {
 	wg := &sync.WaitGroup{}
 	reporter.ListenExecutionEvents(wg)
 	rerun.ListenFailedScenarios(wg, specDirs)
+	ListenSpecEndAndPublishPartialResults(wg)
+	ListenScenarioEndForFlakiness(wg)
+	ListenScenarioEndForBisect(wg)
+	ListenScenarioEndForFuzzing(wg)
+	ListenForEnvMutations(wg)
 	if env.SaveExecutionResult() {
 		ListenSuiteEndAndSaveResult(wg)
 	}
+	if env.GenerateTraceabilityMatrix() {
+		ListenSuiteEndAndWriteTraceabilityMatrix(wg)
+	}
+	for _, listen := range CustomListeners {
+		listen(wg)
+	}
 	defer wg.Wait()
 	ei := newExecutionInfo(res.SpecCollection, res.Runner, nil, res.ErrMap, InParallel, 0)
 
 	e := ei.getExecutor()
+	startBudget()
+	startFailureTracking()
 	logger.Debug(true, "Run started")
-	return printExecutionResult(e.run(), res.ParseOk)
+	suiteRes := e.run()
+	if suiteParameterSet != "" {
+		suiteRes.ParameterSet = suiteParameterSet
+	}
+	return printExecutionResult(suiteRes, res.ParseOk)
 }
 
 func writeExecutionResult(content string) {
 	executionStatusFile := filepath.Join(config.ProjectRoot, common.DotGauge, executionStatusFile)
 	dotGaugeDir := filepath.Join(config.ProjectRoot, common.DotGauge)
 	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
-		logger.Fatalf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+		logger.Fatalf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, classifyInfraError(err).Error())
 	}
 	err := ioutil.WriteFile(executionStatusFile, []byte(content), common.NewFilePermissions)
 	if err != nil {
-		logger.Fatalf(true, "Failed to write to %s. Reason: %s", executionStatusFile, err.Error())
+		logger.Fatalf(true, "Failed to write to %s. Reason: %s", executionStatusFile, classifyInfraError(err).Error())
 	}
 }
 
@@ -196,12 +247,42 @@ func printExecutionResult(suiteResult *result.SuiteResult, isParsingOk bool) int
 	logger.Infof(true, "Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs)
 	logger.Infof(true, "Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
 	logger.Infof(true, "\nTotal time taken: %s", time.Millisecond*time.Duration(suiteResult.ExecutionTime))
+	if len(suiteResult.DurationRegressions) > 0 {
+		logger.Infof(true, "\nScenario duration regressions:")
+		for _, regression := range suiteResult.DurationRegressions {
+			logger.Infof(true, "\t%s", regression)
+		}
+	}
+	if flaky := stats.FlakyCandidates(); len(flaky) > 0 {
+		logger.Infof(true, "\nPossibly flaky scenarios (pass/fail alternated across recent runs):")
+		for _, scenario := range flaky {
+			logger.Infof(true, "\t%5.1f%%  %s: %s", scenario.Score, scenario.Spec, scenario.Scenario)
+		}
+		logger.Infof(true, "Run 'gauge stats' for details.")
+	}
+	printSlowestSteps(suiteResult)
+	if suiteResult.IsFailed {
+		printFailureSummary(suiteResult)
+	}
+	printHookFailures(suiteResult)
+	if reportFile, err := annotations.Flush(); err != nil {
+		logger.Errorf(true, "Unable to write code quality report. %s", err.Error())
+	} else if reportFile != "" {
+		logger.Infof(true, "Code quality report written to %s", reportFile)
+	}
 	writeExecutionResult(s)
+	notifyRunCompletion(suiteResult)
+	notifyWebhook(suiteResult)
+	notifyByEmail(suiteResult)
+	notifyResultUploader(suiteResult)
 
 	if !isParsingOk {
 		return ParseFailed
 	}
 	if suiteResult.IsFailed {
+		if BaselineFile != "" {
+			return checkAgainstBaseline(suiteResult)
+		}
 		return ExecutionFailed
 	}
 	return Success
@@ -211,6 +292,9 @@ func validateFlags() error {
 	if MaxRetriesCount < 1 {
 		return fmt.Errorf("invalid input(%s) to --max-retries-count flag", strconv.Itoa(MaxRetriesCount))
 	}
+	if !isValidContinueOnFailure(ContinueOnFailure) {
+		return fmt.Errorf("invalid input(%s) to --continue-on-failure flag", ContinueOnFailure)
+	}
 	if !InParallel {
 		return nil
 	}
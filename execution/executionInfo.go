@@ -45,6 +45,9 @@ func newExecutionInfo(s *gauge.SpecCollection, r runner.Runner, ph plugin.Handle
 }
 
 func (executionInfo *executionInfo) getExecutor() suiteExecutor {
+	if DryRun {
+		return newDryRunExecution(executionInfo)
+	}
 	if executionInfo.inParallel {
 		return newParallelExecution(executionInfo)
 	}
@@ -0,0 +1,169 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"strings"
+
+	ct "github.com/daviddengcn/go-colortext"
+	"github.com/getgauge/gauge/annotations"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// stackTraceSnippetLines is the number of stack-trace lines shown per failure
+// in the summary; the full trace is available in the logs and reports.
+const stackTraceSnippetLines = 3
+
+type scenarioFailure struct {
+	heading      string
+	line         int
+	step         string
+	errorMessage string
+	stackTrace   string
+	rerunCommand string
+}
+
+type specFailures struct {
+	heading   string
+	fileName  string
+	scenarios []scenarioFailure
+}
+
+// printFailureSummary prints a dedicated section listing every scenario
+// failure, grouped by spec, so failures can be read together at the end of a
+// run instead of being scrolled back to through interleaved logs. Each entry
+// shows the failing step, the error message, a stack-trace snippet and the
+// command to rerun just that scenario.
+func printFailureSummary(suiteResult *result.SuiteResult) {
+	failures := failuresBySpec(suiteResult)
+	if len(failures) == 0 {
+		return
+	}
+	printSummaryLine(ct.Red, "\nFailures:")
+	for _, spec := range failures {
+		printSummaryLine(ct.Cyan, "\n%s (%s)", spec.heading, spec.fileName)
+		for _, scenario := range spec.scenarios {
+			annotations.Error("gauge-execution", spec.fileName, scenario.line, annotationMessage(scenario))
+			printSummaryLine(ct.Red, "\t%s", scenario.heading)
+			if scenario.step != "" {
+				printSummaryLine(ct.None, "\t\tFailed Step: %s", scenario.step)
+			}
+			if scenario.errorMessage != "" {
+				printSummaryLine(ct.None, "\t\tError Message: %s", scenario.errorMessage)
+			}
+			for _, line := range stackTraceSnippet(scenario.stackTrace) {
+				printSummaryLine(ct.None, "\t\t%s", line)
+			}
+			printSummaryLine(ct.Yellow, "\t\tRerun: %s", scenario.rerunCommand)
+		}
+	}
+}
+
+// failuresBySpec collects the failed scenarios of the suite result grouped by
+// spec, in execution order.
+func failuresBySpec(suiteResult *result.SuiteResult) []specFailures {
+	var failures []specFailures
+	for _, specResult := range suiteResult.SpecResults {
+		spec := specResult.ProtoSpec
+		specFailure := specFailures{heading: spec.GetSpecHeading(), fileName: spec.GetFileName()}
+		for _, scenario := range scenariosOf(spec) {
+			if scenario.GetExecutionStatus() != gauge_messages.ExecutionStatus_FAILED {
+				continue
+			}
+			specFailure.scenarios = append(specFailure.scenarios, scenarioFailureOf(spec, scenario))
+		}
+		if len(specFailure.scenarios) > 0 {
+			failures = append(failures, specFailure)
+		}
+	}
+	return failures
+}
+
+func scenarioFailureOf(spec *gauge_messages.ProtoSpec, scenario *gauge_messages.ProtoScenario) scenarioFailure {
+	failure := scenarioFailure{
+		heading:      scenario.GetScenarioHeading(),
+		line:         int(scenario.GetSpan().GetStart()),
+		rerunCommand: rerunCommandFor(spec, scenario),
+	}
+	if step := failedStepOf(scenario); step != nil {
+		execResult := step.GetStepExecutionResult().GetExecutionResult()
+		failure.step = step.GetActualText()
+		failure.errorMessage = execResult.GetErrorMessage()
+		failure.stackTrace = execResult.GetStackTrace()
+	}
+	return failure
+}
+
+// failedStepOf returns the first step of the scenario whose execution failed,
+// looking through contexts, scenario steps and concepts, or nil when the
+// failure did not come from a step (e.g. a hook failure).
+func failedStepOf(scenario *gauge_messages.ProtoScenario) *gauge_messages.ProtoStep {
+	if step := failedStepIn(scenario.GetContexts()); step != nil {
+		return step
+	}
+	return failedStepIn(scenario.GetScenarioItems())
+}
+
+func failedStepIn(items []*gauge_messages.ProtoItem) *gauge_messages.ProtoStep {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			step := item.GetStep()
+			if step.GetStepExecutionResult().GetExecutionResult().GetFailed() {
+				return step
+			}
+		case gauge_messages.ProtoItem_Concept:
+			if step := failedStepIn(item.GetConcept().GetSteps()); step != nil {
+				return step
+			}
+		}
+	}
+	return nil
+}
+
+// rerunCommandFor builds the command that runs only the given scenario, using
+// the spec file and the scenario's line number when it is known.
+func rerunCommandFor(spec *gauge_messages.ProtoSpec, scenario *gauge_messages.ProtoScenario) string {
+	if span := scenario.GetSpan(); span.GetStart() > 0 {
+		return fmt.Sprintf("gauge run %q", fmt.Sprintf("%s:%d", spec.GetFileName(), span.GetStart()))
+	}
+	return fmt.Sprintf("gauge run %q", spec.GetFileName())
+}
+
+// annotationMessage condenses a scenario failure into the single line shown
+// on the PR diff by CI annotations.
+func annotationMessage(scenario scenarioFailure) string {
+	message := fmt.Sprintf("Scenario failed: %s", scenario.heading)
+	if scenario.errorMessage != "" {
+		message = fmt.Sprintf("%s. %s", message, scenario.errorMessage)
+	}
+	return message
+}
+
+func stackTraceSnippet(stackTrace string) []string {
+	lines := strings.Split(strings.TrimSpace(stackTrace), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return nil
+	}
+	if len(lines) > stackTraceSnippetLines {
+		lines = lines[:stackTraceSnippetLines]
+	}
+	return lines
+}
+
+// printSummaryLine prints a line of the failure summary, colored on the
+// console. Colors are skipped in machine-readable mode where output is JSON.
+func printSummaryLine(color ct.Color, format string, args ...interface{}) {
+	if !MachineReadable {
+		ct.Foreground(color, false)
+		defer ct.ResetColor()
+	}
+	logger.Infof(true, format, args...)
+}
@@ -0,0 +1,89 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func failedStepItem(text, errMsg, stackTrace string) *gauge_messages.ProtoItem {
+	return &gauge_messages.ProtoItem{
+		ItemType: gauge_messages.ProtoItem_Step,
+		Step: &gauge_messages.ProtoStep{
+			ActualText: text,
+			StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+				ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: errMsg, StackTrace: stackTrace},
+			},
+		},
+	}
+}
+
+func TestFailuresBySpecGroupsFailedScenarios(t *testing.T) {
+	failing := &gauge_messages.ProtoScenario{
+		ScenarioHeading: "Failing scenario",
+		ExecutionStatus: gauge_messages.ExecutionStatus_FAILED,
+		Span:            &gauge_messages.Span{Start: 12},
+		ScenarioItems:   []*gauge_messages.ProtoItem{failedStepItem("my failing step", "boom", "line one\nline two")},
+	}
+	passing := &gauge_messages.ProtoScenario{ScenarioHeading: "Passing scenario", ExecutionStatus: gauge_messages.ExecutionStatus_PASSED}
+	spec := &gauge_messages.ProtoSpec{SpecHeading: "My Spec", FileName: "specs/example.spec", Items: []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: failing},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: passing},
+	}}
+	suiteResult := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		{ProtoSpec: spec},
+		{ProtoSpec: specWithScenarios("All green", map[string]gauge_messages.ExecutionStatus{"Passing": gauge_messages.ExecutionStatus_PASSED})},
+	}}
+
+	failures := failuresBySpec(suiteResult)
+
+	if len(failures) != 1 {
+		t.Fatalf("failures = %v, want only the spec with a failed scenario", failures)
+	}
+	if failures[0].heading != "My Spec" || len(failures[0].scenarios) != 1 {
+		t.Fatalf("failures[0] = %v, want 'My Spec' with one failed scenario", failures[0])
+	}
+	scenario := failures[0].scenarios[0]
+	if scenario.step != "my failing step" || scenario.errorMessage != "boom" {
+		t.Errorf("scenario failure = %v, want failing step and error message", scenario)
+	}
+	if scenario.rerunCommand != `gauge run "specs/example.spec:12"` {
+		t.Errorf("rerunCommand = %s, want the spec file with the scenario's line number", scenario.rerunCommand)
+	}
+}
+
+func TestFailedStepOfLooksInsideConceptsAndContexts(t *testing.T) {
+	scenario := &gauge_messages.ProtoScenario{
+		Contexts: []*gauge_messages.ProtoItem{failedStepItem("failing context", "boom", "")},
+	}
+	if step := failedStepOf(scenario); step.GetActualText() != "failing context" {
+		t.Errorf("failedStepOf = %v, want the failing context step", step)
+	}
+
+	scenario = &gauge_messages.ProtoScenario{
+		ScenarioItems: []*gauge_messages.ProtoItem{{
+			ItemType: gauge_messages.ProtoItem_Concept,
+			Concept:  &gauge_messages.ProtoConcept{Steps: []*gauge_messages.ProtoItem{failedStepItem("failing concept step", "boom", "")}},
+		}},
+	}
+	if step := failedStepOf(scenario); step.GetActualText() != "failing concept step" {
+		t.Errorf("failedStepOf = %v, want the step inside the concept", step)
+	}
+}
+
+func TestStackTraceSnippetIsTruncated(t *testing.T) {
+	if snippet := stackTraceSnippet(""); snippet != nil {
+		t.Errorf("snippet = %v, want nil for an empty stack trace", snippet)
+	}
+	snippet := stackTraceSnippet("one\ntwo\nthree\nfour\nfive")
+	if len(snippet) != stackTraceSnippetLines || snippet[2] != "three" {
+		t.Errorf("snippet = %v, want the first %d lines", snippet, stackTraceSnippetLines)
+	}
+}
@@ -0,0 +1,106 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+type fuzzFailure struct {
+	scenario string
+	fileName string
+	inputs   string
+}
+
+var fuzzFailures []fuzzFailure
+var fuzzMutex sync.Mutex
+
+// ListenScenarioEndForFuzzing records scenarios that failed on fuzzer
+// generated data table rows, so the generated inputs and the reproduction
+// seed can be reported once the suite is done.
+func ListenScenarioEndForFuzzing(wg *sync.WaitGroup) {
+	if !parser.FuzzTables {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.ScenarioEnd:
+				recordFuzzedFailure(e)
+			case event.SuiteEnd:
+				printFuzzSummary()
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func recordFuzzedFailure(e event.ExecutionEvent) {
+	res := e.Result.(*result.ScenarioResult)
+	if res.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED || !res.GetFailed() {
+		return
+	}
+	scn, ok := e.Item.(*gauge.Scenario)
+	if !ok || !scn.SpecDataTableRow.IsInitialized() {
+		return
+	}
+	fileName := e.ExecutionInfo.GetCurrentSpec().GetFileName()
+	if !parser.IsFuzzedRow(fileName, scn.SpecDataTableRowIndex) {
+		return
+	}
+	fuzzMutex.Lock()
+	defer fuzzMutex.Unlock()
+	fuzzFailures = append(fuzzFailures, fuzzFailure{
+		scenario: res.ProtoScenario.GetScenarioHeading(),
+		fileName: fileName,
+		inputs:   formatFuzzedInputs(scn.SpecDataTableRow),
+	})
+}
+
+// formatFuzzedInputs renders the generated row as "header=value" pairs.
+func formatFuzzedInputs(t gauge.Table) string {
+	rows := t.Rows()
+	if len(rows) == 0 {
+		return ""
+	}
+	var pairs []string
+	for i, header := range t.Headers {
+		if i < len(rows[0]) {
+			pairs = append(pairs, fmt.Sprintf("%s=%q", header, rows[0][i]))
+		}
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func printFuzzSummary() {
+	fuzzMutex.Lock()
+	defer fuzzMutex.Unlock()
+	if len(fuzzFailures) == 0 {
+		logger.Infof(true, "\nNo failures on fuzzed table rows. (fuzz seed: %d)", parser.FuzzSeed)
+		return
+	}
+	logger.Infof(true, "\nFailures on fuzzed table rows:")
+	for _, f := range fuzzFailures {
+		logger.Infof(true, "\t%s: %s [%s]", f.fileName, f.scenario, f.inputs)
+	}
+	logger.Infof(true, "Re-run with --fuzz --fuzz-seed=%d to reproduce these inputs.", parser.FuzzSeed)
+}
@@ -0,0 +1,52 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	ct "github.com/daviddengcn/go-colortext"
+	"github.com/getgauge/gauge/execution/result"
+)
+
+// failedHookResults collects the failed hook executions of the run: suite
+// hooks from the suite result and spec/scenario hooks from each spec result,
+// in execution order.
+func failedHookResults(suiteResult *result.SuiteResult) []*result.HookResult {
+	var failed []*result.HookResult
+	for _, hookResult := range suiteResult.HookResults {
+		if hookResult.Failed {
+			failed = append(failed, hookResult)
+		}
+	}
+	for _, specResult := range suiteResult.SpecResults {
+		for _, hookResult := range specResult.HookResults {
+			if hookResult.Failed {
+				failed = append(failed, hookResult)
+			}
+		}
+	}
+	return failed
+}
+
+// printHookFailures prints a dedicated section listing every failed hook
+// execution, so hook failures stand apart from step failures in the summary.
+func printHookFailures(suiteResult *result.SuiteResult) {
+	failed := failedHookResults(suiteResult)
+	if len(failed) == 0 {
+		return
+	}
+	printSummaryLine(ct.Red, "\nHook failures:")
+	for _, hookResult := range failed {
+		if hookResult.Context != "" {
+			printSummaryLine(ct.Red, "\t[%s] %s", hookResult.HookType, hookResult.Context)
+		} else {
+			printSummaryLine(ct.Red, "\t[%s]", hookResult.HookType)
+		}
+		if hookResult.ErrorMessage != "" {
+			printSummaryLine(ct.None, "\t\tError Message: %s", hookResult.ErrorMessage)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestFailedHookResultsCollectsSuiteSpecAndScenarioHooks(t *testing.T) {
+	suiteResult := result.NewSuiteResult("", time.Now())
+	suiteResult.HookResults = []*result.HookResult{
+		result.NewHookResult(result.BeforeSuiteHook, "", &gauge_messages.ProtoExecutionResult{}),
+		result.NewHookResult(result.AfterSuiteHook, "", &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "after suite failed"}),
+	}
+	specResult := &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
+	specResult.HookResults = []*result.HookResult{
+		result.NewHookResult(result.BeforeSpecHook, "specs/example.spec", &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "before spec failed"}),
+		result.NewHookResult(result.BeforeScenarioHook, "A scenario", &gauge_messages.ProtoExecutionResult{}),
+	}
+	suiteResult.SpecResults = append(suiteResult.SpecResults, specResult)
+
+	failed := failedHookResults(suiteResult)
+
+	if len(failed) != 2 {
+		t.Fatalf("expected 2 failed hook results, got %d", len(failed))
+	}
+	if failed[0].HookType != result.AfterSuiteHook {
+		t.Errorf("expected first failure to be the %s hook, got %s", result.AfterSuiteHook, failed[0].HookType)
+	}
+	if failed[1].HookType != result.BeforeSpecHook || failed[1].Context != "specs/example.spec" {
+		t.Errorf("expected second failure to be the %s hook of specs/example.spec, got %s (%s)", result.BeforeSpecHook, failed[1].HookType, failed[1].Context)
+	}
+}
+
+func TestFailedHookResultsIsEmptyWhenAllHooksPass(t *testing.T) {
+	suiteResult := result.NewSuiteResult("", time.Now())
+	suiteResult.HookResults = []*result.HookResult{
+		result.NewHookResult(result.BeforeSuiteHook, "", &gauge_messages.ProtoExecutionResult{}),
+	}
+
+	if failed := failedHookResults(suiteResult); len(failed) != 0 {
+		t.Errorf("expected no failed hook results, got %d", len(failed))
+	}
+}
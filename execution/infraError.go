@@ -0,0 +1,72 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InfrastructureError is a failure caused by the environment rather than the
+// tests, such as the disk filling up or the runner being killed by the
+// operating system. It keeps the underlying error and carries a category and
+// a remediation hint, so resource exhaustion shows up in the suite result as
+// such instead of as a generic connection failure.
+type InfrastructureError struct {
+	Category    string
+	Cause       error
+	Remediation string
+}
+
+func (e InfrastructureError) Error() string {
+	return fmt.Sprintf("[%s] %s. %s", e.Category, e.Cause.Error(), e.Remediation)
+}
+
+const (
+	outOfDiskCategory   = "OUT_OF_DISK"
+	outOfMemoryCategory = "OUT_OF_MEMORY"
+)
+
+// classifyInfraError wraps errors whose message carries a known signature of
+// resource exhaustion in an InfrastructureError. Other errors are returned
+// unchanged.
+func classifyInfraError(err error) error {
+	if err == nil {
+		return nil
+	}
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "no space left on device") || strings.Contains(message, "disk full"):
+		return InfrastructureError{
+			Category:    outOfDiskCategory,
+			Cause:       err,
+			Remediation: "The disk is full. Free up space in the reports, logs and screenshots directories and re-run.",
+		}
+	case strings.Contains(message, "cannot allocate memory") || strings.Contains(message, "out of memory"):
+		return InfrastructureError{
+			Category:    outOfMemoryCategory,
+			Cause:       err,
+			Remediation: "The system is out of memory. Close other processes or reduce the number of parallel streams (-n).",
+		}
+	case strings.Contains(message, "signal: killed"):
+		return InfrastructureError{
+			Category:    outOfMemoryCategory,
+			Cause:       err,
+			Remediation: "The process was killed by the operating system, typically by the OOM killer. Increase available memory or reduce the number of parallel streams (-n).",
+		}
+	}
+	return err
+}
+
+// classifyInfraErrors applies classifyInfraError to every error in the slice.
+func classifyInfraErrors(errs []error) []error {
+	classified := make([]error, 0, len(errs))
+	for _, err := range errs {
+		classified = append(classified, classifyInfraError(err))
+	}
+	return classified
+}
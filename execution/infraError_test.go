@@ -0,0 +1,51 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestClassifyInfraErrorDetectsDiskExhaustion(t *testing.T) {
+	err := classifyInfraError(errors.New("write /tmp/reports/index.html: no space left on device"))
+
+	infraErr, ok := err.(InfrastructureError)
+	if !ok {
+		t.Fatalf("Expected an InfrastructureError, got %T", err)
+	}
+	if infraErr.Category != outOfDiskCategory {
+		t.Errorf("Expected category %s, got %s", outOfDiskCategory, infraErr.Category)
+	}
+	if !strings.Contains(err.Error(), "no space left on device") || !strings.Contains(err.Error(), "Free up space") {
+		t.Errorf("Expected cause and remediation in message, got %q", err.Error())
+	}
+}
+
+func TestClassifyInfraErrorDetectsOomKilledRunner(t *testing.T) {
+	err := classifyInfraError(errors.New("Runner exited with error: signal: killed"))
+
+	infraErr, ok := err.(InfrastructureError)
+	if !ok {
+		t.Fatalf("Expected an InfrastructureError, got %T", err)
+	}
+	if infraErr.Category != outOfMemoryCategory {
+		t.Errorf("Expected category %s, got %s", outOfMemoryCategory, infraErr.Category)
+	}
+}
+
+func TestClassifyInfraErrorLeavesOtherErrorsUnchanged(t *testing.T) {
+	cause := errors.New("connection refused")
+
+	if err := classifyInfraError(cause); err != cause {
+		t.Errorf("Expected error to pass through unchanged, got %v", err)
+	}
+	if err := classifyInfraError(nil); err != nil {
+		t.Errorf("Expected nil to pass through, got %v", err)
+	}
+}
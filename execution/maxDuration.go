@@ -0,0 +1,58 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/execution/rerun"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/validation"
+)
+
+// MaxDuration is the time budget for the run, the value of --max-duration.
+// Once the budget is exhausted no new specs are scheduled; the remaining
+// specs are skipped and recorded for `gauge run --failed`. Zero means no
+// budget.
+var MaxDuration time.Duration
+
+const budgetSkipReason = "Skipped: the execution time budget (--max-duration) was exhausted before this specification was scheduled"
+
+var executionDeadline time.Time
+var budgetNotice sync.Once
+
+// startBudget arms the budget when a run starts.
+func startBudget() {
+	budgetNotice = sync.Once{}
+	if MaxDuration > 0 {
+		executionDeadline = time.Now().Add(MaxDuration)
+	}
+}
+
+// budgetExhausted reports whether the run has used up --max-duration.
+func budgetExhausted() bool {
+	return MaxDuration > 0 && time.Now().After(executionDeadline)
+}
+
+// skipSpecForBudget marks every scenario of spec as skipped, the same way
+// validation errors do, so the spec still flows through execution events and
+// reports. The spec is recorded in the failed-run metadata so the next
+// `gauge run --failed` executes the remainder.
+func skipSpecForBudget(spec *gauge.Specification, errMap *gauge.BuildErrors) {
+	budgetNotice.Do(func() {
+		logger.Infof(true, "Time budget of %s exhausted, skipping the remaining specifications.", MaxDuration)
+	})
+	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: spec.Heading.LineNo, LineText: spec.Heading.Value},
+		budgetSkipReason, spec.FileName, nil, "")
+	for _, scenario := range spec.Scenarios {
+		errMap.ScenarioErrs[scenario] = []error{validationError}
+	}
+	errMap.SpecErrs[spec] = []error{validationError}
+	rerun.MarkForRerun(spec.FileName)
+}
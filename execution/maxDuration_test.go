@@ -0,0 +1,65 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestBudgetIsNeverExhaustedWithoutMaxDuration(t *testing.T) {
+	MaxDuration = 0
+	startBudget()
+
+	if budgetExhausted() {
+		t.Error("Expected no budget when --max-duration is not set")
+	}
+}
+
+func TestBudgetExhaustionAfterTheDeadline(t *testing.T) {
+	MaxDuration = time.Nanosecond
+	defer func() { MaxDuration = 0 }()
+	startBudget()
+	time.Sleep(time.Millisecond)
+
+	if !budgetExhausted() {
+		t.Error("Expected the budget to be exhausted after the deadline")
+	}
+
+	MaxDuration = time.Hour
+	startBudget()
+	if budgetExhausted() {
+		t.Error("Expected a fresh budget of an hour to not be exhausted")
+	}
+}
+
+func TestSkipSpecForBudgetMarksEveryScenarioSkipped(t *testing.T) {
+	MaxDuration = time.Minute
+	defer func() { MaxDuration = 0 }()
+	startBudget()
+	scenario1 := &gauge.Scenario{Heading: &gauge.Heading{Value: "First"}}
+	scenario2 := &gauge.Scenario{Heading: &gauge.Heading{Value: "Second"}}
+	spec := &gauge.Specification{
+		Heading:   &gauge.Heading{Value: "Spec", LineNo: 1},
+		FileName:  "example.spec",
+		Scenarios: []*gauge.Scenario{scenario1, scenario2},
+	}
+	errMap := gauge.NewBuildErrors()
+
+	skipSpecForBudget(spec, errMap)
+
+	if len(errMap.SpecErrs[spec]) != 1 {
+		t.Fatalf("Expected 1 spec error, got %d", len(errMap.SpecErrs[spec]))
+	}
+	for _, scenario := range spec.Scenarios {
+		if len(errMap.ScenarioErrs[scenario]) != 1 {
+			t.Errorf("Expected scenario %s to carry a skip reason", scenario.Heading.Value)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+)
+
+// Environments holds the environments of a multi-environment run (--env with
+// several comma separated values and allow_multi_env_execution enabled). The
+// whole suite executes once per environment with its own set of execution
+// streams, and each iteration's suite result carries its environment, instead
+// of users scripting one invocation per environment.
+var Environments []string
+
+func executePerEnvironment(specDirs []string) int {
+	exitCode := Success
+	for i, environment := range Environments {
+		env.UnloadEnv()
+		if err := env.LoadEnv(environment); err != nil {
+			logger.Fatalf(true, "Unable to load environment '%s': %s", environment, err.Error())
+		}
+		logger.Infof(true, "Executing suite for environment '%s' (%d of %d)", environment, i+1, len(Environments))
+		if code := executeSpecs(specDirs); code > exitCode {
+			exitCode = code
+		}
+	}
+	return exitCode
+}
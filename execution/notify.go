@@ -0,0 +1,63 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+// notifyRunCompletion raises a desktop notification and rings the terminal
+// bell when a run that took longer than notify_run_threshold finishes, so a
+// suite running in the background can announce its pass/fail summary.
+// Opt-in via notify_on_run_completion; notification failures are only logged.
+func notifyRunCompletion(suiteResult *result.SuiteResult) {
+	if !config.NotifyOnRunCompletion() {
+		return
+	}
+	executionTime := time.Millisecond * time.Duration(suiteResult.ExecutionTime)
+	if executionTime < config.NotifyRunThreshold() {
+		return
+	}
+	summary := fmt.Sprintf("Passed in %s", executionTime)
+	if suiteResult.IsFailed {
+		summary = fmt.Sprintf("Failed in %s: %d spec(s) failed", executionTime, suiteResult.SpecsFailedCount)
+	}
+	fmt.Fprint(os.Stdout, "\a")
+	if err := sendDesktopNotification("Gauge run finished", summary); err != nil {
+		logger.Debugf(false, "Unable to send desktop notification: %s", err.Error())
+	}
+}
+
+// sendDesktopNotification shows a notification using the platform's native
+// mechanism: osascript on macOS, notify-send on Linux and a PowerShell
+// balloon tip on Windows.
+func sendDesktopNotification(title, message string) error {
+	var command *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", message, title)
+		command = exec.Command("osascript", "-e", script)
+	case "windows":
+		script := fmt.Sprintf(`Add-Type -AssemblyName System.Windows.Forms;`+
+			`$n = New-Object System.Windows.Forms.NotifyIcon;`+
+			`$n.Icon = [System.Drawing.SystemIcons]::Information;`+
+			`$n.Visible = $true;`+
+			`$n.ShowBalloonTip(10000, %q, %q, 'Info')`, title, message)
+		command = exec.Command("powershell", "-NoProfile", "-Command", script)
+	default:
+		command = exec.Command("notify-send", title, message)
+	}
+	return command.Run()
+}
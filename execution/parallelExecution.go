@@ -160,6 +160,10 @@ func (e *parallelExecution) run() *result.SuiteResult {
 
 func (e *parallelExecution) executeLazily() {
 	defer close(e.resultChan)
+	if AdaptiveStreams {
+		startStreamGovernor(e.numberOfStreams())
+		defer stopStreamGovernor()
+	}
 	e.wg.Add(e.numberOfStreams())
 	e.startRunnersForRemainingStreams()
 
@@ -240,6 +244,8 @@ func (e *parallelExecution) startRunner(s *gauge.SpecCollection, stream int) (ru
 	}
 	runner, err := runner.Start(e.manifest, stream, make(chan bool), false)
 	if err != nil {
+		reportRunnerStartFailure()
+		err = classifyInfraError(err)
 		logger.Errorf(true, "Failed to start runner. %s", err.Error())
 		logger.Debugf(true, "Skipping %d specifications", s.Size())
 		if isLazy() {
@@ -251,6 +257,17 @@ func (e *parallelExecution) startRunner(s *gauge.SpecCollection, stream int) (ru
 }
 
 func (e *parallelExecution) startSpecsExecutionWithRunner(s *gauge.SpecCollection, runner runner.Runner, stream int) {
+	setupStreamDir(stream)
+	defer cleanupStreamDir(stream)
+	if err := executeBeforeStreamCommand(stream); err != nil {
+		logger.Errorf(true, "%s", err.Error())
+		logger.Debugf(true, "Skipping %d specifications", s.Size())
+		if err := runner.Kill(); err != nil {
+			logger.Errorf(true, "Failed to kill runner. %s", err.Error())
+		}
+		e.resultChan <- &result.SuiteResult{UnhandledErrors: []error{streamExecError{specsSkipped: s.SpecNames(), message: err.Error()}}}
+		return
+	}
 	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, stream)
 	se := newSimpleExecution(executionInfo, false, false)
 	se.execute()
@@ -258,6 +275,7 @@ func (e *parallelExecution) startSpecsExecutionWithRunner(s *gauge.SpecCollectio
 	if err != nil {
 		logger.Errorf(true, "Failed to kill runner. %s", err.Error())
 	}
+	executeAfterStreamCommand(stream)
 	e.resultChan <- se.suiteResult
 }
 
@@ -266,6 +284,8 @@ func (e *parallelExecution) executeSpecsInSerial(s *gauge.SpecCollection) *resul
 	if err != nil {
 		return &result.SuiteResult{UnhandledErrors: err}
 	}
+	setupStreamDir(1)
+	defer cleanupStreamDir(1)
 	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, 1)
 	se := newSimpleExecution(executionInfo, false, false)
 	se.execute()
@@ -280,10 +300,12 @@ func (e *parallelExecution) executeSpecsInSerial(s *gauge.SpecCollection) *resul
 func (e *parallelExecution) finish() {
 	e.suiteResult = mergeDataTableSpecResults(e.suiteResult)
 	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, gauge_messages.ExecutionInfo{}))
+	protoResult := plugin.ProcessSuiteResult(e.manifest, gauge.ConvertToProtoSuiteResult(e.suiteResult))
+	applyProcessedSuiteResult(e.suiteResult, protoResult)
 	message := &gauge_messages.Message{
 		MessageType: gauge_messages.Message_SuiteExecutionResult,
 		SuiteExecutionResult: &gauge_messages.SuiteExecutionResult{
-			SuiteResult: gauge.ConvertToProtoSuiteResult(e.suiteResult),
+			SuiteResult: protoResult,
 		},
 	}
 	e.pluginHandler.NotifyPlugins(message)
@@ -305,9 +327,11 @@ func (e *parallelExecution) aggregateResults(suiteResults []*result.SuiteResult)
 			r.PostSuite = result.PostSuite
 		}
 		if result.UnhandledErrors != nil {
-			r.UnhandledErrors = append(r.UnhandledErrors, result.UnhandledErrors...)
+			r.UnhandledErrors = append(r.UnhandledErrors, classifyInfraErrors(result.UnhandledErrors)...)
 		}
+		r.HookResults = append(r.HookResults, result.HookResults...)
 	}
+	orderSpecResults(r.SpecResults)
 	r.ExecutionTime = int64(time.Since(e.startTime) / 1e6)
 	e.suiteResult = r
 	e.suiteResult.SetSpecsSkippedCount()
@@ -317,6 +341,15 @@ func isLazy() bool {
 	return strings.ToLower(Strategy) == Lazy
 }
 
+// executionStrategy is the strategy reported to runners in ExecutionInfo:
+// the lowercased parallel strategy, or empty for serial execution.
+func executionStrategy() string {
+	if !InParallel {
+		return ""
+	}
+	return strings.ToLower(Strategy)
+}
+
 func isValidStrategy(strategy string) bool {
 	strategy = strings.ToLower(strategy)
 	return strategy == Lazy || strategy == Eager
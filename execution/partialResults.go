@@ -0,0 +1,136 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+const partialRunResult = "partial_run_result.json"
+
+// partialResult is the aggregated progress of a run so far. It is written to
+// .gauge and POSTed to the configured result webhooks, so dashboards can show
+// progress for suites that run for hours, before the final result exists.
+type partialResult struct {
+	Timestamp            string `json:"timestamp"`
+	SpecsExecuted        int    `json:"specsExecuted"`
+	SpecsFailed          int    `json:"specsFailed"`
+	SpecsSkipped         int    `json:"specsSkipped"`
+	ScenariosExecuted    int    `json:"scenariosExecuted"`
+	ScenariosFailed      int    `json:"scenariosFailed"`
+	ScenariosSkipped     int    `json:"scenariosSkipped"`
+	ExecutionTimeInMilli int64  `json:"executionTimeInMilli"`
+	Final                bool   `json:"final"`
+}
+
+// ListenSpecEndAndPublishPartialResults publishes intermediate aggregated results
+// while the suite is still running, every partial_results_interval minutes and/or
+// every partial_results_spec_count executed specs, whichever is configured.
+// A final snapshot is always published at suite end when publishing is enabled.
+func ListenSpecEndAndPublishPartialResults(wg *sync.WaitGroup) {
+	interval := config.PartialResultsInterval()
+	specCount := config.PartialResultsSpecCount()
+	if interval <= 0 && specCount <= 0 {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SpecEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		tick = ticker.C
+	}
+	go func() {
+		aggregate := &partialResult{}
+		for {
+			select {
+			case e := <-ch:
+				switch e.Topic {
+				case event.SpecEnd:
+					addSpecResult(aggregate, e.Result.(*result.SpecResult))
+					if specCount > 0 && aggregate.SpecsExecuted%specCount == 0 {
+						publishPartialResult(aggregate, false)
+					}
+				case event.SuiteEnd:
+					publishPartialResult(aggregate, true)
+					wg.Done()
+					return
+				}
+			case <-tick:
+				publishPartialResult(aggregate, false)
+			}
+		}
+	}()
+}
+
+func addSpecResult(aggregate *partialResult, res *result.SpecResult) {
+	aggregate.SpecsExecuted++
+	if res.IsFailed {
+		aggregate.SpecsFailed++
+	}
+	if res.Skipped {
+		aggregate.SpecsSkipped++
+	}
+	aggregate.ScenariosExecuted += res.ScenarioCount
+	aggregate.ScenariosFailed += res.ScenarioFailedCount
+	aggregate.ScenariosSkipped += res.ScenarioSkippedCount
+	aggregate.ExecutionTimeInMilli += res.ExecutionTime
+}
+
+func publishPartialResult(aggregate *partialResult, final bool) {
+	aggregate.Timestamp = time.Now().Format(time.RFC3339)
+	aggregate.Final = final
+	content, err := json.MarshalIndent(aggregate, "", "  ")
+	if err != nil {
+		logger.Warningf(true, "Unable to marshal partial run result, skipping publish. %s", err.Error())
+		return
+	}
+	writePartialResult(content)
+	for _, url := range config.ResultWebhookURLs() {
+		postPartialResult(url, content)
+	}
+}
+
+func writePartialResult(content []byte) {
+	dotGaugeDir := filepath.Join(config.ProjectRoot, dotGauge)
+	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+		return
+	}
+	resultFile := filepath.Join(dotGaugeDir, partialRunResult)
+	if err := ioutil.WriteFile(resultFile, content, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", resultFile, err.Error())
+	}
+}
+
+func postPartialResult(url string, content []byte) {
+	client := &http.Client{Timeout: time.Second * 10}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(content))
+	if err != nil {
+		logger.Warningf(true, "Failed to publish partial result to %s. %s", url, err.Error())
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		logger.Warningf(true, "Failed to publish partial result to %s. Got status %s", url, resp.Status)
+	}
+}
@@ -0,0 +1,55 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/result"
+)
+
+func TestAddSpecResultAggregatesCounts(t *testing.T) {
+	aggregate := &partialResult{}
+
+	addSpecResult(aggregate, &result.SpecResult{ScenarioCount: 3, ScenarioFailedCount: 1, ExecutionTime: 100, IsFailed: true})
+	addSpecResult(aggregate, &result.SpecResult{ScenarioCount: 2, ScenarioSkippedCount: 2, ExecutionTime: 50, Skipped: true})
+
+	if aggregate.SpecsExecuted != 2 {
+		t.Errorf("Expected 2 specs executed, got %d", aggregate.SpecsExecuted)
+	}
+	if aggregate.SpecsFailed != 1 || aggregate.SpecsSkipped != 1 {
+		t.Errorf("Expected 1 failed and 1 skipped spec, got %d and %d", aggregate.SpecsFailed, aggregate.SpecsSkipped)
+	}
+	if aggregate.ScenariosExecuted != 5 || aggregate.ScenariosFailed != 1 || aggregate.ScenariosSkipped != 2 {
+		t.Errorf("Unexpected scenario counts: %+v", aggregate)
+	}
+	if aggregate.ExecutionTimeInMilli != 150 {
+		t.Errorf("Expected execution time 150, got %d", aggregate.ExecutionTimeInMilli)
+	}
+}
+
+func TestPostPartialResultSendsJSON(t *testing.T) {
+	var gotContentType, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		buf := make([]byte, r.ContentLength)
+		_, _ = r.Body.Read(buf)
+		gotBody = string(buf)
+	}))
+	defer server.Close()
+
+	postPartialResult(server.URL, []byte(`{"specsExecuted":1}`))
+
+	if gotContentType != "application/json" {
+		t.Errorf("Expected content type application/json, got %s", gotContentType)
+	}
+	if gotBody != `{"specsExecuted":1}` {
+		t.Errorf("Unexpected body: %s", gotBody)
+	}
+}
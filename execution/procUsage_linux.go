@@ -0,0 +1,46 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// userHz is the unit of the utime/stime counters in /proc/<pid>/stat.
+const userHz = 100
+
+// sampleProcUsage reads the resident set size and the accumulated CPU time
+// of a process from /proc. ok is false when the process cannot be read,
+// e.g. because it already exited.
+func sampleProcUsage(pid int) (rssBytes int64, cpuTime time.Duration, ok bool) {
+	stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, 0, false
+	}
+	// The comm field may contain spaces; everything after its closing paren
+	// is fixed-position.
+	end := strings.LastIndexByte(string(stat), ')')
+	if end < 0 {
+		return 0, 0, false
+	}
+	fields := strings.Fields(string(stat)[end+1:])
+	if len(fields) < 22 {
+		return 0, 0, false
+	}
+	utime, uErr := strconv.ParseInt(fields[11], 10, 64)
+	stime, sErr := strconv.ParseInt(fields[12], 10, 64)
+	rssPages, rErr := strconv.ParseInt(fields[21], 10, 64)
+	if uErr != nil || sErr != nil || rErr != nil {
+		return 0, 0, false
+	}
+	return rssPages * int64(os.Getpagesize()), time.Duration(utime+stime) * time.Second / userHz, true
+}
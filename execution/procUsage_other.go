@@ -0,0 +1,18 @@
+//go:build !linux
+// +build !linux
+
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import "time"
+
+// sampleProcUsage is only implemented on platforms exposing /proc; runner
+// usage telemetry is disabled elsewhere.
+func sampleProcUsage(pid int) (rssBytes int64, cpuTime time.Duration, ok bool) {
+	return 0, 0, false
+}
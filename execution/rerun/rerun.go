@@ -105,6 +105,14 @@ func prepareScenarioFailedMetadata(res *result.ScenarioResult, sce *gauge.Scenar
 	}
 }
 
+// MarkForRerun records a spec that was never executed, e.g. skipped because
+// the run's time budget was exhausted, so the next `gauge run --failed`
+// picks it up along with the failures.
+func MarkForRerun(specPath string) {
+	fileName := util.RelPathToProjectRoot(specPath)
+	failedMeta.addFailedItem(fileName, fileName)
+}
+
 func addSpecFailedMetadata(res result.Result, args []string) {
 	fileName := util.RelPathToProjectRoot(res.(*result.SpecResult).ProtoSpec.GetFileName())
 	delete(failedMeta.failedItemsMap, fileName)
@@ -123,3 +123,13 @@ func (s *MySuite) TestGetAllFailedItems(c *C) {
 
 	c.Assert(failedItems, DeepEquals, []string{"scn1", "scn2", "scn3"})
 }
+
+func (s *MySuite) TestMarkForRerunRecordsAnUnexecutedSpec(c *C) {
+	spec1Rel := filepath.Join("specs", "example1.spec")
+	spec1Abs := filepath.Join(config.ProjectRoot, spec1Rel)
+
+	MarkForRerun(spec1Abs)
+
+	failedMeta.aggregateFailedItems()
+	c.Assert(failedMeta.FailedItems, DeepEquals, []string{spec1Rel})
+}
@@ -0,0 +1,46 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+// Hook types recorded in HookResult.
+const (
+	BeforeSuiteHook    = "Before Suite"
+	AfterSuiteHook     = "After Suite"
+	BeforeSpecHook     = "Before Spec"
+	AfterSpecHook      = "After Spec"
+	BeforeScenarioHook = "Before Scenario"
+	AfterScenarioHook  = "After Scenario"
+)
+
+// HookResult records one lifecycle hook execution as a first-class entity,
+// so hook failures can be told apart from step failures.
+type HookResult struct {
+	// HookType is one of the hook type constants, e.g. BeforeSpecHook.
+	HookType string
+	// Context names what the hook ran for: the spec file or the scenario
+	// heading, empty for suite hooks.
+	Context string
+	Failed  bool
+	// ExecutionTime is the hook duration in milliseconds.
+	ExecutionTime int64
+	ErrorMessage  string
+}
+
+// NewHookResult builds a HookResult from a hook's proto execution result.
+func NewHookResult(hookType, context string, res *gauge_messages.ProtoExecutionResult) *HookResult {
+	return &HookResult{
+		HookType:      hookType,
+		Context:       context,
+		Failed:        res.GetFailed(),
+		ExecutionTime: res.GetExecutionTime(),
+		ErrorMessage:  res.GetErrorMessage(),
+	}
+}
@@ -0,0 +1,38 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"github.com/getgauge/gauge/gauge_messages"
+	gc "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestNewHookResultCapturesExecutionDetails(c *gc.C) {
+	execResult := &gauge_messages.ProtoExecutionResult{Failed: true, ExecutionTime: 42, ErrorMessage: "hook blew up"}
+
+	hookResult := NewHookResult(BeforeSpecHook, "specs/example.spec", execResult)
+
+	c.Assert(hookResult.HookType, gc.Equals, BeforeSpecHook)
+	c.Assert(hookResult.Context, gc.Equals, "specs/example.spec")
+	c.Assert(hookResult.Failed, gc.Equals, true)
+	c.Assert(hookResult.ExecutionTime, gc.Equals, int64(42))
+	c.Assert(hookResult.ErrorMessage, gc.Equals, "hook blew up")
+}
+
+func (s *MySuite) TestAddScenarioResultsCollectsHookResults(c *gc.C) {
+	specResult := SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
+	scenarioResult := NewScenarioResult(&gauge_messages.ProtoScenario{ScenarioHeading: "Scenario heading"})
+	scenarioResult.HookResults = append(scenarioResult.HookResults,
+		NewHookResult(BeforeScenarioHook, "Scenario heading", &gauge_messages.ProtoExecutionResult{}),
+		NewHookResult(AfterScenarioHook, "Scenario heading", &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "teardown failed"}))
+
+	specResult.AddScenarioResults([]Result{scenarioResult})
+
+	c.Assert(len(specResult.HookResults), gc.Equals, 2)
+	c.Assert(specResult.HookResults[0].HookType, gc.Equals, BeforeScenarioHook)
+	c.Assert(specResult.HookResults[1].Failed, gc.Equals, true)
+}
@@ -15,6 +15,8 @@ type ScenarioResult struct {
 	ScenarioDataTableRow      *gauge_messages.ProtoTable
 	ScenarioDataTableRowIndex int
 	ScenarioDataTable         *gauge_messages.ProtoTable
+	// HookResults records the scenario hook executions, failed or not.
+	HookResults []*HookResult
 }
 
 func NewScenarioResult(sce *gauge_messages.ProtoScenario) *ScenarioResult {
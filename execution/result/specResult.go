@@ -21,6 +21,23 @@ type SpecResult struct {
 	Skipped              bool
 	ScenarioSkippedCount int
 	Errors               []*gauge_messages.Error
+	// RunnerUsage is the resource usage of the runner process sampled while
+	// this spec executed, nil when sampling is unavailable.
+	RunnerUsage *RunnerUsage
+	// HookResults records the spec and scenario hook executions of this
+	// spec, failed or not.
+	HookResults []*HookResult
+}
+
+// RunnerUsage captures peak and average CPU and memory of a runner process
+// on one execution stream, to help size -n and spot memory-hungry steps.
+type RunnerUsage struct {
+	Stream         int
+	Samples        int
+	PeakRSSBytes   int64
+	AvgRSSBytes    int64
+	PeakCPUPercent float64
+	AvgCPUPercent  float64
 }
 
 // SetFailure sets the result to failed
@@ -43,6 +60,9 @@ func (specResult *SpecResult) AddScenarioResults(scenarioResults []Result) {
 			specResult.IsFailed = true
 			specResult.ScenarioFailedCount++
 		}
+		if sr, ok := scenarioResult.(*ScenarioResult); ok {
+			specResult.HookResults = append(specResult.HookResults, sr.HookResults...)
+		}
 		specResult.AddExecTime(scenarioResult.ExecTime())
 		specResult.ProtoSpec.Items = append(specResult.ProtoSpec.Items, &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: scenarioResult.Item().(*gauge_messages.ProtoScenario)})
 	}
@@ -54,6 +74,7 @@ func (specResult *SpecResult) AddTableDrivenScenarioResult(r *ScenarioResult, t
 		specResult.IsFailed = true
 		specResult.ScenarioFailedCount++
 	}
+	specResult.HookResults = append(specResult.HookResults, r.HookResults...)
 	specResult.AddExecTime(r.ExecTime())
 	pItem := &gauge_messages.ProtoItem{
 		ItemType: gauge_messages.ProtoItem_TableDrivenScenario,
@@ -35,6 +35,19 @@ type SuiteResult struct {
 	PostHookScreenshotFiles []string
 	PreHookScreenshots      [][]byte
 	PostHookScreenshots     [][]byte
+	// DurationRegressions lists scenarios that ran significantly slower than
+	// their historical baseline in this run.
+	DurationRegressions []string
+	// ParameterSet describes the suite parameter table row this result was
+	// executed with, empty for plain runs.
+	ParameterSet string
+	// HookResults records the suite hook executions, failed or not. Spec
+	// and scenario hook executions live on the respective SpecResults.
+	HookResults []*HookResult
+	// EnvMutations lists environment variables added, removed or changed
+	// while the suite ran, attributed to the scenario after which the change
+	// was first observed. Populated only when audit_env_mutations is enabled.
+	EnvMutations []string
 }
 
 // NewSuiteResult is a constructor for SuitResult
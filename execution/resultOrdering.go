@@ -0,0 +1,57 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sort"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+// Accepted values of the spec_result_ordering property.
+const (
+	// fileOrdering lists spec results in spec-file order, making reports
+	// diffable across runs regardless of stream completion order.
+	fileOrdering = "file"
+	// failureFirstOrdering lists failed specs first, each group in file order.
+	failureFirstOrdering = "failure-first"
+	// durationOrdering lists the slowest specs first.
+	durationOrdering = "duration"
+	// completionOrdering keeps the order the execution streams finished in.
+	completionOrdering = "completion"
+)
+
+// orderSpecResults sorts aggregated spec results per the
+// spec_result_ordering property. The default is completion order.
+func orderSpecResults(results []*result.SpecResult) {
+	byFile := func(i, j int) bool {
+		return results[i].ProtoSpec.GetFileName() < results[j].ProtoSpec.GetFileName()
+	}
+	switch env.SpecResultOrdering() {
+	case "", completionOrdering:
+	case fileOrdering:
+		sort.SliceStable(results, byFile)
+	case failureFirstOrdering:
+		sort.SliceStable(results, func(i, j int) bool {
+			if results[i].IsFailed != results[j].IsFailed {
+				return results[i].IsFailed
+			}
+			return byFile(i, j)
+		})
+	case durationOrdering:
+		sort.SliceStable(results, func(i, j int) bool {
+			if results[i].ExecutionTime != results[j].ExecutionTime {
+				return results[i].ExecutionTime > results[j].ExecutionTime
+			}
+			return byFile(i, j)
+		})
+	default:
+		logger.Warningf(true, "Invalid value(%s) for spec_result_ordering, accepted values are file, failure-first, duration and completion.", env.SpecResultOrdering())
+	}
+}
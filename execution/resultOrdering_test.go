@@ -0,0 +1,89 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func specResultFor(fileName string, failed bool, executionTime int64) *result.SpecResult {
+	return &result.SpecResult{
+		ProtoSpec:     &gauge_messages.ProtoSpec{FileName: fileName},
+		IsFailed:      failed,
+		ExecutionTime: executionTime,
+	}
+}
+
+func fileNamesOf(results []*result.SpecResult) []string {
+	var names []string
+	for _, r := range results {
+		names = append(names, r.ProtoSpec.GetFileName())
+	}
+	return names
+}
+
+func assertOrder(t *testing.T, results []*result.SpecResult, want []string) {
+	t.Helper()
+	got := fileNamesOf(results)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Spec result order = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestOrderSpecResultsKeepsCompletionOrderByDefault(t *testing.T) {
+	results := []*result.SpecResult{specResultFor("b.spec", false, 1), specResultFor("a.spec", false, 2)}
+
+	orderSpecResults(results)
+
+	assertOrder(t, results, []string{"b.spec", "a.spec"})
+}
+
+func TestOrderSpecResultsByFileName(t *testing.T) {
+	os.Setenv("spec_result_ordering", "file")
+	defer os.Unsetenv("spec_result_ordering")
+	results := []*result.SpecResult{specResultFor("c.spec", false, 1), specResultFor("a.spec", false, 2), specResultFor("b.spec", true, 3)}
+
+	orderSpecResults(results)
+
+	assertOrder(t, results, []string{"a.spec", "b.spec", "c.spec"})
+}
+
+func TestOrderSpecResultsFailureFirst(t *testing.T) {
+	os.Setenv("spec_result_ordering", "failure-first")
+	defer os.Unsetenv("spec_result_ordering")
+	results := []*result.SpecResult{specResultFor("a.spec", false, 1), specResultFor("c.spec", true, 2), specResultFor("b.spec", true, 3)}
+
+	orderSpecResults(results)
+
+	assertOrder(t, results, []string{"b.spec", "c.spec", "a.spec"})
+}
+
+func TestOrderSpecResultsByDuration(t *testing.T) {
+	os.Setenv("spec_result_ordering", "duration")
+	defer os.Unsetenv("spec_result_ordering")
+	results := []*result.SpecResult{specResultFor("a.spec", false, 10), specResultFor("b.spec", false, 30), specResultFor("c.spec", false, 20)}
+
+	orderSpecResults(results)
+
+	assertOrder(t, results, []string{"b.spec", "c.spec", "a.spec"})
+}
+
+func TestOrderSpecResultsLeavesOrderAloneForInvalidValues(t *testing.T) {
+	os.Setenv("spec_result_ordering", "alphabetic")
+	defer os.Unsetenv("spec_result_ordering")
+	results := []*result.SpecResult{specResultFor("b.spec", false, 1), specResultFor("a.spec", false, 2)}
+
+	orderSpecResults(results)
+
+	assertOrder(t, results, []string{"b.spec", "a.spec"})
+}
@@ -0,0 +1,280 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	// TestRailUploader posts outcomes to a TestRail add_results_for_cases
+	// endpoint, mapping tags like @testrail:C123 to case IDs.
+	TestRailUploader = "testrail"
+	// XrayUploader posts outcomes to an Xray import/execution endpoint,
+	// mapping tags like @xray:PROJ-123 to test keys.
+	XrayUploader = "xray"
+
+	resultUploadAttempts = 3
+)
+
+// uploadRetryDelay is the pause between upload attempts; a variable so tests
+// do not have to wait for real backoff.
+var uploadRetryDelay = time.Second
+
+var uploaderClient = &http.Client{Timeout: 30 * time.Second}
+
+// scenarioOutcome is one executed scenario mapped to the external test case
+// it covers, via the tag prefix configured in result_uploader_tag_prefix.
+type scenarioOutcome struct {
+	caseID   string
+	spec     string
+	scenario string
+	status   string
+	timeMs   int64
+}
+
+// resultUploader posts per-scenario outcomes to an external test management
+// system after the run.
+type resultUploader interface {
+	upload(outcomes []scenarioOutcome) error
+}
+
+// notifyResultUploader posts the run's per-scenario outcomes to the test
+// management system configured via the result_uploader properties. Scenarios
+// without a mapping tag are skipped, upload failures are only logged, and
+// result_uploader_dry_run logs the outcomes instead of posting them.
+func notifyResultUploader(suiteResult *result.SuiteResult) {
+	name := env.ResultUploader()
+	if name == "" {
+		return
+	}
+	uploader, err := uploaderFor(name)
+	if err != nil {
+		logger.Warningf(true, "Unable to upload results: %s", err.Error())
+		return
+	}
+	outcomes := scenarioOutcomes(suiteResult)
+	if len(outcomes) == 0 {
+		logger.Warningf(true, "No scenarios carry a '%s:' tag, nothing to upload to %s.", env.ResultUploaderTagPrefix(), name)
+		return
+	}
+	if env.ResultUploaderDryRun() {
+		logger.Infof(true, "Dry run, would upload %d outcome(s) to %s:", len(outcomes), name)
+		for _, outcome := range outcomes {
+			logger.Infof(true, "  %s: %s (%s)", outcome.caseID, outcome.scenario, outcome.status)
+		}
+		return
+	}
+	if err := uploadWithRetries(uploader, outcomes); err != nil {
+		logger.Warningf(true, "Unable to upload results to %s: %s", name, err.Error())
+		return
+	}
+	logger.Infof(true, "Uploaded %d outcome(s) to %s.", len(outcomes), name)
+}
+
+func uploaderFor(name string) (resultUploader, error) {
+	url := env.ResultUploaderURL()
+	if url == "" {
+		return nil, fmt.Errorf("result_uploader is set to '%s' but result_uploader_url is not configured", name)
+	}
+	switch name {
+	case TestRailUploader:
+		return &testRailUploader{url: url, username: env.ResultUploaderUsername(), token: env.ResultUploaderToken()}, nil
+	case XrayUploader:
+		return &xrayUploader{url: url, token: env.ResultUploaderToken()}, nil
+	default:
+		return nil, fmt.Errorf("'%s' is not a known result uploader, accepted values are %s and %s", name, TestRailUploader, XrayUploader)
+	}
+}
+
+// scenarioOutcomes maps the run's scenarios to external test case IDs. A
+// mapping tag on the spec covers every scenario of the spec.
+func scenarioOutcomes(suiteResult *result.SuiteResult) []scenarioOutcome {
+	prefix := env.ResultUploaderTagPrefix()
+	var outcomes []scenarioOutcome
+	for _, specResult := range suiteResult.SpecResults {
+		spec := specResult.ProtoSpec
+		specCaseIDs := caseIDsOf(spec.GetTags(), prefix)
+		for _, scenario := range scenariosOf(spec) {
+			caseIDs := append(caseIDsOf(scenario.GetTags(), prefix), specCaseIDs...)
+			for _, caseID := range dedupe(caseIDs) {
+				outcomes = append(outcomes, scenarioOutcome{
+					caseID:   caseID,
+					spec:     spec.GetSpecHeading(),
+					scenario: scenario.GetScenarioHeading(),
+					status:   strings.ToLower(scenario.GetExecutionStatus().String()),
+					timeMs:   scenario.GetExecutionTime(),
+				})
+			}
+		}
+	}
+	return outcomes
+}
+
+// caseIDsOf returns the external test case IDs the given tags map to,
+// e.g. C123 for @testrail:C123 with the testrail prefix.
+func caseIDsOf(tags []string, prefix string) []string {
+	var caseIDs []string
+	for _, tag := range tags {
+		idx := strings.Index(tag, ":")
+		if idx < 0 {
+			continue
+		}
+		if strings.ToLower(strings.TrimSpace(tag[:idx])) != prefix {
+			continue
+		}
+		if caseID := strings.TrimSpace(tag[idx+1:]); caseID != "" {
+			caseIDs = append(caseIDs, caseID)
+		}
+	}
+	return caseIDs
+}
+
+func uploadWithRetries(uploader resultUploader, outcomes []scenarioOutcome) error {
+	var err error
+	for attempt := 1; attempt <= resultUploadAttempts; attempt++ {
+		if err = uploader.upload(outcomes); err == nil {
+			return nil
+		}
+		if attempt < resultUploadAttempts {
+			logger.Debugf(true, "Result upload attempt %d failed, retrying: %s", attempt, err.Error())
+			time.Sleep(uploadRetryDelay)
+		}
+	}
+	return err
+}
+
+func postUploaderPayload(req *http.Request) error {
+	res, err := uploaderClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 400 {
+		return fmt.Errorf("endpoint responded with status %s", res.Status)
+	}
+	return nil
+}
+
+// testRailUploader posts to TestRail's add_results_for_cases API. Case IDs
+// are the numeric part of tags like @testrail:C123.
+type testRailUploader struct {
+	url      string
+	username string
+	token    string
+}
+
+type testRailResult struct {
+	CaseID   string `json:"case_id"`
+	StatusID int    `json:"status_id"`
+	Comment  string `json:"comment"`
+	Elapsed  string `json:"elapsed,omitempty"`
+}
+
+func (u *testRailUploader) upload(outcomes []scenarioOutcome) error {
+	results := make([]testRailResult, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		results = append(results, testRailResult{
+			CaseID:   strings.TrimPrefix(strings.TrimPrefix(outcome.caseID, "C"), "c"),
+			StatusID: testRailStatusID(outcome.status),
+			Comment:  fmt.Sprintf("%s > %s: %s", outcome.spec, outcome.scenario, outcome.status),
+			Elapsed:  testRailElapsed(outcome.timeMs),
+		})
+	}
+	payload, err := json.Marshal(map[string][]testRailResult{"results": results})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, u.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if u.username != "" {
+		req.SetBasicAuth(u.username, u.token)
+	}
+	return postUploaderPayload(req)
+}
+
+// testRailStatusID maps execution statuses to TestRail's built-in status IDs:
+// 1 passed, 2 blocked, 5 failed.
+func testRailStatusID(status string) int {
+	switch status {
+	case "passed":
+		return 1
+	case "skipped":
+		return 2
+	default:
+		return 5
+	}
+}
+
+// testRailElapsed renders the execution time in TestRail's elapsed format;
+// TestRail rejects spans under a second, so those are left out.
+func testRailElapsed(timeMs int64) string {
+	if timeMs < 1000 {
+		return ""
+	}
+	return fmt.Sprintf("%ds", timeMs/1000)
+}
+
+// xrayUploader posts to Xray's import/execution API. Test keys are Jira
+// issue keys from tags like @xray:PROJ-123.
+type xrayUploader struct {
+	url   string
+	token string
+}
+
+type xrayTest struct {
+	TestKey string `json:"testKey"`
+	Status  string `json:"status"`
+	Comment string `json:"comment"`
+}
+
+func (u *xrayUploader) upload(outcomes []scenarioOutcome) error {
+	tests := make([]xrayTest, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		tests = append(tests, xrayTest{
+			TestKey: outcome.caseID,
+			Status:  xrayStatus(outcome.status),
+			Comment: fmt.Sprintf("%s > %s", outcome.spec, outcome.scenario),
+		})
+	}
+	payload, err := json.Marshal(map[string][]xrayTest{"tests": tests})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, u.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if u.token != "" {
+		req.Header.Set("Authorization", "Bearer "+u.token)
+	}
+	return postUploaderPayload(req)
+}
+
+func xrayStatus(status string) string {
+	switch status {
+	case "passed":
+		return "PASSED"
+	case "skipped":
+		return "TODO"
+	default:
+		return "FAILED"
+	}
+}
@@ -0,0 +1,187 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func stubUploaderProperties(t *testing.T, name, url, prefix string) {
+	oldName, oldURL, oldPrefix := env.ResultUploader, env.ResultUploaderURL, env.ResultUploaderTagPrefix
+	oldDelay := uploadRetryDelay
+	env.ResultUploader = func() string { return name }
+	env.ResultUploaderURL = func() string { return url }
+	env.ResultUploaderTagPrefix = func() string { return prefix }
+	uploadRetryDelay = time.Millisecond
+	t.Cleanup(func() {
+		env.ResultUploader = oldName
+		env.ResultUploaderURL = oldURL
+		env.ResultUploaderTagPrefix = oldPrefix
+		uploadRetryDelay = oldDelay
+	})
+}
+
+func uploaderSuiteResult() *result.SuiteResult {
+	spec := &gauge_messages.ProtoSpec{
+		SpecHeading: "Checkout",
+		Tags:        []string{"testrail:C100"},
+		Items: []*gauge_messages.ProtoItem{
+			taggedScenarioItem("Pay by card", gauge_messages.ExecutionStatus_PASSED, "testrail:C123"),
+			taggedScenarioItem("Pay by wallet", gauge_messages.ExecutionStatus_FAILED),
+		},
+	}
+	return &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}
+}
+
+func TestScenarioOutcomesMapsTagsToCaseIDs(t *testing.T) {
+	stubUploaderProperties(t, "testrail", "http://example.com", "testrail")
+
+	outcomes := scenarioOutcomes(uploaderSuiteResult())
+
+	if len(outcomes) != 3 {
+		t.Fatalf("outcomes = %v, want the spec tag on both scenarios plus the scenario tag", outcomes)
+	}
+	byCase := map[string]string{}
+	for _, outcome := range outcomes {
+		byCase[outcome.caseID+"/"+outcome.scenario] = outcome.status
+	}
+	if byCase["C123/Pay by card"] != "passed" || byCase["C100/Pay by wallet"] != "failed" {
+		t.Errorf("outcomes = %v, want C123 passed and C100 failed", outcomes)
+	}
+}
+
+func TestTestRailUploaderPostsResults(t *testing.T) {
+	var payload map[string][]testRailResult
+	var authorized bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, authorized = r.BasicAuth()
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("Unexpected payload: %s", body)
+		}
+	}))
+	defer server.Close()
+	stubUploaderProperties(t, "testrail", server.URL, "testrail")
+	uploader := &testRailUploader{url: server.URL, username: "ci@example.com", token: "key"}
+
+	err := uploader.upload(scenarioOutcomes(uploaderSuiteResult()))
+
+	if err != nil {
+		t.Fatalf("upload failed: %s", err.Error())
+	}
+	if !authorized {
+		t.Errorf("Expected basic auth on the TestRail request")
+	}
+	results := payload["results"]
+	if len(results) != 3 {
+		t.Fatalf("results = %v, want 3", results)
+	}
+	byCase := map[string]int{}
+	for _, r := range results {
+		byCase[r.CaseID] = r.StatusID
+	}
+	if byCase["123"] != 1 {
+		t.Errorf("results = %v, want case 123 (C stripped) with status_id 1", results)
+	}
+}
+
+func TestXrayUploaderPostsTests(t *testing.T) {
+	var payload map[string][]xrayTest
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("Unexpected payload: %s", body)
+		}
+	}))
+	defer server.Close()
+	stubUploaderProperties(t, "xray", server.URL, "xray")
+	uploader := &xrayUploader{url: server.URL, token: "secret"}
+
+	err := uploader.upload([]scenarioOutcome{{caseID: "PROJ-12", spec: "Checkout", scenario: "Pay by card", status: "failed"}})
+
+	if err != nil {
+		t.Fatalf("upload failed: %s", err.Error())
+	}
+	if authorization != "Bearer secret" {
+		t.Errorf("Authorization = %s, want the bearer token", authorization)
+	}
+	tests := payload["tests"]
+	if len(tests) != 1 || tests[0].TestKey != "PROJ-12" || tests[0].Status != "FAILED" {
+		t.Errorf("tests = %v, want PROJ-12 as FAILED", tests)
+	}
+}
+
+type flakyUploader struct {
+	failures int
+	calls    int
+}
+
+func (u *flakyUploader) upload(outcomes []scenarioOutcome) error {
+	u.calls++
+	if u.calls <= u.failures {
+		return errors.New("temporarily unavailable")
+	}
+	return nil
+}
+
+func TestUploadWithRetriesRecoversFromTransientFailures(t *testing.T) {
+	oldDelay := uploadRetryDelay
+	uploadRetryDelay = time.Millisecond
+	defer func() { uploadRetryDelay = oldDelay }()
+	uploader := &flakyUploader{failures: 2}
+
+	if err := uploadWithRetries(uploader, nil); err != nil {
+		t.Errorf("Expected the third attempt to succeed, got: %s", err.Error())
+	}
+	if uploader.calls != 3 {
+		t.Errorf("calls = %d, want 3", uploader.calls)
+	}
+}
+
+func TestUploadWithRetriesGivesUpAfterAllAttempts(t *testing.T) {
+	oldDelay := uploadRetryDelay
+	uploadRetryDelay = time.Millisecond
+	defer func() { uploadRetryDelay = oldDelay }()
+	uploader := &flakyUploader{failures: 10}
+
+	if err := uploadWithRetries(uploader, nil); err == nil {
+		t.Errorf("Expected an error after exhausting the attempts")
+	}
+	if uploader.calls != resultUploadAttempts {
+		t.Errorf("calls = %d, want %d", uploader.calls, resultUploadAttempts)
+	}
+}
+
+func TestNotifyResultUploaderDryRunDoesNotPost(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = true
+	}))
+	defer server.Close()
+	stubUploaderProperties(t, "testrail", server.URL, "testrail")
+	oldDryRun := env.ResultUploaderDryRun
+	env.ResultUploaderDryRun = func() bool { return true }
+	defer func() { env.ResultUploaderDryRun = oldDryRun }()
+
+	notifyResultUploader(uploaderSuiteResult())
+
+	if posted {
+		t.Errorf("Expected dry run to skip the upload")
+	}
+}
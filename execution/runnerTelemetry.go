@@ -0,0 +1,110 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+// runnerUsageSampleInterval is how often the runner process is sampled while
+// a spec executes.
+const runnerUsageSampleInterval = 250 * time.Millisecond
+
+// runnerUsageSampler periodically samples the CPU time and resident set size
+// of a runner process and aggregates the samples into a result.RunnerUsage.
+type runnerUsageSampler struct {
+	pid    int
+	stream int
+	done   chan struct{}
+
+	mu       sync.Mutex
+	samples  int
+	peakRSS  int64
+	totalRSS int64
+	peakCPU  float64
+	startCPU time.Duration
+	lastCPU  time.Duration
+	startAt  time.Time
+	lastAt   time.Time
+}
+
+// startRunnerUsageSampler begins sampling the given runner process, returning
+// nil when the process cannot be sampled, e.g. on platforms without /proc.
+func startRunnerUsageSampler(pid, stream int) *runnerUsageSampler {
+	if pid <= 0 {
+		return nil
+	}
+	rss, cpu, ok := sampleProcUsage(pid)
+	if !ok {
+		return nil
+	}
+	s := &runnerUsageSampler{pid: pid, stream: stream, done: make(chan struct{})}
+	now := time.Now()
+	s.startAt, s.lastAt = now, now
+	s.startCPU, s.lastCPU = cpu, cpu
+	s.record(rss, cpu, now)
+	go func() {
+		ticker := time.NewTicker(runnerUsageSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				if rss, cpu, ok := sampleProcUsage(s.pid); ok {
+					s.mu.Lock()
+					s.record(rss, cpu, time.Now())
+					s.mu.Unlock()
+				}
+			}
+		}
+	}()
+	return s
+}
+
+func (s *runnerUsageSampler) record(rss int64, cpu time.Duration, at time.Time) {
+	s.samples++
+	s.totalRSS += rss
+	if rss > s.peakRSS {
+		s.peakRSS = rss
+	}
+	if window := at.Sub(s.lastAt); window > 0 {
+		if cpuPercent := float64(cpu-s.lastCPU) / float64(window) * 100; cpuPercent > s.peakCPU {
+			s.peakCPU = cpuPercent
+		}
+	}
+	s.lastCPU = cpu
+	s.lastAt = at
+}
+
+// stop takes a final sample and returns the aggregated usage.
+func (s *runnerUsageSampler) stop() *result.RunnerUsage {
+	close(s.done)
+	if rss, cpu, ok := sampleProcUsage(s.pid); ok {
+		s.mu.Lock()
+		s.record(rss, cpu, time.Now())
+		s.mu.Unlock()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	usage := &result.RunnerUsage{
+		Stream:         s.stream,
+		Samples:        s.samples,
+		PeakRSSBytes:   s.peakRSS,
+		AvgRSSBytes:    s.totalRSS / int64(s.samples),
+		PeakCPUPercent: s.peakCPU,
+	}
+	if elapsed := s.lastAt.Sub(s.startAt); elapsed > 0 {
+		usage.AvgCPUPercent = float64(s.lastCPU-s.startCPU) / float64(elapsed) * 100
+	}
+	logger.Debugf(false, "Runner usage on stream %d: peak RSS %d bytes, avg CPU %.1f%%", s.stream, usage.PeakRSSBytes, usage.AvgCPUPercent)
+	return usage
+}
@@ -0,0 +1,67 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestStartRunnerUsageSamplerRejectsInvalidPids(t *testing.T) {
+	if sampler := startRunnerUsageSampler(0, 1); sampler != nil {
+		t.Error("Expected no sampler for pid 0")
+	}
+	if sampler := startRunnerUsageSampler(-1, 1); sampler != nil {
+		t.Error("Expected no sampler for a negative pid")
+	}
+}
+
+func TestRunnerUsageSamplerAggregatesSamples(t *testing.T) {
+	sampler := startRunnerUsageSampler(os.Getpid(), 2)
+	if sampler == nil {
+		t.Skip("process sampling is not supported on this platform")
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	usage := sampler.stop()
+
+	if usage.Stream != 2 {
+		t.Errorf("usage.Stream = %d, want 2", usage.Stream)
+	}
+	if usage.Samples < 2 {
+		t.Errorf("usage.Samples = %d, want at least the start and stop samples", usage.Samples)
+	}
+	if usage.PeakRSSBytes <= 0 {
+		t.Errorf("usage.PeakRSSBytes = %d, want > 0", usage.PeakRSSBytes)
+	}
+	if usage.AvgRSSBytes <= 0 || usage.AvgRSSBytes > usage.PeakRSSBytes {
+		t.Errorf("usage.AvgRSSBytes = %d, want within (0, %d]", usage.AvgRSSBytes, usage.PeakRSSBytes)
+	}
+	if usage.AvgCPUPercent < 0 {
+		t.Errorf("usage.AvgCPUPercent = %f, want >= 0", usage.AvgCPUPercent)
+	}
+}
+
+func TestRunnerUsageSamplerRecordsThePeak(t *testing.T) {
+	s := &runnerUsageSampler{pid: 1, stream: 1, done: make(chan struct{})}
+	start := time.Now()
+	s.startAt, s.lastAt = start, start
+	s.record(100, 0, start)
+	s.record(300, 50*time.Millisecond, start.Add(100*time.Millisecond))
+	s.record(200, 100*time.Millisecond, start.Add(200*time.Millisecond))
+
+	if s.peakRSS != 300 {
+		t.Errorf("peakRSS = %d, want 300", s.peakRSS)
+	}
+	if s.totalRSS/int64(s.samples) != 200 {
+		t.Errorf("average RSS = %d, want 200", s.totalRSS/int64(s.samples))
+	}
+	if s.peakCPU != 50 {
+		t.Errorf("peakCPU = %f, want 50", s.peakCPU)
+	}
+}
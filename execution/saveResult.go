@@ -19,6 +19,8 @@ import (
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/health"
+	"github.com/getgauge/gauge/stats"
 	"github.com/getgauge/gauge/logger"
 	"github.com/golang/protobuf/proto"
 )
@@ -38,7 +40,11 @@ func ListenSuiteEndAndSaveResult(wg *sync.WaitGroup) {
 		for {
 			e := <-ch
 			if e.Topic == event.SuiteEnd {
-				writeResult(e.Result.(*result.SuiteResult))
+				res := e.Result.(*result.SuiteResult)
+				checkDurationRegressions(res)
+				writeResult(res)
+				health.Record(res)
+				stats.Record(res)
 				wg.Done()
 			}
 		}
@@ -61,4 +67,13 @@ func writeResult(res *result.SuiteResult) {
 	} else {
 		logger.Debugf(true, "Last run result saved to %s", resultFile)
 	}
+	canonicalFile := filepath.Join(config.ProjectRoot, dotGauge, canonicalRunResult)
+	c, err := canonicalSuiteResultJSON(res, Reproducible)
+	if err != nil {
+		logger.Errorf(true, "Unable to serialize canonical run result, skipping save. %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(canonicalFile, c, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", canonicalFile, err.Error())
+	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/metrics"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/validation"
@@ -46,9 +47,10 @@ func newScenarioExecutor(r runner.Runner, ph plugin.Handler, ei *gauge_messages.
 func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	scenario := i.(*gauge.Scenario)
 	scenarioResult := r.(*result.ScenarioResult)
+	defer func() { metrics.RecordScenario(scenarioStatus(scenarioResult)) }()
 	scenarioResult.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_PASSED
 	scenarioResult.ProtoScenario.Skipped = false
-	if scenario.SpecDataTableRow.IsInitialized() && !shouldExecuteForRow(scenario.SpecDataTableRowIndex) {
+	if scenario.SpecDataTableRow.IsInitialized() && !shouldExecuteForRow(scenario.SpecDataTableRowIndex, &scenario.SpecDataTableRow) {
 		e.errMap.ScenarioErrs[scenario] = append([]error{errors.New("skipped Reason: Doesn't satisfy --table-rows flag condition")}, e.errMap.ScenarioErrs[scenario]...)
 		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
 		return
@@ -62,6 +64,9 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	event.Notify(event.NewExecutionEvent(event.ScenarioStart, scenario, scenarioResult, e.stream, *e.currentExecutionInfo))
 	defer event.Notify(event.NewExecutionEvent(event.ScenarioEnd, scenario, scenarioResult, e.stream, *e.currentExecutionInfo))
 
+	tempDir := setupScenarioTempDir(e.currentExecutionInfo, e.stream)
+	defer func() { cleanupScenarioTempDir(e.currentExecutionInfo, tempDir, scenarioResult.GetFailed()) }()
+
 	res := e.initScenarioDataStore()
 	if res.GetFailed() {
 		e.handleScenarioDataStoreFailure(scenarioResult, scenario, fmt.Errorf("Failed to initialize scenario datastore. Error: %s", res.GetErrorMessage()))
@@ -78,6 +83,11 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	}
 
 	e.notifyAfterScenarioHook(scenarioResult)
+	if scenarioResult.GetFailed() {
+		recordFailureForAbort()
+		captureFailureScreenshot(scenario)
+	}
+	collectScenarioArtifacts(e.currentExecutionInfo.CurrentSpec.GetFileName(), scenario, scenarioResult)
 	scenarioResult.UpdateExecutionTime()
 }
 
@@ -95,6 +105,17 @@ func (e *scenarioExecutor) handleScenarioDataStoreFailure(scenarioResult *result
 	setSkipInfoInResult(scenarioResult, scenario, e.errMap)
 }
 
+func scenarioStatus(scenarioResult *result.ScenarioResult) string {
+	switch scenarioResult.ProtoScenario.GetExecutionStatus() {
+	case gauge_messages.ExecutionStatus_FAILED:
+		return "failed"
+	case gauge_messages.ExecutionStatus_SKIPPED:
+		return "skipped"
+	default:
+		return "passed"
+	}
+}
+
 func setSkipInfoInResult(scenarioResult *result.ScenarioResult, scenario *gauge.Scenario, errMap *gauge.BuildErrors) {
 	scenarioResult.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
 	scenarioResult.ProtoScenario.Skipped = true
@@ -113,6 +134,7 @@ func (e *scenarioExecutor) notifyBeforeScenarioHook(scenarioResult *result.Scena
 	scenarioResult.ProtoScenario.PreHookMessages = res.Message
 	scenarioResult.ProtoScenario.PreHookScreenshotFiles = res.ScreenshotFiles
 	scenarioResult.ProtoScenario.PreHookScreenshots = res.Screenshots
+	scenarioResult.HookResults = append(scenarioResult.HookResults, result.NewHookResult(result.BeforeScenarioHook, scenarioResult.ProtoScenario.GetScenarioHeading(), res))
 	if res.GetFailed() {
 		setScenarioFailure(e.currentExecutionInfo)
 		handleHookFailure(scenarioResult, res, result.AddPreHook)
@@ -128,6 +150,7 @@ func (e *scenarioExecutor) notifyAfterScenarioHook(scenarioResult *result.Scenar
 	scenarioResult.ProtoScenario.PostHookMessages = res.Message
 	scenarioResult.ProtoScenario.PostHookScreenshotFiles = res.ScreenshotFiles
 	scenarioResult.ProtoScenario.PostHookScreenshots = res.Screenshots
+	scenarioResult.HookResults = append(scenarioResult.HookResults, result.NewHookResult(result.AfterScenarioHook, scenarioResult.ProtoScenario.GetScenarioHeading(), res))
 	if res.GetFailed() {
 		setScenarioFailure(e.currentExecutionInfo)
 		handleHookFailure(scenarioResult, res, result.AddPostHook)
@@ -167,6 +190,9 @@ func (e *scenarioExecutor) executeStep(step *gauge.Step, protoItem *gauge_messag
 		failed = res.GetFailed()
 		recoverable = res.ProtoStepExecResult().GetExecutionResult().GetRecoverableError()
 	}
+	if failed {
+		recoverable = overrideRecoverable(recoverable)
+	}
 	return failed, recoverable
 }
 
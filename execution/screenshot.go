@@ -0,0 +1,65 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	gaugeScreenshotFileEnv    = "GAUGE_SCREENSHOT_FILE"
+	gaugeScreenshotFormatEnv  = "GAUGE_SCREENSHOT_FORMAT"
+	gaugeScreenshotQualityEnv = "GAUGE_SCREENSHOT_QUALITY"
+)
+
+// captureFailureScreenshot lazily captures a screenshot for a failed
+// scenario through the configured provider command. The provider is told
+// where to write the image and in what format and quality; the file lands
+// in the artifacts staging dir, so it is collected into the reports
+// directory with the scenario's other artifacts. Capturing only on failure
+// and only when a provider is configured keeps passing scenarios free of
+// screenshot overhead.
+func captureFailureScreenshot(scenario *gauge.Scenario) {
+	command := env.ScreenshotProviderCommand()
+	if command == "" || !env.ScreenshotOnFailureEnabled() {
+		return
+	}
+	stagingDir := os.Getenv(env.GaugeArtifactsDir)
+	if stagingDir == "" {
+		return
+	}
+	format := env.ScreenshotFormat()
+	file := filepath.Join(stagingDir, fmt.Sprintf("failure-%s-%d.%s", sanitizeArtifactName(scenario.Heading.Value), time.Now().UnixNano(), format))
+	parts := strings.Fields(command)
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Dir = config.ProjectRoot
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", gaugeScreenshotFileEnv, file),
+		fmt.Sprintf("%s=%s", gaugeScreenshotFormatEnv, format),
+		fmt.Sprintf("%s=%d", gaugeScreenshotQualityEnv, env.ScreenshotQuality()),
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logger.Warningf(true, "Screenshot provider failed: %s\n%s", err.Error(), strings.TrimSpace(string(output)))
+		return
+	}
+	if !common.FileExists(file) {
+		logger.Warningf(true, "Screenshot provider did not write %s", file)
+		return
+	}
+	logger.Debugf(true, "Captured failure screenshot %s", file)
+}
@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestCaptureFailureScreenshotInvokesProvider(t *testing.T) {
+	stagingDir, err := ioutil.TempDir("", "gauge-screenshot")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(stagingDir)
+	provider := filepath.Join(stagingDir, "provider.sh")
+	if err := ioutil.WriteFile(provider, []byte("#!/bin/sh\n: > \"$GAUGE_SCREENSHOT_FILE\"\n"), 0755); err != nil {
+		t.Fatalf("unable to write provider script: %s", err.Error())
+	}
+	os.Setenv(env.GaugeArtifactsDir, stagingDir)
+	defer os.Unsetenv(env.GaugeArtifactsDir)
+	oldCommand := env.ScreenshotProviderCommand
+	env.ScreenshotProviderCommand = func() string { return provider }
+	defer func() { env.ScreenshotProviderCommand = oldCommand }()
+
+	captureFailureScreenshot(&gauge.Scenario{Heading: &gauge.Heading{Value: "My Scenario"}})
+
+	entries, _ := ioutil.ReadDir(stagingDir)
+	var captured bool
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "failure-My_Scenario-") && strings.HasSuffix(entry.Name(), ".png") {
+			captured = true
+		}
+	}
+	if !captured {
+		t.Errorf("expected a failure screenshot in the staging dir, got %v", entries)
+	}
+}
+
+func TestCaptureFailureScreenshotWithoutProviderIsNoOp(t *testing.T) {
+	stagingDir, err := ioutil.TempDir("", "gauge-screenshot")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(stagingDir)
+	os.Setenv(env.GaugeArtifactsDir, stagingDir)
+	defer os.Unsetenv(env.GaugeArtifactsDir)
+	oldCommand := env.ScreenshotProviderCommand
+	env.ScreenshotProviderCommand = func() string { return "" }
+	defer func() { env.ScreenshotProviderCommand = oldCommand }()
+
+	captureFailureScreenshot(&gauge.Scenario{Heading: &gauge.Heading{Value: "My Scenario"}})
+
+	entries, _ := ioutil.ReadDir(stagingDir)
+	if len(entries) != 0 {
+		t.Errorf("expected no screenshot without a provider, got %v", entries)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/metrics"
 	"github.com/getgauge/gauge/manifest"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/runner"
@@ -22,11 +23,11 @@ import (
 
 // ExecuteTags holds the tags to filter the execution by
 var ExecuteTags = ""
-var tableRowsIndexes []int
+var selectedTableRows *tableRowsSelection
 
 // SetTableRows is used to limit data driven execution to specific rows
 func SetTableRows(tableRows string) {
-	tableRowsIndexes = getDataTableRows(tableRows)
+	selectedTableRows = getDataTableRows(tableRows)
 }
 
 type simpleExecution struct {
@@ -65,6 +66,8 @@ func (e *simpleExecution) run() *result.SuiteResult {
 }
 
 func (e *simpleExecution) execute() {
+	metrics.StreamStarted()
+	defer metrics.StreamEnded()
 	e.suiteResult = result.NewSuiteResult(ExecuteTags, e.startTime)
 	defer func() {
 		e.suiteResult.UpdateExecTime(e.startTime)
@@ -74,10 +77,13 @@ func (e *simpleExecution) execute() {
 		logger.Debug(true, "Initialising suite data store.")
 		initSuiteDataStoreResult := e.initSuiteDataStore()
 		if initSuiteDataStoreResult.GetFailed() {
-			e.suiteResult.AddUnhandledError(fmt.Errorf("failed to initialize suite datastore. Error: %s", initSuiteDataStoreResult.GetErrorMessage()))
+			e.suiteResult.AddUnhandledError(classifyInfraError(fmt.Errorf("failed to initialize suite datastore. Error: %s", initSuiteDataStoreResult.GetErrorMessage())))
 			return
 		}
-		e.notifyBeforeSuite()
+		executeBeforeSuiteCommand(e.suiteResult)
+		if !e.suiteResult.GetFailed() {
+			e.notifyBeforeSuite()
+		}
 	}
 
 	if !e.suiteResult.GetFailed() {
@@ -87,6 +93,7 @@ func (e *simpleExecution) execute() {
 
 	if !e.skipSuiteEvents {
 		e.notifyAfterSuite()
+		executeAfterSuiteCommand(e.suiteResult)
 	}
 }
 
@@ -112,6 +119,10 @@ func (e *simpleExecution) stopAllPlugins() {
 
 func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*result.SpecResult) {
 	for sc.HasNext() {
+		waitForStreamSlot(e.stream, sc)
+		if !sc.HasNext() {
+			break
+		}
 		specs := sc.Next()
 		var preHookFailures, postHookFailures []*gauge_messages.ProtoHookFailure
 		var specResults []*result.SpecResult
@@ -120,6 +131,12 @@ func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*res
 			if i == len(specs)-1 {
 				after = true
 			}
+			if budgetExhausted() {
+				skipSpecForBudget(spec, e.errMaps)
+			}
+			if abortOnFailure() {
+				skipSpecForAbort(spec, e.errMaps)
+			}
 			res := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream).execute(before, preHookFailures == nil, after)
 			before = false
 			specResults = append(specResults, res)
@@ -159,6 +176,7 @@ func (e *simpleExecution) notifyBeforeSuite() {
 	e.suiteResult.PreHookMessages = res.Message
 	e.suiteResult.PreHookScreenshotFiles = res.ScreenshotFiles
 	e.suiteResult.PreHookScreenshots = res.Screenshots
+	e.suiteResult.HookResults = append(e.suiteResult.HookResults, result.NewHookResult(result.BeforeSuiteHook, "", res))
 	if res.GetFailed() {
 		handleHookFailure(e.suiteResult, res, result.AddPreHook)
 	}
@@ -173,6 +191,7 @@ func (e *simpleExecution) notifyAfterSuite() {
 	e.suiteResult.PostHookMessages = res.Message
 	e.suiteResult.PostHookScreenshotFiles = res.ScreenshotFiles
 	e.suiteResult.PostHookScreenshots = res.Screenshots
+	e.suiteResult.HookResults = append(e.suiteResult.HookResults, result.NewHookResult(result.AfterSuiteHook, "", res))
 	if res.GetFailed() {
 		handleHookFailure(e.suiteResult, res, result.AddPostHook)
 	}
@@ -192,11 +211,22 @@ func (e *simpleExecution) executeHook(m *gauge_messages.Message) *(gauge_message
 }
 
 func (e *simpleExecution) notifyExecutionResult() {
+	protoResult := plugin.ProcessSuiteResult(e.manifest, gauge.ConvertToProtoSuiteResult(e.suiteResult))
+	applyProcessedSuiteResult(e.suiteResult, protoResult)
 	m := &gauge_messages.Message{MessageType: gauge_messages.Message_SuiteExecutionResult,
-		SuiteExecutionResult: &gauge_messages.SuiteExecutionResult{SuiteResult: gauge.ConvertToProtoSuiteResult(e.suiteResult)}}
+		SuiteExecutionResult: &gauge_messages.SuiteExecutionResult{SuiteResult: protoResult}}
 	e.pluginHandler.NotifyPlugins(m)
 }
 
+// applyProcessedSuiteResult copies the verdict of a result-processor plugin
+// back onto the suite result, so the exit code and the execution status file
+// follow the processed result.
+func applyProcessedSuiteResult(suiteResult *result.SuiteResult, protoResult *gauge_messages.ProtoSuiteResult) {
+	suiteResult.IsFailed = protoResult.GetFailed()
+	suiteResult.SpecsFailedCount = int(protoResult.GetSpecsFailedCount())
+	suiteResult.SpecsSkippedCount = int(protoResult.GetSpecsSkippedCount())
+}
+
 func (e *simpleExecution) notifyExecutionStop() {
 	m := &gauge_messages.Message{MessageType: gauge_messages.Message_KillProcessRequest,
 		KillProcessRequest: &gauge_messages.KillProcessRequest{}}
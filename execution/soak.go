@@ -0,0 +1,124 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// RepeatCount is the number of times the filtered specs are executed,
+// for soak runs and flakiness detection. 1 runs the suite once, as usual.
+var RepeatCount = 1
+
+// UntilFailure stops a repeated run at the first failing iteration.
+var UntilFailure bool
+
+type scenarioStats struct {
+	runs     int
+	failures int
+}
+
+var flakinessStats map[string]*scenarioStats
+var flakinessMutex sync.Mutex
+
+// executeRepeatedly runs the suite RepeatCount times, aggregates per scenario
+// pass rates across iterations and prints a flakiness summary showing
+// scenarios that failed intermittently. The worst exit code wins.
+func executeRepeatedly(specDirs []string) int {
+	flakinessStats = make(map[string]*scenarioStats)
+	exitCode := Success
+	for i := 1; i <= RepeatCount; i++ {
+		logger.Infof(true, "Executing run %d of %d", i, RepeatCount)
+		code := executeSpecs(specDirs)
+		if code > exitCode {
+			exitCode = code
+		}
+		if UntilFailure && code != Success {
+			logger.Infof(true, "Run %d failed, stopping as --until-failure is set.", i)
+			break
+		}
+	}
+	printFlakinessSummary()
+	return exitCode
+}
+
+// ListenScenarioEndForFlakiness records per scenario outcomes during repeated
+// runs so a flakiness summary can be printed once all iterations are done.
+func ListenScenarioEndForFlakiness(wg *sync.WaitGroup) {
+	if RepeatCount <= 1 {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.ScenarioEnd:
+				recordScenarioOutcome(e)
+			case event.SuiteEnd:
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func recordScenarioOutcome(e event.ExecutionEvent) {
+	res := e.Result.(*result.ScenarioResult)
+	if res.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		return
+	}
+	key := fmt.Sprintf("%s: %s", e.ExecutionInfo.GetCurrentSpec().GetFileName(), res.ProtoScenario.GetScenarioHeading())
+	flakinessMutex.Lock()
+	defer flakinessMutex.Unlock()
+	stats, ok := flakinessStats[key]
+	if !ok {
+		stats = &scenarioStats{}
+		flakinessStats[key] = stats
+	}
+	stats.runs++
+	if res.GetFailed() {
+		stats.failures++
+	}
+}
+
+func printFlakinessSummary() {
+	flaky := flakyScenarios(flakinessStats)
+	if len(flaky) == 0 {
+		logger.Infof(true, "\nNo flaky scenarios detected across %d runs.", RepeatCount)
+		return
+	}
+	logger.Infof(true, "\nFlaky scenarios (failed intermittently):")
+	for _, key := range flaky {
+		stats := flakinessStats[key]
+		passed := stats.runs - stats.failures
+		logger.Infof(true, "\t%s: passed %d of %d runs (%.0f%%)", key, passed, stats.runs, float64(passed)*100/float64(stats.runs))
+	}
+}
+
+// flakyScenarios returns the scenarios that both passed and failed at least
+// once, sorted for stable output. Consistent failures are not flaky.
+func flakyScenarios(stats map[string]*scenarioStats) []string {
+	var flaky []string
+	for key, s := range stats {
+		if s.failures > 0 && s.failures < s.runs {
+			flaky = append(flaky, key)
+		}
+	}
+	sort.Strings(flaky)
+	return flaky
+}
@@ -0,0 +1,43 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+)
+
+func TestFlakyScenariosDetectsIntermittentFailures(t *testing.T) {
+	stats := map[string]*scenarioStats{
+		"spec.spec: flaky":          {runs: 5, failures: 2},
+		"spec.spec: always passes":  {runs: 5, failures: 0},
+		"spec.spec: always fails":   {runs: 5, failures: 5},
+		"other.spec: flaky as well": {runs: 3, failures: 1},
+	}
+
+	flaky := flakyScenarios(stats)
+
+	expected := []string{"other.spec: flaky as well", "spec.spec: flaky"}
+	if len(flaky) != len(expected) {
+		t.Fatalf("Expected %d flaky scenarios, got %d: %v", len(expected), len(flaky), flaky)
+	}
+	for i, key := range expected {
+		if flaky[i] != key {
+			t.Errorf("Expected '%s' at position %d, got '%s'", key, i, flaky[i])
+		}
+	}
+}
+
+func TestFlakyScenariosWithNoIntermittentFailures(t *testing.T) {
+	stats := map[string]*scenarioStats{
+		"spec.spec: always passes": {runs: 5, failures: 0},
+		"spec.spec: always fails":  {runs: 5, failures: 5},
+	}
+
+	if flaky := flakyScenarios(stats); len(flaky) != 0 {
+		t.Errorf("Expected no flaky scenarios, got %v", flaky)
+	}
+}
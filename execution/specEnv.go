@@ -0,0 +1,72 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// envTagPrefix marks a spec tag declaring an environment overlay, e.g.
+// env:staging. The named environment's properties are applied for the
+// duration of that spec, allowing mixed-environment suites in one run.
+const envTagPrefix = "env:"
+
+// applySpecEnvOverlay applies the environment overlay declared by the
+// spec's env:<name> tag, if any, and returns a function restoring the
+// previous values. The overlay is in place before the spec's execution
+// events and hooks fire.
+func applySpecEnvOverlay(spec *gauge.Specification) func() {
+	name := specEnvTag(spec)
+	if name == "" {
+		return func() {}
+	}
+	overlay, err := env.PropertiesOf(name)
+	if err != nil {
+		logger.Warningf(true, "Unable to apply environment overlay '%s' for spec %s. %s", name, spec.FileName, err.Error())
+		return func() {}
+	}
+	logger.Debugf(true, "Applying environment overlay '%s' for spec %s", name, spec.FileName)
+	type previousValue struct {
+		value  string
+		wasSet bool
+	}
+	previous := make(map[string]previousValue)
+	for property, value := range overlay {
+		old, wasSet := os.LookupEnv(property)
+		previous[property] = previousValue{value: old, wasSet: wasSet}
+		os.Setenv(property, value)
+	}
+	return func() {
+		for property, old := range previous {
+			if old.wasSet {
+				os.Setenv(property, old.value)
+			} else {
+				os.Unsetenv(property)
+			}
+		}
+	}
+}
+
+// specEnvTag returns the environment named by the spec's env:<name> tag,
+// or an empty string when the spec declares none.
+func specEnvTag(spec *gauge.Specification) string {
+	if spec.Tags == nil {
+		return ""
+	}
+	for _, tag := range spec.Tags.Values() {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(strings.ToLower(tag), envTagPrefix) {
+			return strings.TrimSpace(tag[len(envTagPrefix):])
+		}
+	}
+	return ""
+}
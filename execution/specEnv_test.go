@@ -0,0 +1,80 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func specWithTags(tags ...string) *gauge.Specification {
+	return &gauge.Specification{FileName: "example.spec", Tags: &gauge.Tags{RawValues: [][]string{tags}}}
+}
+
+func TestSpecEnvTag(t *testing.T) {
+	if got := specEnvTag(specWithTags("env:staging")); got != "staging" {
+		t.Errorf("specEnvTag = %s, want staging", got)
+	}
+	if got := specEnvTag(specWithTags("ENV: staging ")); got != "staging" {
+		t.Errorf("specEnvTag = %s, want staging for mixed case prefix", got)
+	}
+	if got := specEnvTag(specWithTags("smoke", "serial")); got != "" {
+		t.Errorf("specEnvTag = %s, want empty when no env tag declared", got)
+	}
+	if got := specEnvTag(&gauge.Specification{}); got != "" {
+		t.Errorf("specEnvTag = %s, want empty when spec has no tags", got)
+	}
+}
+
+func TestApplySpecEnvOverlaySetsAndRestoresVariables(t *testing.T) {
+	old := env.PropertiesOf
+	env.PropertiesOf = func(envName string) (map[string]string, error) {
+		return map[string]string{"overlay_existing": "overridden", "overlay_new": "added"}, nil
+	}
+	defer func() { env.PropertiesOf = old }()
+
+	os.Setenv("overlay_existing", "original")
+	os.Unsetenv("overlay_new")
+	defer os.Unsetenv("overlay_existing")
+
+	restore := applySpecEnvOverlay(specWithTags("env:staging"))
+	if got := os.Getenv("overlay_existing"); got != "overridden" {
+		t.Errorf("overlay_existing = %s, want overridden while overlay is active", got)
+	}
+	if got := os.Getenv("overlay_new"); got != "added" {
+		t.Errorf("overlay_new = %s, want added while overlay is active", got)
+	}
+
+	restore()
+	if got := os.Getenv("overlay_existing"); got != "original" {
+		t.Errorf("overlay_existing = %s, want original after restore", got)
+	}
+	if _, wasSet := os.LookupEnv("overlay_new"); wasSet {
+		t.Error("overlay_new should be unset after restore")
+	}
+}
+
+func TestApplySpecEnvOverlayWithUnknownEnvironmentIsANoOp(t *testing.T) {
+	old := env.PropertiesOf
+	env.PropertiesOf = func(envName string) (map[string]string, error) {
+		return nil, fmt.Errorf("%s environment does not exist", envName)
+	}
+	defer func() { env.PropertiesOf = old }()
+
+	os.Setenv("overlay_existing", "original")
+	defer os.Unsetenv("overlay_existing")
+
+	restore := applySpecEnvOverlay(specWithTags("env:missing"))
+	if got := os.Getenv("overlay_existing"); got != "original" {
+		t.Errorf("overlay_existing = %s, want original when overlay cannot be loaded", got)
+	}
+	restore()
+}
@@ -25,6 +25,11 @@ import (
 	"github.com/getgauge/gauge/validation"
 )
 
+// tagValuesArg is the ExecutionArg carrying the spec's parameterized tags as
+// `name=value` strings, so hooks and report plugins get them in structured
+// form through ExecutionInfo.
+const tagValuesArg = "tag_values"
+
 type specExecutor struct {
 	specification        *gauge.Specification
 	runner               runner.Runner
@@ -46,8 +51,16 @@ func newSpecExecutor(s *gauge.Specification, r runner.Runner, ph plugin.Handler,
 		ProjectName:              filepath.Base(config.ProjectRoot),
 		NumberOfExecutionStreams: int32(NumberOfExecutionStreams),
 		RunnerId:                 int32(stream),
+		Stream:                   int32(stream),
+		ExecutionStrategy:        executionStrategy(),
 		ExecutionArgs:            gauge.ConvertToProtoExecutionArg(ExecutionArgs),
 	}
+	if dir := streamDirFor(stream); dir != "" {
+		ei.ExecutionArgs = append(ei.ExecutionArgs, &gauge_messages.ExecutionArg{FlagName: streamDirArg, FlagValue: []string{dir}})
+	}
+	if tagValues := parameterizedTagsOf(s); len(tagValues) > 0 {
+		ei.ExecutionArgs = append(ei.ExecutionArgs, &gauge_messages.ExecutionArg{FlagName: tagValuesArg, FlagValue: tagValues})
+	}
 
 	return &specExecutor{
 		specification:        s,
@@ -60,8 +73,43 @@ func newSpecExecutor(s *gauge.Specification, r runner.Runner, ph plugin.Handler,
 	}
 }
 
+// parameterizedTagsOf flattens the parameterized tags of the spec and its
+// scenarios to deduplicated `name=value` strings.
+func parameterizedTagsOf(s *gauge.Specification) []string {
+	var values []string
+	seen := make(map[string]bool)
+	addFrom := func(tags *gauge.Tags) {
+		if tags == nil {
+			return
+		}
+		for _, tagValue := range tags.TagValues() {
+			if tagValue.Value == "" {
+				continue
+			}
+			entry := tagValue.Name + "=" + tagValue.Value
+			if seen[entry] {
+				continue
+			}
+			seen[entry] = true
+			values = append(values, entry)
+		}
+	}
+	addFrom(s.Tags)
+	for _, scenario := range s.Scenarios {
+		addFrom(scenario.Tags)
+	}
+	return values
+}
+
 func (e *specExecutor) execute(executeBefore, execute, executeAfter bool) *result.SpecResult {
+	restoreEnv := applySpecEnvOverlay(e.specification)
+	defer restoreEnv()
 	e.specResult = gauge.NewSpecResult(e.specification)
+	if e.runner != nil {
+		if sampler := startRunnerUsageSampler(e.runner.Pid(), e.stream); sampler != nil {
+			defer func() { e.specResult.RunnerUsage = sampler.stop() }()
+		}
+	}
 	if errs, ok := e.errMap.SpecErrs[e.specification]; ok {
 		if hasParseError(errs) {
 			e.failSpec()
@@ -175,6 +223,7 @@ func (e *specExecutor) notifyBeforeSpecHook() {
 	e.specResult.ProtoSpec.PreHookMessages = res.Message
 	e.specResult.ProtoSpec.PreHookScreenshotFiles = res.ScreenshotFiles
 	e.specResult.ProtoSpec.PreHookScreenshots = res.Screenshots
+	e.specResult.HookResults = append(e.specResult.HookResults, result.NewHookResult(result.BeforeSpecHook, e.specification.FileName, res))
 	if res.GetFailed() {
 		setSpecFailure(e.currentExecutionInfo)
 		handleHookFailure(e.specResult, res, result.AddPreHook)
@@ -191,6 +240,7 @@ func (e *specExecutor) notifyAfterSpecHook() {
 	e.specResult.ProtoSpec.PostHookMessages = res.Message
 	e.specResult.ProtoSpec.PostHookScreenshotFiles = res.ScreenshotFiles
 	e.specResult.ProtoSpec.PostHookScreenshots = res.Screenshots
+	e.specResult.HookResults = append(e.specResult.HookResults, result.NewHookResult(result.AfterSpecHook, e.specification.FileName, res))
 	if res.GetFailed() {
 		setSpecFailure(e.currentExecutionInfo)
 		handleHookFailure(e.specResult, res, result.AddPostHook)
@@ -267,6 +317,9 @@ func (e *specExecutor) dataTableLookup() (*gauge.ArgLookup, error) {
 func (e *specExecutor) executeScenarios(scenarios []*gauge.Scenario) ([]result.Result, error) {
 	var scenarioResults []result.Result
 	for _, scenario := range scenarios {
+		if abortOnFailure() {
+			skipScenarioForAbort(e.specification, scenario, e.errMap)
+		}
 		sceResult, err := e.executeScenario(scenario)
 		if err != nil {
 			return nil, err
@@ -363,37 +416,76 @@ func setSpecFailure(executionInfo *gauge_messages.ExecutionInfo) {
 	executionInfo.CurrentSpec.IsFailed = true
 }
 
-func shouldExecuteForRow(i int) bool {
-	if len(tableRowsIndexes) < 1 {
+func shouldExecuteForRow(index int, row *gauge.Table) bool {
+	if selectedTableRows == nil {
 		return true
 	}
-	for _, index := range tableRowsIndexes {
-		if index == i {
+	return selectedTableRows.selectsRow(index, row)
+}
+
+// tableRowsSelection is the parsed --table-rows expression: a comma
+// separated list of 1-based row numbers, ranges like 1-3 (10- and -3 leave
+// one end open) and column-value filters like role=admin. A row is
+// selected when any part matches.
+type tableRowsSelection struct {
+	ranges  []rowRange
+	filters []columnFilter
+}
+
+// rowRange is a 1-based inclusive row range; end == -1 leaves the range
+// open till the last row.
+type rowRange struct {
+	start, end int
+}
+
+type columnFilter struct {
+	column, value string
+}
+
+func (selection *tableRowsSelection) selectsRow(index int, row *gauge.Table) bool {
+	rowNumber := index + 1
+	for _, r := range selection.ranges {
+		if rowNumber >= r.start && (r.end == -1 || rowNumber <= r.end) {
+			return true
+		}
+	}
+	for _, f := range selection.filters {
+		cells, err := row.Get(f.column)
+		if err == nil && len(cells) > 0 && cells[0].Value == f.value {
 			return true
 		}
 	}
 	return false
 }
 
-func getDataTableRows(tableRows string) (tableRowIndexes []int) {
-	switch {
-	case strings.TrimSpace(tableRows) == "":
-		return
-	case strings.Contains(tableRows, "-"):
-		indexes := strings.Split(tableRows, "-")
-		startRow, _ := strconv.Atoi(strings.TrimSpace(indexes[0]))
-		endRow, _ := strconv.Atoi(strings.TrimSpace(indexes[1]))
-		for i := startRow - 1; i < endRow; i++ {
-			tableRowIndexes = append(tableRowIndexes, i)
-		}
-	default:
-		indexes := strings.Split(tableRows, ",")
-		for _, i := range indexes {
-			rowNumber, _ := strconv.Atoi(strings.TrimSpace(i))
-			tableRowIndexes = append(tableRowIndexes, rowNumber-1)
+func getDataTableRows(tableRows string) *tableRowsSelection {
+	if strings.TrimSpace(tableRows) == "" {
+		return nil
+	}
+	selection := &tableRowsSelection{}
+	for _, part := range strings.Split(tableRows, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "":
+		case strings.Contains(part, "="):
+			kv := strings.SplitN(part, "=", 2)
+			selection.filters = append(selection.filters, columnFilter{column: strings.TrimSpace(kv[0]), value: strings.TrimSpace(kv[1])})
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			start, end := 1, -1
+			if b := strings.TrimSpace(bounds[0]); b != "" {
+				start, _ = strconv.Atoi(b)
+			}
+			if b := strings.TrimSpace(bounds[1]); b != "" {
+				end, _ = strconv.Atoi(b)
+			}
+			selection.ranges = append(selection.ranges, rowRange{start: start, end: end})
+		default:
+			rowNumber, _ := strconv.Atoi(part)
+			selection.ranges = append(selection.ranges, rowRange{start: rowNumber, end: rowNumber})
 		}
 	}
-	return
+	return selection
 }
 
 func executeHook(message *gauge_messages.Message, execTimeTracker result.ExecTimeTracker, r runner.Runner) *gauge_messages.ProtoExecutionResult {
@@ -75,25 +75,82 @@ func (specBuilder *specBuilder) tableRow(cells ...string) *specBuilder {
 }
 
 type tableRow struct {
-	name   string
-	input  string // input by user for data table rows
-	output []int  // data table indexes to be executed
+	name     string
+	input    string // input by user for data table rows
+	rowCount int
+	output   []int // data table indexes to be executed
 }
 
 var tableRowTests = []*tableRow{
-	{"Valid single row number", "2", []int{1}},
-	{"Valid row numbers list", "2,3,4", []int{1, 2, 3}},
-	{"Valid table rows range", "2-5", []int{1, 2, 3, 4}},
-	{"Empty table rows range", "", []int(nil)},
-	{"Table rows list with spaces", "2, 4 ", []int{1, 3}},
+	{"Valid single row number", "2", 6, []int{1}},
+	{"Valid row numbers list", "2,3,4", 6, []int{1, 2, 3}},
+	{"Valid table rows range", "2-5", 6, []int{1, 2, 3, 4}},
+	{"Empty table rows range", "", 6, []int{0, 1, 2, 3, 4, 5}},
+	{"Table rows list with spaces", "2, 4 ", 6, []int{1, 3}},
+	{"Open ended range", "4-", 6, []int{3, 4, 5}},
+	{"Range open at the start", "-2", 6, []int{0, 1}},
+	{"Mixed ranges and numbers", "1-2,5", 6, []int{0, 1, 4}},
 }
 
 func (s *MySuite) TestToGetDataTableRowsRangeFromInputFlag(c *C) {
 	for _, test := range tableRowTests {
-		got := getDataTableRows(test.input)
-		want := test.output
-		c.Assert(got, DeepEquals, want, Commentf(test.name))
+		selection := getDataTableRows(test.input)
+		var got []int
+		for i := 0; i < test.rowCount; i++ {
+			if selection == nil || selection.selectsRow(i, &gauge.Table{}) {
+				got = append(got, i)
+			}
+		}
+		c.Assert(got, DeepEquals, test.output, Commentf(test.name))
+	}
+}
+
+func dataTableRow(headers, values []string) *gauge.Table {
+	columns := make([][]gauge.TableCell, len(headers))
+	for i, value := range values {
+		columns[i] = []gauge.TableCell{gauge.GetTableCell(value)}
 	}
+	return gauge.NewTable(headers, columns, 1)
+}
+
+func (s *MySuite) TestColumnValueFilterSelectsMatchingRows(c *C) {
+	selection := getDataTableRows("role=admin")
+	admin := dataTableRow([]string{"id", "role"}, []string{"1", "admin"})
+	user := dataTableRow([]string{"id", "role"}, []string{"2", "user"})
+
+	c.Assert(selection.selectsRow(0, admin), Equals, true)
+	c.Assert(selection.selectsRow(1, user), Equals, false)
+}
+
+func (s *MySuite) TestColumnValueFilterCombinesWithRanges(c *C) {
+	selection := getDataTableRows("1,role=admin")
+	admin := dataTableRow([]string{"role"}, []string{"admin"})
+	user := dataTableRow([]string{"role"}, []string{"user"})
+
+	c.Assert(selection.selectsRow(0, user), Equals, true)
+	c.Assert(selection.selectsRow(3, admin), Equals, true)
+	c.Assert(selection.selectsRow(3, user), Equals, false)
+}
+
+func (s *MySuite) TestExecutionInfoCarriesStreamAndStrategy(c *C) {
+	oldInParallel, oldStrategy := InParallel, Strategy
+	InParallel, Strategy = true, "Lazy"
+	defer func() { InParallel, Strategy = oldInParallel, oldStrategy }()
+
+	se := newSpecExecutor(anySpec(), nil, nil, nil, 3)
+
+	c.Assert(se.currentExecutionInfo.GetStream(), Equals, int32(3))
+	c.Assert(se.currentExecutionInfo.GetExecutionStrategy(), Equals, "lazy")
+}
+
+func (s *MySuite) TestExecutionInfoStrategyIsEmptyInSerialExecution(c *C) {
+	oldInParallel := InParallel
+	InParallel = false
+	defer func() { InParallel = oldInParallel }()
+
+	se := newSpecExecutor(anySpec(), nil, nil, nil, 0)
+
+	c.Assert(se.currentExecutionInfo.GetExecutionStrategy(), Equals, "")
 }
 
 func (s *MySuite) TestCreateSkippedSpecResult(c *C) {
@@ -712,3 +769,25 @@ func TestExecuteShouldMarkSpecAsSkippedWhenAllScenariosSkipped(t *testing.T) {
 		t.Error("Expect SpecResult.Skipped = true, got false")
 	}
 }
+
+func TestParameterizedTagsOfFlattensSpecAndScenarioTags(t *testing.T) {
+	spec := &gauge.Specification{
+		Tags: &gauge.Tags{RawValues: [][]string{{"smoke", "priority(1)"}}},
+		Scenarios: []*gauge.Scenario{
+			{Tags: &gauge.Tags{RawValues: [][]string{{"owner(payments-team)", "priority(1)"}}}},
+			{Tags: nil},
+		},
+	}
+
+	got := parameterizedTagsOf(spec)
+
+	want := []string{"priority=1", "owner=payments-team"}
+	if len(got) != len(want) {
+		t.Fatalf("parameterizedTagsOf() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("parameterizedTagsOf()[%d] = %s, want %s", i, got[i], v)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sort"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// stepTiming aggregates the execution time of one step across the suite,
+// keyed by the parsed step text so all invocations of an implementation are
+// counted together regardless of argument values.
+type stepTiming struct {
+	stepText   string
+	executions int
+	total      time.Duration
+}
+
+func (t *stepTiming) average() time.Duration {
+	return t.total / time.Duration(t.executions)
+}
+
+// printSlowestSteps lists the top slowest_steps_count steps by cumulative
+// and by average duration, computed from the step execution results.
+func printSlowestSteps(suiteResult *result.SuiteResult) {
+	count := env.SlowestStepsCount()
+	if count <= 0 {
+		return
+	}
+	timings := collectStepTimings(suiteResult)
+	if len(timings) == 0 {
+		return
+	}
+	if count > len(timings) {
+		count = len(timings)
+	}
+	sort.SliceStable(timings, func(i, j int) bool { return timings[i].total > timings[j].total })
+	logger.Infof(true, "\nSlowest steps by cumulative time:")
+	for _, timing := range timings[:count] {
+		logger.Infof(true, "\t%s  %s (%d executions)", timing.total, timing.stepText, timing.executions)
+	}
+	sort.SliceStable(timings, func(i, j int) bool { return timings[i].average() > timings[j].average() })
+	logger.Infof(true, "\nSlowest steps by average time:")
+	for _, timing := range timings[:count] {
+		logger.Infof(true, "\t%s  %s (%d executions)", timing.average(), timing.stepText, timing.executions)
+	}
+}
+
+// collectStepTimings walks every executed step of the suite, recursing into
+// concepts, and aggregates execution time per step text.
+func collectStepTimings(suiteResult *result.SuiteResult) []*stepTiming {
+	timings := make(map[string]*stepTiming)
+	var addItems func(items []*gauge_messages.ProtoItem)
+	addItems = func(items []*gauge_messages.ProtoItem) {
+		for _, item := range items {
+			switch item.GetItemType() {
+			case gauge_messages.ProtoItem_Step:
+				addStepTiming(timings, item.GetStep())
+			case gauge_messages.ProtoItem_Concept:
+				addItems(item.GetConcept().GetSteps())
+			case gauge_messages.ProtoItem_Scenario:
+				addScenarioItems(addItems, item.GetScenario())
+			case gauge_messages.ProtoItem_TableDrivenScenario:
+				addScenarioItems(addItems, item.GetTableDrivenScenario().GetScenario())
+			}
+		}
+	}
+	for _, specResult := range suiteResult.SpecResults {
+		addItems(specResult.ProtoSpec.GetItems())
+	}
+	collected := make([]*stepTiming, 0, len(timings))
+	for _, timing := range timings {
+		collected = append(collected, timing)
+	}
+	sort.SliceStable(collected, func(i, j int) bool { return collected[i].stepText < collected[j].stepText })
+	return collected
+}
+
+func addScenarioItems(addItems func([]*gauge_messages.ProtoItem), scenario *gauge_messages.ProtoScenario) {
+	if scenario == nil {
+		return
+	}
+	addItems(scenario.GetContexts())
+	addItems(scenario.GetScenarioItems())
+	addItems(scenario.GetTearDownSteps())
+}
+
+func addStepTiming(timings map[string]*stepTiming, step *gauge_messages.ProtoStep) {
+	execResult := step.GetStepExecutionResult()
+	if execResult == nil || execResult.GetSkipped() {
+		return
+	}
+	stepText := step.GetParsedText()
+	if stepText == "" {
+		stepText = step.GetActualText()
+	}
+	timing, ok := timings[stepText]
+	if !ok {
+		timing = &stepTiming{stepText: stepText}
+		timings[stepText] = timing
+	}
+	timing.executions++
+	timing.total += time.Millisecond * time.Duration(execResult.GetExecutionResult().GetExecutionTime())
+}
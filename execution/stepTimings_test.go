@@ -0,0 +1,97 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func protoStepItem(parsedText string, executionTime int64, skipped bool) *gauge_messages.ProtoItem {
+	return &gauge_messages.ProtoItem{
+		ItemType: gauge_messages.ProtoItem_Step,
+		Step: &gauge_messages.ProtoStep{
+			ParsedText: parsedText,
+			StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+				Skipped:         skipped,
+				ExecutionResult: &gauge_messages.ProtoExecutionResult{ExecutionTime: executionTime},
+			},
+		},
+	}
+}
+
+func timedSuiteResult() *result.SuiteResult {
+	scenario := &gauge_messages.ProtoScenario{
+		Contexts: []*gauge_messages.ProtoItem{protoStepItem("setup", 5, false)},
+		ScenarioItems: []*gauge_messages.ProtoItem{
+			protoStepItem("slow step {}", 100, false),
+			protoStepItem("slow step {}", 300, false),
+			protoStepItem("fast step", 10, false),
+			protoStepItem("unexecuted step", 999, true),
+			{
+				ItemType: gauge_messages.ProtoItem_Concept,
+				Concept: &gauge_messages.ProtoConcept{
+					Steps: []*gauge_messages.ProtoItem{protoStepItem("concept inner step", 50, false)},
+				},
+			},
+		},
+	}
+	spec := &gauge_messages.ProtoSpec{Items: []*gauge_messages.ProtoItem{{
+		ItemType: gauge_messages.ProtoItem_Scenario,
+		Scenario: scenario,
+	}}}
+	return &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}
+}
+
+func timingFor(timings []*stepTiming, stepText string) *stepTiming {
+	for _, timing := range timings {
+		if timing.stepText == stepText {
+			return timing
+		}
+	}
+	return nil
+}
+
+func TestCollectStepTimingsAggregatesByParsedText(t *testing.T) {
+	timings := collectStepTimings(timedSuiteResult())
+
+	slow := timingFor(timings, "slow step {}")
+	if slow == nil {
+		t.Fatal("Expected a timing for 'slow step {}'")
+	}
+	if slow.executions != 2 {
+		t.Errorf("executions = %d, want 2", slow.executions)
+	}
+	if slow.total != 400*time.Millisecond {
+		t.Errorf("total = %s, want 400ms", slow.total)
+	}
+	if slow.average() != 200*time.Millisecond {
+		t.Errorf("average = %s, want 200ms", slow.average())
+	}
+}
+
+func TestCollectStepTimingsIncludesContextsAndConceptSteps(t *testing.T) {
+	timings := collectStepTimings(timedSuiteResult())
+
+	if timingFor(timings, "setup") == nil {
+		t.Error("Expected context steps to be counted")
+	}
+	if timingFor(timings, "concept inner step") == nil {
+		t.Error("Expected steps inside concepts to be counted")
+	}
+}
+
+func TestCollectStepTimingsSkipsUnexecutedSteps(t *testing.T) {
+	timings := collectStepTimings(timedSuiteResult())
+
+	if timingFor(timings, "unexecuted step") != nil {
+		t.Error("Expected skipped steps to be excluded")
+	}
+}
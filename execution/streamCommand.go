@@ -0,0 +1,56 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+)
+
+const gaugeStreamEnv = "GAUGE_STREAM"
+
+// executeBeforeStreamCommand runs the command configured as
+// before_stream_command for the given stream. The command sees the stream
+// number in GAUGE_STREAM, so it can allocate per-stream resources. A failure
+// skips the stream's specs.
+func executeBeforeStreamCommand(stream int) error {
+	return runStreamCommand(env.BeforeStreamCommand(), "before_stream_command", stream)
+}
+
+// executeAfterStreamCommand runs the command configured as
+// after_stream_command for the given stream. It runs whatever the stream's
+// outcome, so per-stream teardown always happens; a failure is only logged.
+func executeAfterStreamCommand(stream int) {
+	if err := runStreamCommand(env.AfterStreamCommand(), "after_stream_command", stream); err != nil {
+		logger.Errorf(true, "%s", err.Error())
+	}
+}
+
+func runStreamCommand(command, property string, stream int) error {
+	if command == "" {
+		return nil
+	}
+	logger.Debugf(true, "Running %s for stream %d: %s", property, stream, command)
+	parts := strings.Fields(command)
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Dir = config.ProjectRoot
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%d", gaugeStreamEnv, stream))
+	if dir := streamDirFor(stream); dir != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", gaugeStreamDirEnv, dir))
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %q failed for stream %d: %s\n%s", property, command, stream, err.Error(), strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+)
+
+func TestRunStreamCommandIsNoOpWhenUnset(t *testing.T) {
+	if err := runStreamCommand("", "before_stream_command", 1); err != nil {
+		t.Errorf("expected no error for unset command, got %s", err.Error())
+	}
+}
+
+func TestRunStreamCommandFailureNamesStream(t *testing.T) {
+	err := runStreamCommand("false", "before_stream_command", 3)
+
+	if err == nil {
+		t.Fatalf("expected an error for failing command")
+	}
+	if !strings.Contains(err.Error(), "stream 3") {
+		t.Errorf("expected error to name the stream, got %s", err.Error())
+	}
+}
+
+func TestExecuteBeforeStreamCommandSuccess(t *testing.T) {
+	oldCommand := env.BeforeStreamCommand
+	env.BeforeStreamCommand = func() string { return "true" }
+	defer func() { env.BeforeStreamCommand = oldCommand }()
+
+	if err := executeBeforeStreamCommand(1); err != nil {
+		t.Errorf("expected no error, got %s", err.Error())
+	}
+}
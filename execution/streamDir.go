@@ -0,0 +1,69 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	gaugeStreamDirEnv = "GAUGE_STREAM_DIR"
+	streamDirArg      = "stream_dir"
+)
+
+// streamDirs maps an execution stream to its scratch directory. Guarded by a
+// mutex because parallel streams start and finish concurrently.
+var (
+	streamDirsMutex sync.Mutex
+	streamDirs      = map[int]string{}
+)
+
+// setupStreamDir creates a scratch directory for the stream, so step
+// implementations of parallel runs have a per-stream workspace instead of
+// colliding on shared temp paths. The path reaches the runner as a stream_dir
+// execution arg and the stream commands as GAUGE_STREAM_DIR. Returns an empty
+// path when the directory could not be created.
+func setupStreamDir(stream int) string {
+	dir, err := ioutil.TempDir("", fmt.Sprintf("gauge_stream_%d_", stream))
+	if err != nil {
+		logger.Warningf(true, "Unable to create scratch directory for stream %d: %s", stream, err.Error())
+		return ""
+	}
+	streamDirsMutex.Lock()
+	streamDirs[stream] = dir
+	streamDirsMutex.Unlock()
+	logger.Debugf(true, "Created scratch directory %s for stream %d", dir, stream)
+	return dir
+}
+
+// streamDirFor returns the stream's scratch directory, or an empty string
+// when the stream does not have one.
+func streamDirFor(stream int) string {
+	streamDirsMutex.Lock()
+	defer streamDirsMutex.Unlock()
+	return streamDirs[stream]
+}
+
+// cleanupStreamDir removes the stream's scratch directory once the stream
+// finishes, after the after-stream command has run.
+func cleanupStreamDir(stream int) {
+	streamDirsMutex.Lock()
+	dir := streamDirs[stream]
+	delete(streamDirs, stream)
+	streamDirsMutex.Unlock()
+	if dir == "" {
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		logger.Warningf(true, "Unable to remove scratch directory %s of stream %d: %s", dir, stream, err.Error())
+	}
+}
@@ -0,0 +1,54 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSetupAndCleanupStreamDir(t *testing.T) {
+	dir := setupStreamDir(3)
+
+	if dir == "" {
+		t.Fatal("Expected a scratch directory to be created")
+	}
+	if streamDirFor(3) != dir {
+		t.Errorf("streamDirFor(3) = %s, want %s", streamDirFor(3), dir)
+	}
+
+	cleanupStreamDir(3)
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("Expected scratch directory %s to be removed", dir)
+	}
+	if streamDirFor(3) != "" {
+		t.Errorf("Expected stream 3 to have no scratch directory after cleanup")
+	}
+}
+
+func TestStreamDirsAreIsolatedPerStream(t *testing.T) {
+	first := setupStreamDir(1)
+	second := setupStreamDir(2)
+	defer cleanupStreamDir(1)
+	defer cleanupStreamDir(2)
+
+	if first == second {
+		t.Errorf("Expected each stream to get its own scratch directory, both got %s", first)
+	}
+	if streamDirFor(1) != first || streamDirFor(2) != second {
+		t.Errorf("streamDirFor = %s, %s, want %s, %s", streamDirFor(1), streamDirFor(2), first, second)
+	}
+}
+
+func TestCleanupStreamDirForUnknownStreamIsANoOp(t *testing.T) {
+	cleanupStreamDir(42)
+
+	if streamDirFor(42) != "" {
+		t.Errorf("Expected stream 42 to have no scratch directory")
+	}
+}
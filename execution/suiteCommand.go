@@ -0,0 +1,62 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// executeBeforeSuiteCommand runs the command configured as
+// before_suite_command, if any. Its output goes into the suite result's pre
+// hook messages; a failure is recorded as a pre suite hook failure and fails
+// the suite.
+func executeBeforeSuiteCommand(suiteResult *result.SuiteResult) {
+	runSuiteCommand(env.BeforeSuiteCommand(), "before_suite_command", suiteResult, result.AddPreHook, func(output string) {
+		suiteResult.PreHookMessages = append(suiteResult.PreHookMessages, output)
+	})
+}
+
+// executeAfterSuiteCommand runs the command configured as
+// after_suite_command, if any. It runs even when the suite failed, so
+// teardown always happens.
+func executeAfterSuiteCommand(suiteResult *result.SuiteResult) {
+	runSuiteCommand(env.AfterSuiteCommand(), "after_suite_command", suiteResult, result.AddPostHook, func(output string) {
+		suiteResult.PostHookMessages = append(suiteResult.PostHookMessages, output)
+	})
+}
+
+func runSuiteCommand(command, property string, suiteResult *result.SuiteResult, addHookFailure func(result.Result, *gauge_messages.ProtoExecutionResult), addMessage func(string)) {
+	if command == "" {
+		return
+	}
+	logger.Infof(true, "Running %s: %s", property, command)
+	var output bytes.Buffer
+	cmd, err := common.ExecuteSystemCommand(strings.Fields(command), config.ProjectRoot, &output, &output)
+	if err == nil {
+		err = cmd.Wait()
+	}
+	if outputText := strings.TrimSpace(output.String()); outputText != "" {
+		addMessage(outputText)
+	}
+	if err != nil {
+		logger.Errorf(true, "%s failed: %s", property, err.Error())
+		addHookFailure(suiteResult, &gauge_messages.ProtoExecutionResult{
+			Failed:       true,
+			ErrorMessage: fmt.Sprintf("%s %q failed: %s", property, command, err.Error()),
+			StackTrace:   output.String(),
+		})
+	}
+}
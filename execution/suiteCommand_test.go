@@ -0,0 +1,76 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+)
+
+func TestExecuteBeforeSuiteCommandCapturesOutput(t *testing.T) {
+	oldCommand := env.BeforeSuiteCommand
+	env.BeforeSuiteCommand = func() string { return "echo suite starting" }
+	defer func() { env.BeforeSuiteCommand = oldCommand }()
+	suiteResult := result.NewSuiteResult("", time.Now())
+
+	executeBeforeSuiteCommand(suiteResult)
+
+	if suiteResult.IsFailed {
+		t.Errorf("expected suite result to not be failed")
+	}
+	if len(suiteResult.PreHookMessages) != 1 || !strings.Contains(suiteResult.PreHookMessages[0], "suite starting") {
+		t.Errorf("expected command output in pre hook messages, got %v", suiteResult.PreHookMessages)
+	}
+}
+
+func TestExecuteBeforeSuiteCommandFailureFailsSuite(t *testing.T) {
+	oldCommand := env.BeforeSuiteCommand
+	env.BeforeSuiteCommand = func() string { return "false" }
+	defer func() { env.BeforeSuiteCommand = oldCommand }()
+	suiteResult := result.NewSuiteResult("", time.Now())
+
+	executeBeforeSuiteCommand(suiteResult)
+
+	if !suiteResult.IsFailed {
+		t.Errorf("expected suite result to be failed")
+	}
+	if suiteResult.PreSuite == nil {
+		t.Errorf("expected a pre suite hook failure")
+	}
+}
+
+func TestExecuteAfterSuiteCommandFailureIsRecordedAsPostHook(t *testing.T) {
+	oldCommand := env.AfterSuiteCommand
+	env.AfterSuiteCommand = func() string { return "false" }
+	defer func() { env.AfterSuiteCommand = oldCommand }()
+	suiteResult := result.NewSuiteResult("", time.Now())
+
+	executeAfterSuiteCommand(suiteResult)
+
+	if suiteResult.PostSuite == nil {
+		t.Errorf("expected a post suite hook failure")
+	}
+}
+
+func TestSuiteCommandsAreNoOpsWhenUnset(t *testing.T) {
+	oldBefore, oldAfter := env.BeforeSuiteCommand, env.AfterSuiteCommand
+	env.BeforeSuiteCommand = func() string { return "" }
+	env.AfterSuiteCommand = func() string { return "" }
+	defer func() { env.BeforeSuiteCommand, env.AfterSuiteCommand = oldBefore, oldAfter }()
+	suiteResult := result.NewSuiteResult("", time.Now())
+
+	executeBeforeSuiteCommand(suiteResult)
+	executeAfterSuiteCommand(suiteResult)
+
+	if suiteResult.IsFailed || len(suiteResult.PreHookMessages) != 0 || len(suiteResult.PostHookMessages) != 0 {
+		t.Errorf("expected suite result to be untouched")
+	}
+}
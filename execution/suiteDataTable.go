@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// SuiteTableFile holds the path to a CSV file with a suite parameter table
+// (e.g. browsers or locales). When set, the whole suite is executed once per
+// row with the row's values exported as environment variables to the runners.
+var SuiteTableFile string
+
+// suiteParameterSet describes the parameter row the current suite iteration
+// runs with; it is stamped onto the iteration's suite result.
+var suiteParameterSet string
+
+func executeSuiteDataDriven(specDirs []string) int {
+	headers, rows, err := parseSuiteTable(SuiteTableFile)
+	if err != nil {
+		logger.Fatalf(true, "Unable to read suite parameter table %s: %s", SuiteTableFile, err.Error())
+	}
+	exitCode := Success
+	for i, row := range rows {
+		var params []string
+		for j, header := range headers {
+			if err := os.Setenv(header, row[j]); err != nil {
+				logger.Fatalf(true, "Unable to set %s for suite parameter row %d: %s", header, i+1, err.Error())
+			}
+			params = append(params, fmt.Sprintf("%s=%s", header, row[j]))
+		}
+		suiteParameterSet = strings.Join(params, ", ")
+		logger.Infof(true, "Executing suite with parameters [%s] (row %d of %d)", suiteParameterSet, i+1, len(rows))
+		if code := executeSpecs(specDirs); code > exitCode {
+			exitCode = code
+		}
+	}
+	suiteParameterSet = ""
+	return exitCode
+}
+
+func parseSuiteTable(file string) (headers []string, rows [][]string, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) < 2 {
+		return nil, nil, fmt.Errorf("suite parameter table needs a header row and at least one data row")
+	}
+	for _, header := range records[0] {
+		headers = append(headers, strings.TrimSpace(header))
+	}
+	return headers, records[1:], nil
+}
@@ -0,0 +1,51 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// sampleSystemPressure reads the 1-minute load average and the fraction of
+// memory still available from /proc. ok is false when either cannot be read.
+func sampleSystemPressure() (load1 float64, memAvailableFraction float64, ok bool) {
+	loadData, err := ioutil.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0, 0, false
+	}
+	loadFields := strings.Fields(string(loadData))
+	if len(loadFields) < 1 {
+		return 0, 0, false
+	}
+	load1, err = strconv.ParseFloat(loadFields[0], 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	memData, err := ioutil.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, 0, false
+	}
+	var memTotal, memAvailable int64
+	for _, line := range strings.Split(string(memData), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			memTotal, _ = strconv.ParseInt(fields[1], 10, 64)
+		case "MemAvailable:":
+			memAvailable, _ = strconv.ParseInt(fields[1], 10, 64)
+		}
+	}
+	if memTotal <= 0 {
+		return 0, 0, false
+	}
+	return load1, float64(memAvailable) / float64(memTotal), true
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+// sampleSystemPressure is only implemented on platforms exposing /proc; the
+// stream governor keeps the configured limit elsewhere.
+func sampleSystemPressure() (load1 float64, memAvailableFraction float64, ok bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,70 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	scenarioTempDirEnv = "gauge_scenario_tempdir"
+	scenarioTempDirArg = "scenario_tempdir"
+)
+
+// setupScenarioTempDir creates a fresh temp directory for the scenario, so
+// step implementations have a place for scratch files without leaking them.
+// The path is exported via the gauge_scenario_tempdir environment variable
+// and as a scenario_tempdir execution arg in ExecutionInfo. Returns an empty
+// path when the directory could not be created.
+func setupScenarioTempDir(ei *gauge_messages.ExecutionInfo, stream int) string {
+	dir, err := ioutil.TempDir("", fmt.Sprintf("gauge_scenario_%d_", stream))
+	if err != nil {
+		logger.Warningf(true, "Unable to create scenario temp directory: %s", err.Error())
+		return ""
+	}
+	if err := os.Setenv(scenarioTempDirEnv, dir); err != nil {
+		logger.Warningf(true, "Unable to set env %s: %s", scenarioTempDirEnv, err.Error())
+	}
+	ei.ExecutionArgs = append(ei.ExecutionArgs, &gauge_messages.ExecutionArg{FlagName: scenarioTempDirArg, FlagValue: []string{dir}})
+	return dir
+}
+
+// cleanupScenarioTempDir removes the scenario's temp directory once the
+// scenario is done. A failed scenario's directory is retained when
+// keep_failed_scenario_tempdir is set, so its contents can be inspected.
+func cleanupScenarioTempDir(ei *gauge_messages.ExecutionInfo, dir string, failed bool) {
+	if dir == "" {
+		return
+	}
+	ei.ExecutionArgs = removeExecutionArg(ei.ExecutionArgs, scenarioTempDirArg)
+	if err := os.Unsetenv(scenarioTempDirEnv); err != nil {
+		logger.Warningf(true, "Unable to unset env %s: %s", scenarioTempDirEnv, err.Error())
+	}
+	if failed && env.KeepFailedScenarioTempDir() {
+		logger.Infof(true, "Keeping temp directory %s of failed scenario.", dir)
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		logger.Warningf(true, "Unable to remove scenario temp directory %s: %s", dir, err.Error())
+	}
+}
+
+func removeExecutionArg(args []*gauge_messages.ExecutionArg, flagName string) []*gauge_messages.ExecutionArg {
+	filtered := args[:0]
+	for _, arg := range args {
+		if arg.FlagName != flagName {
+			filtered = append(filtered, arg)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,58 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestSetupAndCleanupScenarioTempDir(t *testing.T) {
+	ei := &gauge_messages.ExecutionInfo{}
+
+	dir := setupScenarioTempDir(ei, 1)
+
+	if dir == "" {
+		t.Fatal("Expected a temp directory to be created")
+	}
+	if os.Getenv(scenarioTempDirEnv) != dir {
+		t.Errorf("Expected %s to be set to %s, got %s", scenarioTempDirEnv, dir, os.Getenv(scenarioTempDirEnv))
+	}
+	if len(ei.ExecutionArgs) != 1 || ei.ExecutionArgs[0].FlagName != scenarioTempDirArg {
+		t.Errorf("Expected %s execution arg, got %v", scenarioTempDirArg, ei.ExecutionArgs)
+	}
+
+	cleanupScenarioTempDir(ei, dir, false)
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("Expected temp directory %s to be removed", dir)
+	}
+	if len(ei.ExecutionArgs) != 0 {
+		t.Errorf("Expected execution arg to be removed, got %v", ei.ExecutionArgs)
+	}
+	if os.Getenv(scenarioTempDirEnv) != "" {
+		t.Errorf("Expected %s to be unset", scenarioTempDirEnv)
+	}
+}
+
+func TestCleanupScenarioTempDirKeepsFailedScenarioDir(t *testing.T) {
+	old := env.KeepFailedScenarioTempDir
+	env.KeepFailedScenarioTempDir = func() bool { return true }
+	defer func() { env.KeepFailedScenarioTempDir = old }()
+	ei := &gauge_messages.ExecutionInfo{}
+	dir := setupScenarioTempDir(ei, 1)
+
+	cleanupScenarioTempDir(ei, dir, true)
+
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("Expected temp directory %s of failed scenario to be kept", dir)
+	}
+	os.RemoveAll(dir)
+}
@@ -0,0 +1,146 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+const traceabilityMatrixFile = "traceability_matrix.csv"
+
+// ListenSuiteEndAndWriteTraceabilityMatrix writes a scenario-to-requirement
+// matrix to the reports directory once the suite finishes. Requirements are
+// read from traceability tags (e.g. @jira:PROJ-123) on specs and scenarios;
+// a spec-level tag covers every scenario of the spec. Registered when
+// generate_traceability_matrix is enabled.
+func ListenSuiteEndAndWriteTraceabilityMatrix(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				writeTraceabilityMatrix(e.Result.(*result.SuiteResult))
+				wg.Done()
+			}
+		}
+	}()
+}
+
+// traceabilityRow links one requirement to one executed scenario.
+type traceabilityRow struct {
+	requirement string
+	spec        string
+	scenario    string
+	status      string
+}
+
+func writeTraceabilityMatrix(suiteResult *result.SuiteResult) {
+	rows := traceabilityRows(suiteResult)
+	if len(rows) == 0 {
+		logger.Debugf(true, "No traceability tags found, skipping the traceability matrix.")
+		return
+	}
+	dir := reportsDir()
+	if err := os.MkdirAll(dir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dir, err.Error())
+		return
+	}
+	matrixFile := filepath.Join(dir, traceabilityMatrixFile)
+	file, err := os.Create(matrixFile)
+	if err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", matrixFile, err.Error())
+		return
+	}
+	defer file.Close()
+	writer := csv.NewWriter(file)
+	records := [][]string{{"requirement", "spec", "scenario", "status"}}
+	for _, row := range rows {
+		records = append(records, []string{row.requirement, row.spec, row.scenario, row.status})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", matrixFile, err.Error())
+		return
+	}
+	logger.Infof(true, "Traceability matrix written to %s", matrixFile)
+}
+
+// traceabilityRows builds the matrix from the tags recorded in the suite
+// result, sorted by requirement for stable output.
+func traceabilityRows(suiteResult *result.SuiteResult) []traceabilityRow {
+	var rows []traceabilityRow
+	for _, specResult := range suiteResult.SpecResults {
+		spec := specResult.ProtoSpec
+		specRequirements := requirementsOf(spec.GetTags())
+		for _, scenario := range scenariosOf(spec) {
+			requirements := append(requirementsOf(scenario.GetTags()), specRequirements...)
+			for _, requirement := range dedupe(requirements) {
+				rows = append(rows, traceabilityRow{
+					requirement: requirement,
+					spec:        spec.GetSpecHeading(),
+					scenario:    scenario.GetScenarioHeading(),
+					status:      strings.ToLower(scenario.GetExecutionStatus().String()),
+				})
+			}
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].requirement != rows[j].requirement {
+			return rows[i].requirement < rows[j].requirement
+		}
+		if rows[i].spec != rows[j].spec {
+			return rows[i].spec < rows[j].spec
+		}
+		return rows[i].scenario < rows[j].scenario
+	})
+	return rows
+}
+
+// requirementsOf returns the requirement references among the given tags,
+// e.g. jira:PROJ-123, normalized to a lower-case prefix.
+func requirementsOf(tags []string) []string {
+	var requirements []string
+	for _, tag := range tags {
+		idx := strings.Index(tag, ":")
+		if idx < 0 {
+			continue
+		}
+		prefix := strings.ToLower(strings.TrimSpace(tag[:idx]))
+		for _, traceability := range env.TraceabilityTagPrefixes() {
+			if prefix == traceability {
+				requirements = append(requirements, prefix+":"+strings.TrimSpace(tag[idx+1:]))
+				break
+			}
+		}
+	}
+	return requirements
+}
+
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var result []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func taggedScenarioItem(heading string, status gauge_messages.ExecutionStatus, tags ...string) *gauge_messages.ProtoItem {
+	return &gauge_messages.ProtoItem{
+		ItemType: gauge_messages.ProtoItem_Scenario,
+		Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: heading, ExecutionStatus: status, Tags: tags},
+	}
+}
+
+func TestTraceabilityRowsLinksRequirementsToScenarios(t *testing.T) {
+	oldPrefixes := env.TraceabilityTagPrefixes
+	env.TraceabilityTagPrefixes = func() []string { return []string{"jira", "req"} }
+	defer func() { env.TraceabilityTagPrefixes = oldPrefixes }()
+
+	spec := &gauge_messages.ProtoSpec{
+		SpecHeading: "Checkout",
+		Tags:        []string{"jira:PROJ-1", "nightly"},
+		Items: []*gauge_messages.ProtoItem{
+			taggedScenarioItem("Pay by card", gauge_messages.ExecutionStatus_PASSED, "req:PAY-7"),
+			taggedScenarioItem("Pay by wallet", gauge_messages.ExecutionStatus_FAILED),
+		},
+	}
+	suiteResult := &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}
+
+	rows := traceabilityRows(suiteResult)
+
+	if len(rows) != 3 {
+		t.Fatalf("rows = %v, want the spec tag on both scenarios plus the scenario tag", rows)
+	}
+	if rows[0].requirement != "jira:PROJ-1" || rows[0].scenario != "Pay by card" || rows[0].status != "passed" {
+		t.Errorf("rows[0] = %v, want jira:PROJ-1 covering 'Pay by card' as passed", rows[0])
+	}
+	if rows[1].requirement != "jira:PROJ-1" || rows[1].scenario != "Pay by wallet" || rows[1].status != "failed" {
+		t.Errorf("rows[1] = %v, want jira:PROJ-1 covering 'Pay by wallet' as failed", rows[1])
+	}
+	if rows[2].requirement != "req:PAY-7" || rows[2].scenario != "Pay by card" {
+		t.Errorf("rows[2] = %v, want req:PAY-7 covering 'Pay by card'", rows[2])
+	}
+}
+
+func TestTraceabilityRowsIgnoresUnconfiguredPrefixes(t *testing.T) {
+	oldPrefixes := env.TraceabilityTagPrefixes
+	env.TraceabilityTagPrefixes = func() []string { return []string{"jira"} }
+	defer func() { env.TraceabilityTagPrefixes = oldPrefixes }()
+
+	spec := &gauge_messages.ProtoSpec{
+		SpecHeading: "Checkout",
+		Items: []*gauge_messages.ProtoItem{
+			taggedScenarioItem("Pay by card", gauge_messages.ExecutionStatus_PASSED, "timeout:30s", "sanity"),
+		},
+	}
+	suiteResult := &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}
+
+	if rows := traceabilityRows(suiteResult); len(rows) != 0 {
+		t.Errorf("rows = %v, want none for tags without a traceability prefix", rows)
+	}
+}
+
+func TestTraceabilityRowsDedupesRepeatedRequirement(t *testing.T) {
+	oldPrefixes := env.TraceabilityTagPrefixes
+	env.TraceabilityTagPrefixes = func() []string { return []string{"jira"} }
+	defer func() { env.TraceabilityTagPrefixes = oldPrefixes }()
+
+	spec := &gauge_messages.ProtoSpec{
+		SpecHeading: "Checkout",
+		Tags:        []string{"jira:PROJ-1"},
+		Items: []*gauge_messages.ProtoItem{
+			taggedScenarioItem("Pay by card", gauge_messages.ExecutionStatus_PASSED, "jira:PROJ-1"),
+		},
+	}
+	suiteResult := &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}
+
+	if rows := traceabilityRows(suiteResult); len(rows) != 1 {
+		t.Errorf("rows = %v, want the repeated requirement reported once", rows)
+	}
+}
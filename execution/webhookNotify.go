@@ -0,0 +1,77 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+var webhookClient = &http.Client{Timeout: 30 * time.Second}
+
+// notifyWebhook posts the run summary to the incoming webhook configured via
+// notification_webhook_url, so teams get a Slack or Microsoft Teams message
+// per run without writing a plugin. Both services accept the plain
+// {"text": ...} payload of their incoming webhooks. Failures to deliver the
+// notification are only logged; they never fail the run.
+func notifyWebhook(suiteResult *result.SuiteResult) {
+	webhookURL := env.NotificationWebhookURL()
+	if webhookURL == "" {
+		return
+	}
+	payload, err := json.Marshal(map[string]string{"text": webhookMessage(suiteResult)})
+	if err != nil {
+		logger.Warningf(false, "Unable to build webhook notification payload: %s", err.Error())
+		return
+	}
+	res, err := webhookClient.Post(webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		logger.Warningf(false, "Unable to post run summary to webhook: %s", err.Error())
+		return
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 400 {
+		logger.Warningf(false, "Webhook rejected the run summary with status %s", res.Status)
+	}
+}
+
+// webhookMessage fills the configured message template with the run's
+// pass/fail counts, total time and report link.
+func webhookMessage(suiteResult *result.SuiteResult) string {
+	summary := summarize(suiteResult)
+	replacer := strings.NewReplacer(
+		"{{status}}", summary.Status,
+		"{{passed}}", strconv.Itoa(summary.Passed),
+		"{{failed}}", strconv.Itoa(summary.Failed),
+		"{{skipped}}", strconv.Itoa(summary.Skipped),
+		"{{totalTime}}", summary.TotalTime,
+		"{{reportLink}}", summary.ReportLink,
+	)
+	return replacer.Replace(env.NotificationMessageTemplate())
+}
+
+// reportLink points at the html-report of the run, the report most teams
+// publish from CI.
+func reportLink() string {
+	reportsDir := os.Getenv(env.GaugeReportsDir)
+	if !filepath.IsAbs(reportsDir) {
+		reportsDir = filepath.Join(config.ProjectRoot, reportsDir)
+	}
+	return filepath.Join(reportsDir, "html-report", "index.html")
+}
@@ -0,0 +1,86 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+)
+
+func stubNotificationProperties(t *testing.T, webhookURL, template string) {
+	t.Helper()
+	oldURL, oldTemplate := env.NotificationWebhookURL, env.NotificationMessageTemplate
+	env.NotificationWebhookURL = func() string { return webhookURL }
+	if template != "" {
+		env.NotificationMessageTemplate = func() string { return template }
+	}
+	t.Cleanup(func() {
+		env.NotificationWebhookURL = oldURL
+		env.NotificationMessageTemplate = oldTemplate
+	})
+}
+
+func suiteResultWithCounts(failed bool) *result.SuiteResult {
+	return &result.SuiteResult{
+		IsFailed:      failed,
+		ExecutionTime: 2000,
+		SpecResults: []*result.SpecResult{
+			{ScenarioCount: 4, ScenarioFailedCount: 1, ScenarioSkippedCount: 1},
+		},
+	}
+}
+
+func TestNotifyWebhookPostsRunSummary(t *testing.T) {
+	var payload map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("unable to read request body: %s", err.Error())
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("payload is not valid JSON: %s", err.Error())
+		}
+	}))
+	defer server.Close()
+	stubNotificationProperties(t, server.URL, "")
+
+	notifyWebhook(suiteResultWithCounts(true))
+
+	text := payload["text"]
+	for _, fragment := range []string{"failed", "2 passed", "1 failed", "1 skipped", "2s"} {
+		if !strings.Contains(text, fragment) {
+			t.Errorf("text = %q, want it to contain %q", text, fragment)
+		}
+	}
+}
+
+func TestNotifyWebhookIsANoOpWithoutURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("webhook should not be called when notification_webhook_url is unset")
+	}))
+	defer server.Close()
+	stubNotificationProperties(t, "", "")
+
+	notifyWebhook(suiteResultWithCounts(false))
+}
+
+func TestWebhookMessageUsesConfiguredTemplate(t *testing.T) {
+	stubNotificationProperties(t, "unused", "{{status}} with {{failed}} failure(s)")
+
+	message := webhookMessage(suiteResultWithCounts(true))
+
+	if message != "failed with 1 failure(s)" {
+		t.Errorf("message = %q, want the filled custom template", message)
+	}
+}
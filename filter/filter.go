@@ -28,7 +28,7 @@ func FilterSpecForParallelRun(specs []*gauge.Specification, tags string) ([]*gau
 }
 
 func specsFilters() []specsFilter {
-	return []specsFilter{&tagsFilter{ExecuteTags}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}, &scenariosFilter{ScenariosName}}
+	return []specsFilter{&tagsFilter{ExecuteTags}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}, &scenariosFilter{ScenariosName}, &selectorFilter{}}
 }
 
 func applyFilters(specsToExecute []*gauge.Specification, filters []specsFilter) []*gauge.Specification {
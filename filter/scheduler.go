@@ -0,0 +1,163 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package filter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// Scheduler assigns specifications to parallel execution streams. Distribute
+// returns one slice of specs per stream; a spec's position decides which
+// stream runs it. The default scheduler balances dependency groups
+// round-robin across streams. A custom Go implementation can be plugged in
+// with UseScheduler, or the `custom_scheduler_command` property can point at
+// an external command that receives spec metadata and returns assignments.
+type Scheduler interface {
+	Distribute(specs []*gauge.Specification, streams int) ([][]*gauge.Specification, error)
+}
+
+var customScheduler Scheduler
+
+// UseScheduler installs a custom distribution strategy for parallel
+// execution. Passing nil restores the default round-robin scheduler.
+func UseScheduler(s Scheduler) {
+	customScheduler = s
+}
+
+func activeScheduler() Scheduler {
+	if customScheduler != nil {
+		return customScheduler
+	}
+	if command := config.CustomSchedulerCommand(); command != "" {
+		return &commandScheduler{command: command}
+	}
+	return nil
+}
+
+// distributeWithScheduler runs the custom scheduler and validates its output:
+// every spec must be assigned to exactly one stream within range. Invalid
+// output falls back to the default distribution, so a misbehaving scheduler
+// cannot drop or duplicate specs.
+func distributeWithScheduler(scheduler Scheduler, specifications []*gauge.Specification, distributions int) ([]*gauge.SpecCollection, bool) {
+	assignments, err := scheduler.Distribute(specifications, distributions)
+	if err != nil {
+		logger.Warningf(true, "Custom scheduler failed: %s. Using the default distribution.", err.Error())
+		return nil, false
+	}
+	if err := validateAssignments(assignments, specifications, distributions); err != nil {
+		logger.Warningf(true, "Custom scheduler returned an invalid distribution: %s. Using the default distribution.", err.Error())
+		return nil, false
+	}
+	s := make([]*gauge.SpecCollection, distributions)
+	for stream, specs := range assignments {
+		s[stream] = gauge.NewSpecCollection(specs, false)
+	}
+	for i := len(assignments); i < distributions; i++ {
+		s[i] = gauge.NewSpecCollection(make([]*gauge.Specification, 0), false)
+	}
+	return s, true
+}
+
+func validateAssignments(assignments [][]*gauge.Specification, specifications []*gauge.Specification, distributions int) error {
+	if len(assignments) > distributions {
+		return fmt.Errorf("%d streams returned for %d available", len(assignments), distributions)
+	}
+	seen := make(map[*gauge.Specification]bool)
+	for _, specs := range assignments {
+		for _, spec := range specs {
+			if seen[spec] {
+				return fmt.Errorf("spec %s is assigned to more than one stream", spec.FileName)
+			}
+			seen[spec] = true
+		}
+	}
+	for _, spec := range specifications {
+		if !seen[spec] {
+			return fmt.Errorf("spec %s is not assigned to any stream", spec.FileName)
+		}
+	}
+	return nil
+}
+
+// commandScheduler delegates distribution to an external command. The command
+// receives a JSON document on stdin:
+//
+//	{"streams": 4, "specs": [{"fileName": "...", "heading": "...", "tags": [...], "scenarioCount": 3}, ...]}
+//
+// and must print stream numbers to stdout, one 1-based stream per input spec,
+// in input order:
+//
+//	{"assignments": [1, 3, 1, 2]}
+type commandScheduler struct {
+	command string
+}
+
+type schedulerSpec struct {
+	FileName      string   `json:"fileName"`
+	Heading       string   `json:"heading"`
+	Tags          []string `json:"tags"`
+	ScenarioCount int      `json:"scenarioCount"`
+}
+
+type schedulerRequest struct {
+	Streams int             `json:"streams"`
+	Specs   []schedulerSpec `json:"specs"`
+}
+
+type schedulerResponse struct {
+	Assignments []int `json:"assignments"`
+}
+
+func (s *commandScheduler) Distribute(specs []*gauge.Specification, streams int) ([][]*gauge.Specification, error) {
+	request := schedulerRequest{Streams: streams}
+	for _, spec := range specs {
+		meta := schedulerSpec{FileName: spec.FileName, ScenarioCount: len(spec.Scenarios)}
+		if spec.Heading != nil {
+			meta.Heading = spec.Heading.Value
+		}
+		if spec.Tags != nil {
+			meta.Tags = spec.Tags.Values()
+		}
+		request.Specs = append(request.Specs, meta)
+	}
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := common.GetExecutableCommand(false, strings.Fields(s.command)...)
+	cmd.Dir = config.ProjectRoot
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("scheduler command %s failed: %s. %s", s.command, err.Error(), stderr.String())
+	}
+	var response schedulerResponse
+	if err = json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("unable to parse scheduler command output: %s", err.Error())
+	}
+	if len(response.Assignments) != len(specs) {
+		return nil, fmt.Errorf("scheduler command returned %d assignments for %d specs", len(response.Assignments), len(specs))
+	}
+	assignments := make([][]*gauge.Specification, streams)
+	for i, stream := range response.Assignments {
+		if stream < 1 || stream > streams {
+			return nil, fmt.Errorf("stream %d for spec %s is out of range 1..%d", stream, specs[i].FileName, streams)
+		}
+		assignments[stream-1] = append(assignments[stream-1], specs[i])
+	}
+	return assignments, nil
+}
@@ -0,0 +1,64 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package filter
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+type reverseScheduler struct{}
+
+func (s *reverseScheduler) Distribute(specs []*gauge.Specification, streams int) ([][]*gauge.Specification, error) {
+	assignments := make([][]*gauge.Specification, streams)
+	for i, spec := range specs {
+		stream := streams - 1 - (i % streams)
+		assignments[stream] = append(assignments[stream], spec)
+	}
+	return assignments, nil
+}
+
+type lopsidedScheduler struct{}
+
+func (s *lopsidedScheduler) Distribute(specs []*gauge.Specification, streams int) ([][]*gauge.Specification, error) {
+	return [][]*gauge.Specification{specs[1:]}, nil
+}
+
+func schedulerTestSpecs() []*gauge.Specification {
+	var specs []*gauge.Specification
+	for _, name := range []string{"first.spec", "second.spec", "third.spec"} {
+		specs = append(specs, &gauge.Specification{FileName: name, Heading: &gauge.Heading{Value: name}})
+	}
+	return specs
+}
+
+func (s *MySuite) TestDistributeSpecsWithCustomScheduler(c *C) {
+	defer UseScheduler(nil)
+	UseScheduler(&reverseScheduler{})
+	specs := schedulerTestSpecs()
+
+	collections := DistributeSpecs(specs, 2)
+
+	c.Assert(len(collections), Equals, 2)
+	c.Assert(collections[0].Specs()[0].FileName, Equals, "second.spec")
+	c.Assert(collections[1].Specs()[0].FileName, Equals, "first.spec")
+	c.Assert(collections[1].Specs()[1].FileName, Equals, "third.spec")
+}
+
+func (s *MySuite) TestInvalidSchedulerOutputFallsBackToDefaultDistribution(c *C) {
+	defer UseScheduler(nil)
+	UseScheduler(&lopsidedScheduler{})
+	specs := schedulerTestSpecs()
+
+	collections := DistributeSpecs(specs, 2)
+
+	total := 0
+	for _, collection := range collections {
+		total += collection.Size()
+	}
+	c.Assert(total, Equals, len(specs))
+}
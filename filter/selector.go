@@ -0,0 +1,205 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package filter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// Selector narrows the candidate scenarios of a run to the subset an
+// external test impact analysis service decides is affected. Select receives
+// every scenario that would run plus the VCS state of the working copy and
+// returns the candidates to keep. A custom Go implementation can be plugged
+// in with UseSelector, or the `test_selector_url` property can point at an
+// HTTP endpoint that receives the candidates as JSON.
+type Selector interface {
+	Select(candidates []ScenarioCandidate, vcs VCSMetadata) ([]ScenarioCandidate, error)
+}
+
+// ScenarioCandidate identifies one scenario that is about to run.
+type ScenarioCandidate struct {
+	SpecFileName string   `json:"specFileName"`
+	SpecHeading  string   `json:"specHeading"`
+	Heading      string   `json:"heading"`
+	LineNo       int      `json:"lineNo"`
+	Tags         []string `json:"tags"`
+}
+
+// VCSMetadata describes the working copy the run executes against, so the
+// selector can map code changes to affected scenarios.
+type VCSMetadata struct {
+	Branch       string   `json:"branch"`
+	Commit       string   `json:"commit"`
+	ChangedFiles []string `json:"changedFiles"`
+}
+
+var customSelector Selector
+
+// UseSelector installs a custom test impact analysis selector. Passing nil
+// restores the default behaviour of running every candidate.
+func UseSelector(s Selector) {
+	customSelector = s
+}
+
+func activeSelector() Selector {
+	if customSelector != nil {
+		return customSelector
+	}
+	if url := config.TestSelectorURL(); url != "" {
+		return &httpSelector{url: url}
+	}
+	return nil
+}
+
+// selectorFilter applies the active selector as the last spec filter. When
+// the selector fails, every candidate runs, so an unreachable service cannot
+// block the suite.
+type selectorFilter struct{}
+
+func (sf *selectorFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
+	selector := activeSelector()
+	if selector == nil || len(specs) == 0 {
+		return specs
+	}
+	candidates := candidatesOf(specs)
+	selected, err := selector.Select(candidates, vcsMetadata())
+	if err != nil {
+		logger.Warningf(true, "Test selector failed: %s. Running all %d candidate scenarios.", err.Error(), len(candidates))
+		return specs
+	}
+	logger.Infof(true, "Test selector picked %d of %d candidate scenarios.", len(selected), len(candidates))
+	return filterToSelection(specs, selected)
+}
+
+func candidatesOf(specs []*gauge.Specification) []ScenarioCandidate {
+	var candidates []ScenarioCandidate
+	for _, spec := range specs {
+		for _, scenario := range spec.Scenarios {
+			candidate := ScenarioCandidate{
+				SpecFileName: spec.FileName,
+				Heading:      scenario.Heading.Value,
+				LineNo:       scenario.Heading.LineNo,
+			}
+			if spec.Heading != nil {
+				candidate.SpecHeading = spec.Heading.Value
+			}
+			if scenario.Tags != nil {
+				candidate.Tags = scenario.Tags.Values()
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates
+}
+
+// filterToSelection keeps only the selected scenarios, dropping specs that
+// end up with none.
+func filterToSelection(specs []*gauge.Specification, selected []ScenarioCandidate) []*gauge.Specification {
+	selection := make(map[string]bool, len(selected))
+	for _, candidate := range selected {
+		selection[selectionKey(candidate.SpecFileName, candidate.Heading)] = true
+	}
+	filteredSpecs := make([]*gauge.Specification, 0)
+	for _, spec := range specs {
+		s, _ := spec.Filter(&scenarioFilterBasedOnSelection{fileName: spec.FileName, selection: selection})
+		if len(s.Scenarios) != 0 {
+			filteredSpecs = append(filteredSpecs, s)
+		}
+	}
+	return filteredSpecs
+}
+
+type scenarioFilterBasedOnSelection struct {
+	fileName  string
+	selection map[string]bool
+}
+
+func (filter *scenarioFilterBasedOnSelection) Filter(item gauge.Item) bool {
+	return !filter.selection[selectionKey(filter.fileName, item.(*gauge.Scenario).Heading.Value)]
+}
+
+func selectionKey(fileName, scenarioHeading string) string {
+	return fileName + "|" + scenarioHeading
+}
+
+// gitOutput runs a git command in the project root; a variable so tests can
+// stub the VCS state.
+var gitOutput = func(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = config.ProjectRoot
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+// vcsMetadata collects the branch, commit and locally changed files of the
+// working copy. Fields stay empty outside a git repository.
+func vcsMetadata() VCSMetadata {
+	var metadata VCSMetadata
+	if branch, err := gitOutput("rev-parse", "--abbrev-ref", "HEAD"); err == nil {
+		metadata.Branch = branch
+	}
+	if commit, err := gitOutput("rev-parse", "HEAD"); err == nil {
+		metadata.Commit = commit
+	}
+	if files, err := gitOutput("diff", "--name-only", "HEAD"); err == nil && files != "" {
+		metadata.ChangedFiles = strings.Split(files, "\n")
+	}
+	return metadata
+}
+
+// httpSelector asks a test impact analysis service over HTTP. The service
+// receives a JSON document:
+//
+//	{"vcs": {"branch": "...", "commit": "...", "changedFiles": [...]}, "candidates": [...]}
+//
+// and must answer with the subset to run:
+//
+//	{"selected": [{"specFileName": "...", "heading": "..."}, ...]}
+type httpSelector struct {
+	url string
+}
+
+type selectorRequest struct {
+	VCS        VCSMetadata         `json:"vcs"`
+	Candidates []ScenarioCandidate `json:"candidates"`
+}
+
+type selectorResponse struct {
+	Selected []ScenarioCandidate `json:"selected"`
+}
+
+var selectorClient = &http.Client{Timeout: 30 * time.Second}
+
+func (s *httpSelector) Select(candidates []ScenarioCandidate, vcs VCSMetadata) ([]ScenarioCandidate, error) {
+	input, err := json.Marshal(selectorRequest{VCS: vcs, Candidates: candidates})
+	if err != nil {
+		return nil, err
+	}
+	res, err := selectorClient.Post(s.url, "application/json", bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("test selector service responded with %s", res.Status)
+	}
+	var response selectorResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("unable to parse test selector response: %s", err.Error())
+	}
+	return response.Selected, nil
+}
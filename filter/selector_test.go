@@ -0,0 +1,111 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+type headingSelector struct {
+	heading string
+}
+
+func (s *headingSelector) Select(candidates []ScenarioCandidate, vcs VCSMetadata) ([]ScenarioCandidate, error) {
+	var selected []ScenarioCandidate
+	for _, candidate := range candidates {
+		if candidate.Heading == s.heading {
+			selected = append(selected, candidate)
+		}
+	}
+	return selected, nil
+}
+
+type failingSelector struct{}
+
+func (s *failingSelector) Select(candidates []ScenarioCandidate, vcs VCSMetadata) ([]ScenarioCandidate, error) {
+	return nil, errors.New("service unreachable")
+}
+
+func selectorTestSpecs() []*gauge.Specification {
+	var specs []*gauge.Specification
+	for _, name := range []string{"first.spec", "second.spec"} {
+		spec := &gauge.Specification{FileName: name, Heading: &gauge.Heading{Value: name}}
+		for _, heading := range []string{"scenario one", "scenario two"} {
+			scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: heading}}
+			spec.Scenarios = append(spec.Scenarios, scenario)
+			spec.Items = append(spec.Items, scenario)
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func (s *MySuite) TestSelectorFilterKeepsOnlySelectedScenarios(c *C) {
+	defer UseSelector(nil)
+	UseSelector(&headingSelector{heading: "scenario two"})
+
+	specs := (&selectorFilter{}).filter(selectorTestSpecs())
+
+	c.Assert(specs, HasLen, 2)
+	for _, spec := range specs {
+		c.Assert(spec.Scenarios, HasLen, 1)
+		c.Assert(spec.Scenarios[0].Heading.Value, Equals, "scenario two")
+	}
+}
+
+func (s *MySuite) TestSelectorFailureRunsAllCandidates(c *C) {
+	defer UseSelector(nil)
+	UseSelector(&failingSelector{})
+
+	specs := (&selectorFilter{}).filter(selectorTestSpecs())
+
+	c.Assert(specs, HasLen, 2)
+	c.Assert(specs[0].Scenarios, HasLen, 2)
+}
+
+func (s *MySuite) TestHTTPSelectorSendsCandidatesAndVCSMetadata(c *C) {
+	var request selectorRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		c.Assert(err, IsNil)
+		c.Assert(json.Unmarshal(body, &request), IsNil)
+		response := selectorResponse{Selected: request.Candidates[:1]}
+		c.Assert(json.NewEncoder(w).Encode(response), IsNil)
+	}))
+	defer server.Close()
+	selector := &httpSelector{url: server.URL}
+	candidates := candidatesOf(selectorTestSpecs())
+	vcs := VCSMetadata{Branch: "main", Commit: "abc123", ChangedFiles: []string{"api/user.go"}}
+
+	selected, err := selector.Select(candidates, vcs)
+
+	c.Assert(err, IsNil)
+	c.Assert(selected, HasLen, 1)
+	c.Assert(selected[0].SpecFileName, Equals, "first.spec")
+	c.Assert(request.VCS.Branch, Equals, "main")
+	c.Assert(request.VCS.ChangedFiles, DeepEquals, []string{"api/user.go"})
+	c.Assert(request.Candidates, HasLen, 4)
+}
+
+func (s *MySuite) TestHTTPSelectorRejectsNonOKResponse(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	selector := &httpSelector{url: server.URL}
+
+	_, err := selector.Select(candidatesOf(selectorTestSpecs()), VCSMetadata{})
+
+	c.Assert(err, NotNil)
+}
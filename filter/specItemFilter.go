@@ -17,6 +17,7 @@ import (
 
 	"fmt"
 
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 )
@@ -27,6 +28,9 @@ type scenarioFilterBasedOnSpan struct {
 type ScenarioFilterBasedOnTags struct {
 	specTags      []string
 	tagExpression string
+	// tagValues maps the names of parameterized tags (`priority(1)`) to the
+	// values they carry, for comparison terms like `priority<=2`.
+	tagValues map[string]string
 }
 
 type scenarioFilterBasedOnName struct {
@@ -47,15 +51,19 @@ func (filter *scenarioFilterBasedOnSpan) Filter(item gauge.Item) bool {
 }
 
 func NewScenarioFilterBasedOnTags(specTags []string, tagExp string) *ScenarioFilterBasedOnTags {
-	return &ScenarioFilterBasedOnTags{specTags, tagExp}
+	return &ScenarioFilterBasedOnTags{specTags: specTags, tagExpression: tagExp}
 }
 
 func (filter *ScenarioFilterBasedOnTags) Filter(item gauge.Item) bool {
-	tags := item.(*gauge.Scenario).Tags
-	if tags == nil {
-		return !filter.filterTags(filter.specTags)
+	var scenarioTags []string
+	if tags := item.(*gauge.Scenario).Tags; tags != nil {
+		scenarioTags = tags.Values()
 	}
-	return !filter.filterTags(append(tags.Values(), filter.specTags...))
+	inheritedTags := filter.specTags
+	if !env.AllowTagInheritance() {
+		inheritedTags = nil
+	}
+	return !filter.filterTags(gauge.EffectiveTags(inheritedTags, scenarioTags))
 }
 
 func newScenarioFilterBasedOnName(scenariosName []string) *scenarioFilterBasedOnName {
@@ -75,12 +83,17 @@ func sanitize(tag string) string {
 
 func (filter *ScenarioFilterBasedOnTags) filterTags(stags []string) bool {
 	tagsMap := make(map[string]bool)
+	filter.tagValues = make(map[string]string)
 	for _, tag := range stags {
 		tag = sanitize(tag)
-		tagsMap[strings.Replace(tag, " ", "", -1)] = true
+		tag = strings.Replace(tag, " ", "", -1)
+		tagsMap[tag] = true
+		if tagValue := gauge.ParseTagValue(tag); tagValue.Value != "" {
+			filter.tagValues[tagValue.Name] = tagValue.Value
+		}
 	}
 	filter.replaceSpecialChar()
-	value, _ := filter.formatAndEvaluateExpression(tagsMap, filter.isTagPresent)
+	value, _ := filter.formatAndEvaluateExpression(tagsMap, filter.isTagQualified)
 	return value
 }
 
@@ -161,6 +174,65 @@ func (filter *ScenarioFilterBasedOnTags) isTagPresent(tagsMap map[string]bool, t
 	return ok
 }
 
+// isTagQualified reports whether a term of the tag expression holds: the tag
+// is present as written, or it is a comparison like `priority<=2` satisfied
+// by the value a parameterized tag carries.
+func (filter *ScenarioFilterBasedOnTags) isTagQualified(tagsMap map[string]bool, tagName string) bool {
+	if filter.isTagPresent(tagsMap, tagName) {
+		return true
+	}
+	return filter.evaluateComparison(tagName)
+}
+
+var tagComparisonRegex = regexp.MustCompile(`^(.+?)(<=|>=|==|<|>|=)(.+)$`)
+
+func (filter *ScenarioFilterBasedOnTags) evaluateComparison(term string) bool {
+	match := tagComparisonRegex.FindStringSubmatch(term)
+	if match == nil {
+		return false
+	}
+	got, ok := filter.tagValues[match[1]]
+	if !ok {
+		return false
+	}
+	return compareTagValues(got, match[2], match[3])
+}
+
+// compareTagValues compares numerically when both sides are numbers and
+// lexically otherwise.
+func compareTagValues(got, operator, want string) bool {
+	gotNumber, gotErr := strconv.ParseFloat(got, 64)
+	wantNumber, wantErr := strconv.ParseFloat(want, 64)
+	if gotErr == nil && wantErr == nil {
+		switch operator {
+		case "<":
+			return gotNumber < wantNumber
+		case "<=":
+			return gotNumber <= wantNumber
+		case ">":
+			return gotNumber > wantNumber
+		case ">=":
+			return gotNumber >= wantNumber
+		case "=", "==":
+			return gotNumber == wantNumber
+		}
+		return false
+	}
+	switch operator {
+	case "<":
+		return got < want
+	case "<=":
+		return got <= want
+	case ">":
+		return got > want
+	case ">=":
+		return got >= want
+	case "=", "==":
+		return got == want
+	}
+	return false
+}
+
 func (filter *ScenarioFilterBasedOnTags) parseTagExpression() (tagExpressionParts []string, tags []string) {
 	isValidOperator := func(r rune) bool { return r == '&' || r == '|' || r == '(' || r == ')' || r == '!' }
 	var word string
@@ -7,6 +7,7 @@
 package filter
 
 import (
+	"os"
 	"testing"
 
 	"github.com/getgauge/gauge/gauge"
@@ -805,3 +806,77 @@ func (s *MySuite) TestScenarioTagFilterShouldNotRemoveNonScenarioKindItems(c *C)
 	c.Assert(len(specWithOtherItems), Equals, 1)
 	c.Assert(len(specWithOtherItems[0].Items), Equals, 4)
 }
+
+func (s *MySuite) TestToEvaluateTagExpressionWithValueComparison(c *C) {
+	filter := &ScenarioFilterBasedOnTags{tagExpression: "priority<=2"}
+	c.Assert(filter.filterTags([]string{"priority(1)", "smoke"}), Equals, true)
+	filter = &ScenarioFilterBasedOnTags{tagExpression: "priority<=2"}
+	c.Assert(filter.filterTags([]string{"priority(3)", "smoke"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionComparingTagValuesLexically(c *C) {
+	filter := &ScenarioFilterBasedOnTags{tagExpression: "owner=payments-team"}
+	c.Assert(filter.filterTags([]string{"owner(payments-team)"}), Equals, true)
+	filter = &ScenarioFilterBasedOnTags{tagExpression: "owner=payments-team"}
+	c.Assert(filter.filterTags([]string{"owner(platform-team)"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionMixingComparisonsAndPresence(c *C) {
+	filter := &ScenarioFilterBasedOnTags{tagExpression: "smoke & priority<2"}
+	c.Assert(filter.filterTags([]string{"smoke", "priority(1)"}), Equals, true)
+	filter = &ScenarioFilterBasedOnTags{tagExpression: "smoke & priority<2"}
+	c.Assert(filter.filterTags([]string{"priority(1)"}), Equals, false)
+}
+
+func (s *MySuite) TestComparisonAgainstAnAbsentTagDoesNotQualify(c *C) {
+	filter := &ScenarioFilterBasedOnTags{tagExpression: "priority<=2"}
+	c.Assert(filter.filterTags([]string{"smoke"}), Equals, false)
+}
+
+func (s *MySuite) TestScenarioTagCanSuppressAnInheritedSpecTag(c *C) {
+	scenario1 := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "First Scenario"},
+		Span:    &gauge.Span{Start: 1, End: 3},
+		Tags:    &gauge.Tags{RawValues: [][]string{{"!slow"}}},
+	}
+	scenario2 := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Second Scenario"},
+		Span:    &gauge.Span{Start: 4, End: 6},
+	}
+	spec := &gauge.Specification{
+		Items:     []gauge.Item{scenario1, scenario2},
+		Scenarios: []*gauge.Scenario{scenario1, scenario2},
+		Tags:      &gauge.Tags{RawValues: [][]string{{"slow"}}},
+	}
+
+	filteredSpecs, otherSpecs := filterSpecsByTags([]*gauge.Specification{spec}, "slow")
+
+	c.Assert(len(filteredSpecs), Equals, 1)
+	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 1)
+	c.Assert(filteredSpecs[0].Scenarios[0], Equals, scenario2)
+	c.Assert(len(otherSpecs), Equals, 1)
+	c.Assert(otherSpecs[0].Scenarios[0], Equals, scenario1)
+}
+
+func (s *MySuite) TestTagInheritanceCanBeDisabled(c *C) {
+	os.Setenv("allow_tag_inheritance", "false")
+	defer os.Unsetenv("allow_tag_inheritance")
+	scenario1 := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "First Scenario"},
+		Span:    &gauge.Span{Start: 1, End: 3},
+		Tags:    &gauge.Tags{RawValues: [][]string{{"smoke"}}},
+	}
+	scenario2 := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Second Scenario"},
+		Span:    &gauge.Span{Start: 4, End: 6},
+	}
+	spec := &gauge.Specification{
+		Items:     []gauge.Item{scenario1, scenario2},
+		Scenarios: []*gauge.Scenario{scenario1, scenario2},
+		Tags:      &gauge.Tags{RawValues: [][]string{{"slow"}}},
+	}
+
+	filteredSpecs, _ := filterSpecsByTags([]*gauge.Specification{spec}, "slow")
+
+	c.Assert(len(filteredSpecs), Equals, 0)
+}
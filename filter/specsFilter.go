@@ -11,6 +11,7 @@ import (
 
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/order"
 )
 
 type specsFilter interface {
@@ -77,13 +78,22 @@ func (scenarioFilter *scenariosFilter) filter(specs []*gauge.Specification) []*g
 }
 
 func DistributeSpecs(specifications []*gauge.Specification, distributions int) []*gauge.SpecCollection {
+	if scheduler := activeScheduler(); scheduler != nil {
+		if collections, ok := distributeWithScheduler(scheduler, specifications, distributions); ok {
+			return collections
+		}
+	}
 	s := make([]*gauge.SpecCollection, distributions)
-	for i := 0; i < len(specifications); i++ {
+	// specs related via @depends_on stay on the same stream, in dependency order
+	groups := order.DependencyGroups(specifications)
+	for i := 0; i < len(groups); i++ {
 		mod := i % distributions
 		if s[mod] == nil {
 			s[mod] = gauge.NewSpecCollection(make([]*gauge.Specification, 0), false)
 		}
-		s[mod].Add(specifications[i])
+		for _, spec := range groups[i] {
+			s[mod].Add(spec)
+		}
 	}
 	return s
 }
@@ -75,9 +75,9 @@ func FormatStep(step *gauge.Step) string {
 	}
 	stepText := ""
 	if strings.HasSuffix(text, "\n") {
-		stepText = fmt.Sprintf("* %s", text)
+		stepText = fmt.Sprintf("%s %s", bulletStyle(), text)
 	} else {
-		stepText = fmt.Sprintf("* %s%s\n", text, step.Suffix)
+		stepText = fmt.Sprintf("%s %s%s\n", bulletStyle(), text, step.Suffix)
 	}
 	return stepText
 }
@@ -107,15 +107,22 @@ func FormatStepWithResolvedArgs(step *gauge.Step) string {
 	}
 	stepText := ""
 	if strings.HasSuffix(text, "\n") {
-		stepText = fmt.Sprintf("* %s", text)
+		stepText = fmt.Sprintf("%s %s", bulletStyle(), text)
 	} else {
-		stepText = fmt.Sprintf("* %s%s\n", text, step.Suffix)
+		stepText = fmt.Sprintf("%s %s%s\n", bulletStyle(), text, step.Suffix)
 	}
 	return stepText
 }
 
 func FormatHeading(heading, headingChar string) string {
 	trimmedHeading := strings.TrimSpace(heading)
+	if headingStyle() == headingStyleUnderline {
+		underlineChar := "="
+		if headingChar == "##" {
+			underlineChar = "-"
+		}
+		return fmt.Sprintf("%s\n%s\n", trimmedHeading, getRepeatedChars(underlineChar, len(trimmedHeading)))
+	}
 	return fmt.Sprintf("%s %s\n", headingChar, trimmedHeading)
 }
 
@@ -131,14 +138,14 @@ func FormatTable(table *gauge.Table) string {
 
 	tableStringBuffer.WriteString("\n")
 
-	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
+	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftPadding())))
 	for i, header := range table.Headers {
 		width := columnToWidthMap[i]
 		tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(header, width)))
 	}
 
 	tableStringBuffer.WriteString("\n")
-	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
+	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftPadding())))
 	for i := range table.Headers {
 		width := columnToWidthMap[i]
 		cell := getRepeatedChars("-", width)
@@ -147,7 +154,7 @@ func FormatTable(table *gauge.Table) string {
 
 	tableStringBuffer.WriteString("\n")
 	for _, row := range table.Rows() {
-		tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
+		tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftPadding())))
 		for i, cell := range row {
 			width := columnToWidthMap[i]
 			tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(cell, width)))
@@ -239,7 +246,7 @@ func sortConcepts(conceptDictionary *gauge.ConceptDictionary, conceptMap map[str
 }
 
 func formatConceptSteps(conceptMap map[string]string, concept *gauge.Concept) {
-	conceptMap[concept.FileName] += strings.TrimSpace(strings.Replace(FormatStep(concept.ConceptStep), "*", "#", 1)) + "\n"
+	conceptMap[concept.FileName] += strings.TrimSpace(strings.Replace(FormatStep(concept.ConceptStep), bulletStyle(), "#", 1)) + "\n"
 	for i := 1; i < len(concept.ConceptStep.Items); i++ {
 		conceptMap[concept.FileName] += formatItem(concept.ConceptStep.Items[i])
 	}
@@ -292,3 +299,33 @@ func FormatSpecFilesIn(filesLocation string) {
 		os.Exit(1)
 	}
 }
+
+// CheckSpecFilesIn reports the spec files in filesLocation whose content
+// differs from the formatter's output, without rewriting them. It exits
+// non-zero when any file needs formatting, so CI can enforce formatting.
+func CheckSpecFilesIn(filesLocation string) {
+	specFiles := util.GetSpecFiles([]string{filesLocation})
+	specs, parseResults := parser.ParseSpecFiles(specFiles, &gauge.ConceptDictionary{}, gauge.NewBuildErrors())
+	if parser.HandleParseResult(parseResults...) {
+		os.Exit(1)
+	}
+	var unformatted []string
+	for _, spec := range specs {
+		content, err := common.ReadFileContents(spec.FileName)
+		if err != nil {
+			logger.Fatalf(true, "Failed to read %s. %s", spec.FileName, err.Error())
+		}
+		if content != FormatSpecification(spec) {
+			unformatted = append(unformatted, util.RelPathToProjectRoot(spec.FileName))
+		}
+	}
+	if len(unformatted) == 0 {
+		logger.Infof(true, "All spec files are formatted.")
+		return
+	}
+	for _, file := range unformatted {
+		logger.Errorf(true, "%s is not formatted.", file)
+	}
+	logger.Errorf(true, "%d file(s) are not formatted. Run `gauge format` to format them.", len(unformatted))
+	os.Exit(1)
+}
@@ -7,6 +7,7 @@
 package formatter
 
 import (
+	"os"
 	"testing"
 
 	"github.com/getgauge/gauge/env"
@@ -475,3 +476,33 @@ func (s *MySuite) TestFormatShouldNotAddExtraNewLinesBeforeDataTable(c *C) {
    |Rhythm|0          |
 `)
 }
+
+func (s *MySuite) TestFormatHeadingWithUnderlineStyle(c *C) {
+	os.Setenv(headingStyleProperty, headingStyleUnderline)
+	defer os.Unsetenv(headingStyleProperty)
+
+	c.Assert(FormatHeading("Spec Heading", "#"), Equals, "Spec Heading\n============\n")
+	c.Assert(FormatHeading("Scenario Heading", "##"), Equals, "Scenario Heading\n----------------\n")
+}
+
+func (s *MySuite) TestFormatTableWithConfiguredLeftPadding(c *C) {
+	os.Setenv(tableLeftPaddingProperty, "1")
+	defer os.Unsetenv(tableLeftPaddingProperty)
+	cell1 := gauge.TableCell{Value: "john", CellType: gauge.Static}
+	headers := []string{"name"}
+	cols := [][]gauge.TableCell{{cell1}}
+
+	got := FormatTable(gauge.NewTable(headers, cols, 10))
+
+	c.Assert(got, Equals, "\n |name|\n |----|\n |john|\n")
+}
+
+func (s *MySuite) TestFormatStepWithDashBulletStyle(c *C) {
+	old := env.StepBulletStyle
+	env.StepBulletStyle = func() string { return "-" }
+	defer func() { env.StepBulletStyle = old }()
+
+	step := &gauge.Step{Value: "Say hello", Lookup: gauge.ArgLookup{}}
+
+	c.Assert(FormatStep(step), Equals, "- Say hello\n")
+}
@@ -0,0 +1,45 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/getgauge/gauge/env"
+)
+
+// The formatter's output can be tuned per project through properties:
+// gauge_format_heading_style selects between hash prefixed headings and
+// underlined headings, gauge_format_table_left_padding controls how far
+// tables are indented, and step_bullet_style (shared with the parser)
+// selects the step bullet character.
+const (
+	headingStyleProperty     = "gauge_format_heading_style"
+	tableLeftPaddingProperty = "gauge_format_table_left_padding"
+
+	headingStyleHash      = "hash"
+	headingStyleUnderline = "underline"
+)
+
+func headingStyle() string {
+	if os.Getenv(headingStyleProperty) == headingStyleUnderline {
+		return headingStyleUnderline
+	}
+	return headingStyleHash
+}
+
+func tableLeftPadding() int {
+	if value, err := strconv.Atoi(os.Getenv(tableLeftPaddingProperty)); err == nil && value >= 0 {
+		return value
+	}
+	return tableLeftSpacing
+}
+
+func bulletStyle() string {
+	return env.StepBulletStyle()
+}
@@ -13,6 +13,7 @@ type Table struct {
 	Columns        [][]TableCell
 	Headers        []string
 	LineNo         int
+	rowLines       []int
 }
 
 type DataTable struct {
@@ -100,6 +101,23 @@ func (table *Table) AddRowValues(tableCells []TableCell) {
 	table.addRows(tableCells)
 }
 
+// RecordRowLine notes the spec file line the last added data row came
+// from, so a spec addressed as specs/foo.spec:42 can resolve a table row.
+func (table *Table) RecordRowLine(lineNo int) {
+	table.rowLines = append(table.rowLines, lineNo)
+}
+
+// RowIndexOnLine returns the index of the data row parsed from the given
+// line number, or -1 if no row was recorded on that line.
+func (table *Table) RowIndexOnLine(lineNumber int) int {
+	for i, line := range table.rowLines {
+		if line == lineNumber {
+			return i
+		}
+	}
+	return -1
+}
+
 func (table *Table) CreateTableCells(rowValues []string) []TableCell {
 	tableCells := make([]TableCell, 0)
 	for _, value := range rowValues {
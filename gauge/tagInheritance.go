@@ -0,0 +1,37 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import "strings"
+
+// tagSuppressionPrefix marks a scenario tag that removes an inherited spec
+// tag instead of adding one, e.g. `!slow` on a scenario of a spec tagged
+// `slow`.
+const tagSuppressionPrefix = "!"
+
+// EffectiveTags merges the tags a scenario inherits from its spec with its
+// own. A scenario tag prefixed with `!` suppresses the inherited tag of that
+// name (parameterized or not) and is itself excluded from the result.
+func EffectiveTags(specTags, scenarioTags []string) []string {
+	suppressed := make(map[string]bool)
+	var effective []string
+	for _, tag := range scenarioTags {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, tagSuppressionPrefix) {
+			suppressed[strings.TrimSpace(strings.TrimPrefix(tag, tagSuppressionPrefix))] = true
+			continue
+		}
+		effective = append(effective, tag)
+	}
+	for _, tag := range specTags {
+		if suppressed[ParseTagValue(tag).Name] {
+			continue
+		}
+		effective = append(effective, strings.TrimSpace(tag))
+	}
+	return effective
+}
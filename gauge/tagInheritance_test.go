@@ -0,0 +1,33 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import . "gopkg.in/check.v1"
+
+func (s *MySuite) TestEffectiveTagsMergesSpecAndScenarioTags(c *C) {
+	tags := EffectiveTags([]string{"slow", "smoke"}, []string{"regression"})
+
+	c.Assert(tags, DeepEquals, []string{"regression", "slow", "smoke"})
+}
+
+func (s *MySuite) TestEffectiveTagsSuppressesAnInheritedTag(c *C) {
+	tags := EffectiveTags([]string{"slow", "smoke"}, []string{"!slow", "regression"})
+
+	c.Assert(tags, DeepEquals, []string{"regression", "smoke"})
+}
+
+func (s *MySuite) TestEffectiveTagsSuppressesAParameterizedTagByName(c *C) {
+	tags := EffectiveTags([]string{"priority(1)", "smoke"}, []string{"!priority"})
+
+	c.Assert(tags, DeepEquals, []string{"smoke"})
+}
+
+func (s *MySuite) TestSuppressionOfAnAbsentTagHasNoEffect(c *C) {
+	tags := EffectiveTags([]string{"smoke"}, []string{"!slow"})
+
+	c.Assert(tags, DeepEquals, []string{"smoke"})
+}
@@ -0,0 +1,41 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TagValue is a tag in structured form. A parameterized tag such as
+// `priority(1)` carries name "priority" and value "1"; a plain tag has an
+// empty value.
+type TagValue struct {
+	Name  string
+	Value string
+}
+
+var parameterizedTagRegex = regexp.MustCompile(`^(.+?)\((.*)\)$`)
+
+// ParseTagValue splits a tag into its name and, for parameterized tags, the
+// value it carries.
+func ParseTagValue(tag string) TagValue {
+	tag = strings.TrimSpace(tag)
+	if match := parameterizedTagRegex.FindStringSubmatch(tag); match != nil {
+		return TagValue{Name: strings.TrimSpace(match[1]), Value: strings.TrimSpace(match[2])}
+	}
+	return TagValue{Name: tag}
+}
+
+// TagValues returns the tags in structured form.
+func (tags *Tags) TagValues() []TagValue {
+	var values []TagValue
+	for _, tag := range tags.Values() {
+		values = append(values, ParseTagValue(tag))
+	}
+	return values
+}
@@ -0,0 +1,33 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import . "gopkg.in/check.v1"
+
+func (s *MySuite) TestParseTagValueSplitsParameterizedTags(c *C) {
+	tagValue := ParseTagValue("priority(1)")
+
+	c.Assert(tagValue.Name, Equals, "priority")
+	c.Assert(tagValue.Value, Equals, "1")
+}
+
+func (s *MySuite) TestParseTagValueLeavesPlainTagsWithoutAValue(c *C) {
+	tagValue := ParseTagValue("smoke")
+
+	c.Assert(tagValue.Name, Equals, "smoke")
+	c.Assert(tagValue.Value, Equals, "")
+}
+
+func (s *MySuite) TestTagValuesReturnsStructuredTags(c *C) {
+	tags := &Tags{RawValues: [][]string{{"smoke", "owner( payments-team )"}}}
+
+	tagValues := tags.TagValues()
+
+	c.Assert(len(tagValues), Equals, 2)
+	c.Assert(tagValues[0], Equals, TagValue{Name: "smoke"})
+	c.Assert(tagValues[1], Equals, TagValue{Name: "owner", Value: "payments-team"})
+}
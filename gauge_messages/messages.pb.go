@@ -817,7 +817,11 @@ type ExecutionInfo struct {
 	// / Holds the number of running execution streams.
 	NumberOfExecutionStreams int32 `protobuf:"varint,7,opt,name=numberOfExecutionStreams,proto3" json:"numberOfExecutionStreams,omitempty"`
 	// / Holds the runner id for parallel execution.
-	RunnerId             int32    `protobuf:"varint,8,opt,name=runnerId,proto3" json:"runnerId,omitempty"`
+	RunnerId int32 `protobuf:"varint,8,opt,name=runnerId,proto3" json:"runnerId,omitempty"`
+	// / Holds the stream number executing the current spec, in parallel execution. 0 for serial execution.
+	Stream int32 `protobuf:"varint,9,opt,name=stream,proto3" json:"stream,omitempty"`
+	// / Holds the parallel execution strategy, eager or lazy. Empty for serial execution.
+	ExecutionStrategy    string   `protobuf:"bytes,10,opt,name=executionStrategy,proto3" json:"executionStrategy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -904,6 +908,20 @@ func (m *ExecutionInfo) GetRunnerId() int32 {
 	return 0
 }
 
+func (m *ExecutionInfo) GetStream() int32 {
+	if m != nil {
+		return m.Stream
+	}
+	return 0
+}
+
+func (m *ExecutionInfo) GetExecutionStrategy() string {
+	if m != nil {
+		return m.ExecutionStrategy
+	}
+	return ""
+}
+
 // / Contains details of the Spec execution.
 type SpecInfo struct {
 	// / Name of the current Spec being executed.
@@ -546,7 +546,9 @@ type ProtoScenario struct {
 	// / Screenshots captured on post hook exec time to be available on reports
 	PostHookScreenshotFiles []string `protobuf:"bytes,22,rep,name=postHookScreenshotFiles,proto3" json:"postHookScreenshotFiles,omitempty"`
 	// / Number of retires
-	RetriesCount         int64    `protobuf:"varint,23,opt,name=retriesCount,proto3" json:"retriesCount,omitempty"`
+	RetriesCount int64 `protobuf:"varint,23,opt,name=retriesCount,proto3" json:"retriesCount,omitempty"`
+	// / Paths of artifacts collected for this scenario, relative to the reports directory
+	ArtifactFiles        []string `protobuf:"bytes,24,rep,name=artifactFiles,proto3" json:"artifactFiles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -744,6 +746,13 @@ func (m *ProtoScenario) GetRetriesCount() int64 {
 	return 0
 }
 
+func (m *ProtoScenario) GetArtifactFiles() []string {
+	if m != nil {
+		return m.ArtifactFiles
+	}
+	return nil
+}
+
 // / A proto object representing a Span of content
 type Span struct {
 	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -0,0 +1,249 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*Package health computes a single quality score for a Gauge project by
+  combining lint findings, step implementation coverage and the recorded run
+  history: pass rates, flakiness, skip rates and duration regressions.
+
+  The run history is appended to .gauge/health_history.json at the end of
+  every execution that has save_execution_result enabled. Metrics without
+  data, such as run history in a project that has not been executed yet, are
+  left out of the score and the remaining weights are renormalised.
+*/
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/lint"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/validation"
+)
+
+// JSONOutput prints the health report as JSON instead of plain text.
+var JSONOutput bool
+
+// Metric is one scored aspect of suite quality.
+type Metric struct {
+	Name   string  `json:"name"`
+	Score  float64 `json:"score"`
+	Weight int     `json:"weight"`
+	Detail string  `json:"detail"`
+}
+
+// Report is the overall health score with its per metric breakdown.
+type Report struct {
+	Score   float64  `json:"score"`
+	Metrics []Metric `json:"metrics"`
+}
+
+const (
+	lintWeight        = 15
+	coverageWeight    = 20
+	reliabilityWeight = 20
+	stabilityWeight   = 15
+	skipWeight        = 15
+	durationWeight    = 15
+)
+
+// Run computes and prints the project's health report. It always exits zero;
+// the score is information, not a gate.
+func Run(specDirs []string) int {
+	report := computeReport(specDirs, loadHistory())
+	if JSONOutput {
+		printJSONReport(report)
+	} else {
+		printReport(report)
+	}
+	return 0
+}
+
+func computeReport(specDirs []string, history []runRecord) *Report {
+	var metrics []Metric
+	if m, ok := lintMetric(specDirs); ok {
+		metrics = append(metrics, m)
+	}
+	if m, ok := coverageMetric(specDirs); ok {
+		metrics = append(metrics, m)
+	}
+	if m, ok := reliabilityMetric(history); ok {
+		metrics = append(metrics, m)
+	}
+	if m, ok := stabilityMetric(history); ok {
+		metrics = append(metrics, m)
+	}
+	if m, ok := skipMetric(history); ok {
+		metrics = append(metrics, m)
+	}
+	if m, ok := durationMetric(history); ok {
+		metrics = append(metrics, m)
+	}
+	report := &Report{Metrics: metrics}
+	totalWeight := 0
+	for _, m := range metrics {
+		report.Score += m.Score * float64(m.Weight)
+		totalWeight += m.Weight
+	}
+	if totalWeight > 0 {
+		report.Score = math.Round(report.Score/float64(totalWeight)*10) / 10
+	}
+	return report
+}
+
+// lintMetric scores the project on lint findings: ten points off per error,
+// two per warning.
+func lintMetric(specDirs []string) (Metric, bool) {
+	issues, err := lint.Issues(specDirs)
+	if err != nil {
+		logger.Warningf(true, "Skipping lint metric: %s", err.Error())
+		return Metric{}, false
+	}
+	errors, warnings := 0, 0
+	for _, issue := range issues {
+		if issue.Severity == lint.SeverityError {
+			errors++
+		} else {
+			warnings++
+		}
+	}
+	score := math.Max(0, 100-float64(errors*10)-float64(warnings*2))
+	detail := fmt.Sprintf("%d error(s), %d warning(s)", errors, warnings)
+	return Metric{Name: "lint", Score: score, Weight: lintWeight, Detail: detail}, true
+}
+
+// coverageMetric scores the fraction of unique steps that have an
+// implementation, using the language runner's validation response.
+func coverageMetric(specDirs []string) (Metric, bool) {
+	res := validation.ValidateSpecs(specDirs, false)
+	if res.Runner != nil {
+		if err := res.Runner.Kill(); err != nil {
+			logger.Debugf(true, "Unable to kill runner after validation: %s", err.Error())
+		}
+	}
+	if !res.ParseOk || res.SpecCollection == nil {
+		logger.Warningf(true, "Skipping step coverage metric: specs could not be validated.")
+		return Metric{}, false
+	}
+	uniqueSteps := make(map[string]bool)
+	for _, spec := range res.SpecCollection.Specs() {
+		for _, step := range spec.Contexts {
+			uniqueSteps[step.Value] = true
+		}
+		for _, step := range spec.TearDownSteps {
+			uniqueSteps[step.Value] = true
+		}
+		for _, scenario := range spec.Scenarios {
+			for _, step := range scenario.Steps {
+				uniqueSteps[step.Value] = true
+			}
+		}
+	}
+	unimplemented := make(map[string]bool)
+	for step, err := range res.ErrMap.StepErrs {
+		if vErr, ok := err.(validation.StepValidationError); ok && vErr.ErrorType() == gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
+			unimplemented[step.Value] = true
+		}
+	}
+	if len(uniqueSteps) == 0 {
+		return Metric{}, false
+	}
+	score := float64(len(uniqueSteps)-len(unimplemented)) * 100 / float64(len(uniqueSteps))
+	detail := fmt.Sprintf("%d of %d unique steps implemented", len(uniqueSteps)-len(unimplemented), len(uniqueSteps))
+	return Metric{Name: "step coverage", Score: score, Weight: coverageWeight, Detail: detail}, true
+}
+
+// reliabilityMetric scores the scenario pass rate across the recorded runs.
+func reliabilityMetric(history []runRecord) (Metric, bool) {
+	executed, failed := 0, 0
+	for _, record := range history {
+		executed += record.ScenariosExecuted
+		failed += record.ScenariosFailed
+	}
+	if executed == 0 {
+		return Metric{}, false
+	}
+	score := float64(executed-failed) * 100 / float64(executed)
+	detail := fmt.Sprintf("%d of %d scenarios passed across %d run(s)", executed-failed, executed, len(history))
+	return Metric{Name: "reliability", Score: score, Weight: reliabilityWeight, Detail: detail}, true
+}
+
+// stabilityMetric scores how often the suite flips between passing and
+// failing across consecutive runs. Alternating outcomes indicate flakiness,
+// a consistently failing suite is unreliable but not flaky.
+func stabilityMetric(history []runRecord) (Metric, bool) {
+	if len(history) < 2 {
+		return Metric{}, false
+	}
+	flips := 0
+	for i := 1; i < len(history); i++ {
+		if (history[i].ScenariosFailed == 0) != (history[i-1].ScenariosFailed == 0) {
+			flips++
+		}
+	}
+	score := 100 - float64(flips)*100/float64(len(history)-1)
+	detail := fmt.Sprintf("outcome changed %d time(s) across %d runs", flips, len(history))
+	return Metric{Name: "stability", Score: score, Weight: stabilityWeight, Detail: detail}, true
+}
+
+// skipMetric scores the share of scenarios that actually ran.
+func skipMetric(history []runRecord) (Metric, bool) {
+	executed, skipped := 0, 0
+	for _, record := range history {
+		executed += record.ScenariosExecuted
+		skipped += record.ScenariosSkipped
+	}
+	total := executed + skipped
+	if total == 0 {
+		return Metric{}, false
+	}
+	score := float64(executed) * 100 / float64(total)
+	detail := fmt.Sprintf("%d of %d scenarios skipped", skipped, total)
+	return Metric{Name: "skip rate", Score: score, Weight: skipWeight, Detail: detail}, true
+}
+
+// durationMetric scores duration regressions in the most recent runs, ten
+// points off per regression in the latest five.
+func durationMetric(history []runRecord) (Metric, bool) {
+	if len(history) == 0 {
+		return Metric{}, false
+	}
+	recent := history
+	if len(recent) > 5 {
+		recent = recent[len(recent)-5:]
+	}
+	regressions := 0
+	for _, record := range recent {
+		regressions += record.DurationRegressions
+	}
+	score := math.Max(0, 100-float64(regressions*10))
+	detail := fmt.Sprintf("%d duration regression(s) in the last %d run(s)", regressions, len(recent))
+	return Metric{Name: "duration trend", Score: score, Weight: durationWeight, Detail: detail}, true
+}
+
+func printReport(report *Report) {
+	if len(report.Metrics) == 0 {
+		logger.Infof(true, "No health data available. Run the suite with save_execution_result enabled to record run history.")
+		return
+	}
+	logger.Infof(true, "Project health score: %.1f / 100\n", report.Score)
+	for _, m := range report.Metrics {
+		logger.Infof(true, "%-15s %6.1f (weight %d)\t%s", m.Name, m.Score, m.Weight, m.Detail)
+	}
+}
+
+func printJSONReport(report *Report) {
+	if report.Metrics == nil {
+		report.Metrics = []Metric{}
+	}
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.Fatalf(true, "Unable to marshal health report. %s", err.Error())
+	}
+	fmt.Println(string(out))
+}
@@ -0,0 +1,124 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package health
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+)
+
+func TestReliabilityMetric(t *testing.T) {
+	history := []runRecord{
+		{ScenariosExecuted: 10, ScenariosFailed: 2},
+		{ScenariosExecuted: 10, ScenariosFailed: 0},
+	}
+
+	m, ok := reliabilityMetric(history)
+
+	if !ok {
+		t.Fatal("Expected reliability metric to have data")
+	}
+	if m.Score != 90 {
+		t.Errorf("Expected score 90, got %.1f", m.Score)
+	}
+}
+
+func TestReliabilityMetricWithoutHistory(t *testing.T) {
+	if _, ok := reliabilityMetric(nil); ok {
+		t.Error("Expected reliability metric to report no data")
+	}
+}
+
+func TestStabilityMetricCountsOutcomeFlips(t *testing.T) {
+	history := []runRecord{
+		{ScenariosExecuted: 5, ScenariosFailed: 0},
+		{ScenariosExecuted: 5, ScenariosFailed: 1},
+		{ScenariosExecuted: 5, ScenariosFailed: 0},
+	}
+
+	m, ok := stabilityMetric(history)
+
+	if !ok {
+		t.Fatal("Expected stability metric to have data")
+	}
+	if m.Score != 0 {
+		t.Errorf("Expected score 0 for a suite that flips every run, got %.1f", m.Score)
+	}
+}
+
+func TestStabilityMetricForConsistentFailures(t *testing.T) {
+	history := []runRecord{
+		{ScenariosExecuted: 5, ScenariosFailed: 1},
+		{ScenariosExecuted: 5, ScenariosFailed: 2},
+	}
+
+	m, _ := stabilityMetric(history)
+
+	if m.Score != 100 {
+		t.Errorf("Expected consistent failures not to count as flaky, got score %.1f", m.Score)
+	}
+}
+
+func TestSkipMetric(t *testing.T) {
+	history := []runRecord{{ScenariosExecuted: 8, ScenariosSkipped: 2}}
+
+	m, ok := skipMetric(history)
+
+	if !ok {
+		t.Fatal("Expected skip metric to have data")
+	}
+	if m.Score != 80 {
+		t.Errorf("Expected score 80, got %.1f", m.Score)
+	}
+}
+
+func TestDurationMetricPenalisesRecentRegressions(t *testing.T) {
+	history := []runRecord{{DurationRegressions: 3}}
+
+	m, ok := durationMetric(history)
+
+	if !ok {
+		t.Fatal("Expected duration metric to have data")
+	}
+	if m.Score != 70 {
+		t.Errorf("Expected score 70, got %.1f", m.Score)
+	}
+}
+
+func TestRecordAppendsToHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	if err := os.MkdirAll(filepath.Join(dir, common.DotGauge), common.NewDirectoryPermissions); err != nil {
+		t.Fatal(err)
+	}
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		{ScenarioCount: 5, ScenarioFailedCount: 1, ScenarioSkippedCount: 2},
+	}}
+
+	Record(res)
+	Record(res)
+
+	history := loadHistory()
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 history entries, got %d", len(history))
+	}
+	if history[0].ScenariosExecuted != 3 || history[0].ScenariosFailed != 1 || history[0].ScenariosSkipped != 2 {
+		t.Errorf("Unexpected record: %+v", history[0])
+	}
+}
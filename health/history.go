@@ -0,0 +1,78 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package health
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	healthHistoryFile = "health_history.json"
+	// maxHistoryEntries caps the run history; older runs stop being
+	// representative of the suite's current quality.
+	maxHistoryEntries = 30
+)
+
+// runRecord is one run's worth of health data, persisted in
+// .gauge/health_history.json at the end of every execution.
+type runRecord struct {
+	Timestamp           time.Time `json:"timestamp"`
+	ScenariosExecuted   int       `json:"scenariosExecuted"`
+	ScenariosFailed     int       `json:"scenariosFailed"`
+	ScenariosSkipped    int       `json:"scenariosSkipped"`
+	DurationRegressions int       `json:"durationRegressions"`
+}
+
+// Record appends the given suite result to the run history the health
+// command reads. Failures to persist are logged and otherwise ignored, an
+// execution should never fail because its health snapshot could not be saved.
+func Record(res *result.SuiteResult) {
+	record := runRecord{Timestamp: time.Now()}
+	for _, specRes := range res.SpecResults {
+		record.ScenariosExecuted += specRes.ScenarioCount - specRes.ScenarioSkippedCount
+		record.ScenariosFailed += specRes.ScenarioFailedCount
+		record.ScenariosSkipped += specRes.ScenarioSkippedCount
+	}
+	record.DurationRegressions = len(res.DurationRegressions)
+	history := append(loadHistory(), record)
+	if len(history) > maxHistoryEntries {
+		history = history[len(history)-maxHistoryEntries:]
+	}
+	contents, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		logger.Debugf(true, "Unable to marshal health history: %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(historyFile(), contents, common.NewFilePermissions); err != nil {
+		logger.Debugf(true, "Unable to save health history: %s", err.Error())
+	}
+}
+
+func historyFile() string {
+	return filepath.Join(config.ProjectRoot, common.DotGauge, healthHistoryFile)
+}
+
+func loadHistory() []runRecord {
+	contents, err := ioutil.ReadFile(historyFile())
+	if err != nil {
+		return nil
+	}
+	var history []runRecord
+	if err = json.Unmarshal(contents, &history); err != nil {
+		logger.Debugf(true, "Unable to read health history: %s", err.Error())
+		return nil
+	}
+	return history
+}
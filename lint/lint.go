@@ -0,0 +1,195 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*Package lint checks specs and concepts for style issues that the parser
+  accepts but that make suites harder to maintain, such as duplicate scenario
+  headings, overly long scenarios and unused concepts.
+
+  Every rule has a severity, which can be changed per project through
+  properties. A rule named duplicate-scenario-heading is configured by the
+  property gauge_lint_duplicate_scenario_heading, whose value is one of
+  error, warning or off. Issues of severity error fail the lint run.
+*/
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// Severity tells how an issue reported by a rule is treated.
+type Severity string
+
+const (
+	// SeverityError makes issues of the rule fail the lint run.
+	SeverityError Severity = "error"
+	// SeverityWarning reports issues of the rule without failing the run.
+	SeverityWarning Severity = "warning"
+	// SeverityOff disables the rule.
+	SeverityOff Severity = "off"
+
+	lintPropertyPrefix = "gauge_lint_"
+)
+
+// JSONOutput prints lint issues as a JSON array instead of plain text.
+var JSONOutput bool
+
+// Issue is a single style problem found in a spec or concept file.
+type Issue struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+}
+
+// lintContext carries everything the rules inspect: the parsed specs and
+// concepts, plus the raw contents of every spec and concept file for the
+// text level rules.
+type lintContext struct {
+	specs      []*gauge.Specification
+	dictionary *gauge.ConceptDictionary
+	files      map[string][]string
+}
+
+type rule struct {
+	id              string
+	defaultSeverity Severity
+	apply           func(ctx *lintContext, severity Severity) []Issue
+}
+
+var rules = []rule{
+	{id: "duplicate-scenario-heading", defaultSeverity: SeverityError, apply: checkDuplicateScenarioHeadings},
+	{id: "long-scenario", defaultSeverity: SeverityWarning, apply: checkLongScenarios},
+	{id: "unused-concept", defaultSeverity: SeverityWarning, apply: checkUnusedConcepts},
+	{id: "inconsistent-table-columns", defaultSeverity: SeverityError, apply: checkInconsistentTableColumns},
+	{id: "trailing-whitespace", defaultSeverity: SeverityWarning, apply: checkTrailingWhitespace},
+	{id: "required-tags", defaultSeverity: SeverityError, apply: checkRequiredTags},
+}
+
+// Lint parses the specs and concepts in the given directories, runs every
+// enabled rule and reports the issues found. It returns a non-zero exit code
+// when any issue of severity error is reported.
+func Lint(specDirs []string) int {
+	issues, err := Issues(specDirs)
+	if err != nil {
+		logger.Fatalf(true, err.Error())
+	}
+	if JSONOutput {
+		printJSONIssues(issues)
+	} else {
+		printIssues(issues)
+	}
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Issues parses the specs and concepts in the given directories and returns
+// the issues reported by every enabled rule, sorted by file and line.
+func Issues(specDirs []string) ([]Issue, error) {
+	ctx, err := newLintContext(specDirs)
+	if err != nil {
+		return nil, err
+	}
+	return run(ctx), nil
+}
+
+func newLintContext(specDirs []string) (*lintContext, error) {
+	dictionary, _, err := parser.ParseConcepts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse concepts. %s", err.Error())
+	}
+	specFiles := util.GetSpecFiles(specDirs)
+	specs, _ := parser.ParseSpecFiles(specFiles, dictionary, gauge.NewBuildErrors())
+	files := make(map[string][]string)
+	for _, file := range append(specFiles, util.GetConceptFiles()...) {
+		content, err := common.ReadFileContents(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s. %s", file, err.Error())
+		}
+		files[file] = splitLines(content)
+	}
+	return &lintContext{specs: specs, dictionary: dictionary, files: files}, nil
+}
+
+func run(ctx *lintContext) []Issue {
+	var issues []Issue
+	for _, r := range rules {
+		severity := severityFor(r.id, r.defaultSeverity)
+		if severity == SeverityOff {
+			continue
+		}
+		issues = append(issues, r.apply(ctx, severity)...)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Rule < issues[j].Rule
+	})
+	return issues
+}
+
+// severityFor looks up the configured severity of a rule, falling back to
+// the rule's default when the property is absent or not a known severity.
+func severityFor(ruleID string, defaultSeverity Severity) Severity {
+	value := Severity(os.Getenv(propertyNameFor(ruleID)))
+	switch value {
+	case SeverityError, SeverityWarning, SeverityOff:
+		return value
+	}
+	return defaultSeverity
+}
+
+func propertyNameFor(ruleID string) string {
+	return lintPropertyPrefix + strings.Replace(ruleID, "-", "_", -1)
+}
+
+func printIssues(issues []Issue) {
+	if len(issues) == 0 {
+		logger.Infof(true, "No lint issues found.")
+		return
+	}
+	for _, issue := range issues {
+		logger.Infof(true, "%s:%d: [%s] %s: %s", issue.File, issue.Line, issue.Severity, issue.Rule, issue.Message)
+	}
+	logger.Infof(true, "\n%d lint issue(s) found.", len(issues))
+}
+
+func printJSONIssues(issues []Issue) {
+	if issues == nil {
+		issues = []Issue{}
+	}
+	out, err := json.MarshalIndent(issues, "", "  ")
+	if err != nil {
+		logger.Fatalf(true, "Unable to marshal lint issues. %s", err.Error())
+	}
+	fmt.Println(string(out))
+}
+
+func splitLines(content string) []string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
@@ -0,0 +1,162 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lint
+
+import (
+	"os"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func newScenario(heading string, lineNo int, stepCount int, tags ...string) *gauge.Scenario {
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: heading, LineNo: lineNo}}
+	for i := 0; i < stepCount; i++ {
+		scenario.Steps = append(scenario.Steps, &gauge.Step{Value: "a step"})
+	}
+	if len(tags) > 0 {
+		scenario.Tags = &gauge.Tags{RawValues: [][]string{tags}}
+	}
+	return scenario
+}
+
+func TestCheckDuplicateScenarioHeadings(t *testing.T) {
+	spec := &gauge.Specification{
+		FileName: "example.spec",
+		Scenarios: []*gauge.Scenario{
+			newScenario("Login", 5, 1),
+			newScenario("login", 12, 1),
+			newScenario("Logout", 20, 1),
+		},
+	}
+	issues := checkDuplicateScenarioHeadings(&lintContext{specs: []*gauge.Specification{spec}}, SeverityError)
+
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Line != 12 || issues[0].File != "example.spec" {
+		t.Errorf("Expected issue at example.spec:12, got %s:%d", issues[0].File, issues[0].Line)
+	}
+}
+
+func TestCheckLongScenariosHonoursConfiguredLimit(t *testing.T) {
+	os.Setenv(maxScenarioStepsProperty, "2")
+	defer os.Unsetenv(maxScenarioStepsProperty)
+	spec := &gauge.Specification{
+		FileName:  "example.spec",
+		Scenarios: []*gauge.Scenario{newScenario("Short", 5, 2), newScenario("Long", 10, 3)},
+	}
+
+	issues := checkLongScenarios(&lintContext{specs: []*gauge.Specification{spec}}, SeverityWarning)
+
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Line != 10 {
+		t.Errorf("Expected issue at line 10, got line %d", issues[0].Line)
+	}
+}
+
+func TestCheckUnusedConcepts(t *testing.T) {
+	dictionary := gauge.NewConceptDictionary()
+	dictionary.ConceptsMap["used concept"] = &gauge.Concept{
+		FileName:    "concepts/used.cpt",
+		ConceptStep: &gauge.Step{Value: "used concept", LineText: "used concept", LineNo: 1},
+	}
+	dictionary.ConceptsMap["unused concept"] = &gauge.Concept{
+		FileName:    "concepts/unused.cpt",
+		ConceptStep: &gauge.Step{Value: "unused concept", LineText: "unused concept", LineNo: 1},
+	}
+	scenario := newScenario("Login", 5, 0)
+	scenario.Steps = []*gauge.Step{{Value: "used concept", IsConcept: true}}
+	spec := &gauge.Specification{FileName: "example.spec", Scenarios: []*gauge.Scenario{scenario}}
+
+	issues := checkUnusedConcepts(&lintContext{specs: []*gauge.Specification{spec}, dictionary: dictionary}, SeverityWarning)
+
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].File != "concepts/unused.cpt" {
+		t.Errorf("Expected issue in concepts/unused.cpt, got %s", issues[0].File)
+	}
+}
+
+func TestCheckInconsistentTableColumns(t *testing.T) {
+	ctx := &lintContext{files: map[string][]string{
+		"example.spec": {
+			"# Spec",
+			"|name|age|",
+			"|----|---|",
+			"|john|30|",
+			"|jane|30|extra|",
+		},
+	}}
+
+	issues := checkInconsistentTableColumns(ctx, SeverityError)
+
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Line != 5 {
+		t.Errorf("Expected issue at line 5, got line %d", issues[0].Line)
+	}
+}
+
+func TestCheckTrailingWhitespace(t *testing.T) {
+	ctx := &lintContext{files: map[string][]string{
+		"example.spec": {"# Spec", "* a step ", "* another step"},
+	}}
+
+	issues := checkTrailingWhitespace(ctx, SeverityWarning)
+
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Line != 2 {
+		t.Errorf("Expected issue at line 2, got line %d", issues[0].Line)
+	}
+}
+
+func TestCheckRequiredTags(t *testing.T) {
+	os.Setenv(requiredTagsProperty, "smoke")
+	defer os.Unsetenv(requiredTagsProperty)
+	spec := &gauge.Specification{
+		FileName:  "example.spec",
+		Scenarios: []*gauge.Scenario{newScenario("Tagged", 5, 1, "smoke"), newScenario("Untagged", 10, 1)},
+	}
+
+	issues := checkRequiredTags(&lintContext{specs: []*gauge.Specification{spec}}, SeverityError)
+
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Line != 10 {
+		t.Errorf("Expected issue at line 10, got line %d", issues[0].Line)
+	}
+}
+
+func TestCheckRequiredTagsIsSilentWhenUnconfigured(t *testing.T) {
+	spec := &gauge.Specification{FileName: "example.spec", Scenarios: []*gauge.Scenario{newScenario("Untagged", 5, 1)}}
+
+	if issues := checkRequiredTags(&lintContext{specs: []*gauge.Specification{spec}}, SeverityError); len(issues) != 0 {
+		t.Errorf("Expected no issues, got %d", len(issues))
+	}
+}
+
+func TestSeverityForFallsBackToDefault(t *testing.T) {
+	os.Setenv(propertyNameFor("long-scenario"), "loud")
+	defer os.Unsetenv(propertyNameFor("long-scenario"))
+
+	if s := severityFor("long-scenario", SeverityWarning); s != SeverityWarning {
+		t.Errorf("Expected default severity warning, got %s", s)
+	}
+
+	os.Setenv(propertyNameFor("long-scenario"), "off")
+	if s := severityFor("long-scenario", SeverityWarning); s != SeverityOff {
+		t.Errorf("Expected severity off, got %s", s)
+	}
+}
@@ -0,0 +1,220 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lint
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+const (
+	maxScenarioStepsProperty = "gauge_lint_max_scenario_steps"
+	requiredTagsProperty     = "gauge_lint_required_tags"
+	defaultMaxScenarioSteps  = 15
+)
+
+// checkDuplicateScenarioHeadings reports scenarios within a spec that share
+// a heading, which makes rerun and scenario filtering ambiguous.
+func checkDuplicateScenarioHeadings(ctx *lintContext, severity Severity) []Issue {
+	var issues []Issue
+	for _, spec := range ctx.specs {
+		seen := make(map[string]int)
+		for _, scenario := range spec.Scenarios {
+			heading := strings.ToLower(scenario.Heading.Value)
+			if firstLine, ok := seen[heading]; ok {
+				issues = append(issues, Issue{
+					Rule:     "duplicate-scenario-heading",
+					Severity: severity,
+					Message:  fmt.Sprintf("Scenario heading %q is already used on line %d", scenario.Heading.Value, firstLine),
+					File:     spec.FileName,
+					Line:     scenario.Heading.LineNo,
+				})
+				continue
+			}
+			seen[heading] = scenario.Heading.LineNo
+		}
+	}
+	return issues
+}
+
+// checkLongScenarios reports scenarios with more steps than the limit set by
+// the gauge_lint_max_scenario_steps property.
+func checkLongScenarios(ctx *lintContext, severity Severity) []Issue {
+	maxSteps := defaultMaxScenarioSteps
+	if value, err := strconv.Atoi(os.Getenv(maxScenarioStepsProperty)); err == nil && value > 0 {
+		maxSteps = value
+	}
+	var issues []Issue
+	for _, spec := range ctx.specs {
+		for _, scenario := range spec.Scenarios {
+			if len(scenario.Steps) > maxSteps {
+				issues = append(issues, Issue{
+					Rule:     "long-scenario",
+					Severity: severity,
+					Message:  fmt.Sprintf("Scenario %q has %d steps, more than the limit of %d. Consider extracting concepts.", scenario.Heading.Value, len(scenario.Steps), maxSteps),
+					File:     spec.FileName,
+					Line:     scenario.Heading.LineNo,
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// checkUnusedConcepts reports concepts that no spec and no other concept
+// refers to.
+func checkUnusedConcepts(ctx *lintContext, severity Severity) []Issue {
+	used := make(map[string]bool)
+	markUsed := func(steps []*gauge.Step) {
+		for _, step := range steps {
+			if step.IsConcept {
+				used[step.Value] = true
+			}
+		}
+	}
+	for _, spec := range ctx.specs {
+		markUsed(spec.Contexts)
+		markUsed(spec.TearDownSteps)
+		for _, scenario := range spec.Scenarios {
+			markUsed(scenario.Steps)
+		}
+	}
+	for _, concept := range ctx.dictionary.ConceptsMap {
+		markUsed(concept.ConceptStep.ConceptSteps)
+	}
+	var issues []Issue
+	for value, concept := range ctx.dictionary.ConceptsMap {
+		if !used[value] {
+			issues = append(issues, Issue{
+				Rule:     "unused-concept",
+				Severity: severity,
+				Message:  fmt.Sprintf("Concept %q is not used by any spec or concept", concept.ConceptStep.LineText),
+				File:     concept.FileName,
+				Line:     concept.ConceptStep.LineNo,
+			})
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Message < issues[j].Message })
+	return issues
+}
+
+// checkInconsistentTableColumns reports table rows whose cell count differs
+// from the header row of the table they belong to. It works on the raw file
+// text so that concept files are covered as well.
+func checkInconsistentTableColumns(ctx *lintContext, severity Severity) []Issue {
+	var issues []Issue
+	for _, file := range sortedFileNames(ctx) {
+		headerColumns := 0
+		for i, line := range ctx.files[file] {
+			trimmed := strings.TrimSpace(line)
+			if !strings.HasPrefix(trimmed, "|") || !strings.HasSuffix(trimmed, "|") {
+				headerColumns = 0
+				continue
+			}
+			columns := len(strings.Split(trimmed, "|")) - 2
+			if headerColumns == 0 {
+				headerColumns = columns
+				continue
+			}
+			if columns != headerColumns {
+				issues = append(issues, Issue{
+					Rule:     "inconsistent-table-columns",
+					Severity: severity,
+					Message:  fmt.Sprintf("Table row has %d columns, the table header has %d", columns, headerColumns),
+					File:     file,
+					Line:     i + 1,
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// checkTrailingWhitespace reports lines ending in spaces or tabs.
+func checkTrailingWhitespace(ctx *lintContext, severity Severity) []Issue {
+	var issues []Issue
+	for _, file := range sortedFileNames(ctx) {
+		for i, line := range ctx.files[file] {
+			if line != strings.TrimRight(line, " \t") {
+				issues = append(issues, Issue{
+					Rule:     "trailing-whitespace",
+					Severity: severity,
+					Message:  "Line has trailing whitespace",
+					File:     file,
+					Line:     i + 1,
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// checkRequiredTags reports scenarios that carry none of the tags listed in
+// the gauge_lint_required_tags property, either directly or through their
+// spec. The rule reports nothing when the property is empty.
+func checkRequiredTags(ctx *lintContext, severity Severity) []Issue {
+	required := splitTags(os.Getenv(requiredTagsProperty))
+	if len(required) == 0 {
+		return nil
+	}
+	var issues []Issue
+	for _, spec := range ctx.specs {
+		for _, scenario := range spec.Scenarios {
+			tags := make(map[string]bool)
+			if spec.Tags != nil {
+				for _, tag := range spec.Tags.Values() {
+					tags[tag] = true
+				}
+			}
+			if scenario.Tags != nil {
+				for _, tag := range scenario.Tags.Values() {
+					tags[tag] = true
+				}
+			}
+			var missing []string
+			for _, tag := range required {
+				if !tags[tag] {
+					missing = append(missing, tag)
+				}
+			}
+			if len(missing) > 0 {
+				issues = append(issues, Issue{
+					Rule:     "required-tags",
+					Severity: severity,
+					Message:  fmt.Sprintf("Scenario %q is missing required tag(s): %s", scenario.Heading.Value, strings.Join(missing, ", ")),
+					File:     spec.FileName,
+					Line:     scenario.Heading.LineNo,
+				})
+			}
+		}
+	}
+	return issues
+}
+
+func splitTags(value string) []string {
+	var tags []string
+	for _, tag := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(tag); trimmed != "" {
+			tags = append(tags, trimmed)
+		}
+	}
+	return tags
+}
+
+func sortedFileNames(ctx *lintContext) []string {
+	files := make([]string, 0, len(ctx.files))
+	for file := range ctx.files {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
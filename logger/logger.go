@@ -104,6 +104,7 @@ func Initialize(mr bool, logLevel string, c int) {
 }
 
 func logInfo(logger *logging.Logger, stdout bool, msg string) {
+	msg = maskSecrets(msg)
 	if level >= logging.INFO {
 		write(stdout, msg, os.Stdout)
 	}
@@ -114,6 +115,7 @@ func logInfo(logger *logging.Logger, stdout bool, msg string) {
 }
 
 func logError(logger *logging.Logger, stdout bool, msg string) {
+	msg = maskSecrets(msg)
 	if level >= logging.ERROR {
 		write(stdout, msg, os.Stdout)
 	}
@@ -125,6 +127,7 @@ func logError(logger *logging.Logger, stdout bool, msg string) {
 }
 
 func logWarning(logger *logging.Logger, stdout bool, msg string) {
+	msg = maskSecrets(msg)
 	if level >= logging.WARNING {
 		write(stdout, msg, os.Stdout)
 	}
@@ -135,6 +138,7 @@ func logWarning(logger *logging.Logger, stdout bool, msg string) {
 }
 
 func logDebug(logger *logging.Logger, stdout bool, msg string) {
+	msg = maskSecrets(msg)
 	if level >= logging.DEBUG {
 		write(stdout, msg, os.Stdout)
 	}
@@ -145,6 +149,7 @@ func logDebug(logger *logging.Logger, stdout bool, msg string) {
 }
 
 func logCritical(logger *logging.Logger, msg string) {
+	msg = maskSecrets(msg)
 	if !initialized {
 		fmt.Fprint(os.Stderr, msg)
 		return
@@ -0,0 +1,43 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package logger
+
+import (
+	"strings"
+	"sync"
+)
+
+const maskedValue = "****"
+
+var maskedSecretsMutex sync.RWMutex
+var maskedSecrets []string
+
+// MaskSecret registers a secret value so that any log message containing it
+// has the value replaced with **** before being written. Resolved secret
+// store values are registered here when the environment is loaded.
+func MaskSecret(value string) {
+	if value == "" {
+		return
+	}
+	maskedSecretsMutex.Lock()
+	defer maskedSecretsMutex.Unlock()
+	for _, secret := range maskedSecrets {
+		if secret == value {
+			return
+		}
+	}
+	maskedSecrets = append(maskedSecrets, value)
+}
+
+func maskSecrets(msg string) string {
+	maskedSecretsMutex.RLock()
+	defer maskedSecretsMutex.RUnlock()
+	for _, secret := range maskedSecrets {
+		msg = strings.Replace(msg, secret, maskedValue, -1)
+	}
+	return msg
+}
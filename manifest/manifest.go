@@ -21,10 +21,37 @@ import (
 type Manifest struct {
 	Language string
 	Plugins  []string
+	// ConnectTo holds an optional `host:port` address of an already running
+	// runner on another machine or container. When set, gauge connects to it
+	// instead of launching a runner process locally.
+	ConnectTo string `json:",omitempty"`
+	// PluginConstraints holds optional version ranges per plugin, e.g.
+	// `"html-report": ">=4.0 <5"`. Plugins without an entry run at the
+	// latest installed version, as before.
+	PluginConstraints map[string]string `json:",omitempty"`
+	// ConceptLibraries lists concept dependencies shared across projects.
+	// An entry is either a directory of .cpt files (absolute or relative to
+	// the project root) or the name of a library installed under
+	// <gauge home>/concepts, optionally pinned as `name@version`. Their
+	// concepts are linked in at parse time.
+	ConceptLibraries []string `json:",omitempty"`
 }
 
+// ConstraintFor returns the version constraint declared for the given plugin,
+// or an empty string if the manifest does not constrain it.
+func (m *Manifest) ConstraintFor(pluginID string) string {
+	return m.PluginConstraints[pluginID]
+}
+
+// ProjectManifest reads the manifest of the project config.ProjectRoot
+// points at.
 func ProjectManifest() (*Manifest, error) {
-	contents, err := common.ReadFileContents(filepath.Join(config.ProjectRoot, common.ManifestFile))
+	return ManifestAt(config.ProjectRoot)
+}
+
+// ManifestAt reads the manifest of the project at the given root.
+func ManifestAt(root string) (*Manifest, error) {
+	contents, err := common.ReadFileContents(filepath.Join(root, common.ManifestFile))
 	if err != nil {
 		return nil, err
 	}
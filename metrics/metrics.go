@@ -0,0 +1,147 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package metrics keeps counters and histograms about a long-lived gauge
+// process and serves them in the Prometheus text exposition format. The
+// format is written by hand so gauge does not pick up a client library
+// dependency for a handful of metrics.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// requestDurationBuckets are the histogram bucket upper bounds, in
+// seconds, for API request latencies.
+var requestDurationBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5}
+
+var registry = struct {
+	sync.Mutex
+	scenarios      map[string]int64
+	runnerRestarts int64
+	apiRequests    map[string]int64
+	activeStreams  int64
+
+	requestDurationCounts []int64
+	requestDurationSum    float64
+	requestDurationTotal  int64
+}{
+	scenarios:             map[string]int64{},
+	apiRequests:           map[string]int64{},
+	requestDurationCounts: make([]int64, len(requestDurationBuckets)),
+}
+
+// RecordScenario counts one executed scenario with the given status
+// ("passed", "failed" or "skipped").
+func RecordScenario(status string) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.scenarios[status]++
+}
+
+// RecordRunnerRestart counts one runner restarted after a crash.
+func RecordRunnerRestart() {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.runnerRestarts++
+}
+
+// RecordAPIRequest counts one handled API request of the given type and
+// adds its duration to the latency histogram.
+func RecordAPIRequest(requestType string, duration time.Duration) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.apiRequests[requestType]++
+	seconds := duration.Seconds()
+	for i, bound := range requestDurationBuckets {
+		if seconds <= bound {
+			registry.requestDurationCounts[i]++
+		}
+	}
+	registry.requestDurationSum += seconds
+	registry.requestDurationTotal++
+}
+
+// StreamStarted marks one execution stream as active.
+func StreamStarted() {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.activeStreams++
+}
+
+// StreamEnded marks one execution stream as done.
+func StreamEnded() {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.activeStreams--
+}
+
+// Handler serves the collected metrics in the Prometheus text exposition
+// format.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprint(w, expose())
+	})
+}
+
+// StartServer serves the /metrics endpoint on the given port. It blocks,
+// so run it on its own goroutine; a serve failure is logged, not fatal.
+func StartServer(port int) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	logger.Infof(true, "Serving metrics on port: %d", port)
+	if err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux); err != nil {
+		logger.Errorf(true, "Unable to serve metrics on port %d. %s", port, err.Error())
+	}
+}
+
+func expose() string {
+	registry.Lock()
+	defer registry.Unlock()
+	var b strings.Builder
+	writeHeader(&b, "gauge_scenarios_executed_total", "counter", "Scenarios executed, by status.")
+	for _, status := range sortedKeys(registry.scenarios) {
+		fmt.Fprintf(&b, "gauge_scenarios_executed_total{status=%q} %d\n", status, registry.scenarios[status])
+	}
+	writeHeader(&b, "gauge_runner_restarts_total", "counter", "Runners restarted after a crash.")
+	fmt.Fprintf(&b, "gauge_runner_restarts_total %d\n", registry.runnerRestarts)
+	writeHeader(&b, "gauge_api_requests_total", "counter", "API requests handled, by request type.")
+	for _, requestType := range sortedKeys(registry.apiRequests) {
+		fmt.Fprintf(&b, "gauge_api_requests_total{type=%q} %d\n", requestType, registry.apiRequests[requestType])
+	}
+	writeHeader(&b, "gauge_api_request_duration_seconds", "histogram", "API request latency.")
+	for i, bound := range requestDurationBuckets {
+		fmt.Fprintf(&b, "gauge_api_request_duration_seconds_bucket{le=%q} %d\n", fmt.Sprintf("%g", bound), registry.requestDurationCounts[i])
+	}
+	fmt.Fprintf(&b, "gauge_api_request_duration_seconds_bucket{le=\"+Inf\"} %d\n", registry.requestDurationTotal)
+	fmt.Fprintf(&b, "gauge_api_request_duration_seconds_sum %g\n", registry.requestDurationSum)
+	fmt.Fprintf(&b, "gauge_api_request_duration_seconds_count %d\n", registry.requestDurationTotal)
+	writeHeader(&b, "gauge_active_streams", "gauge", "Execution streams currently running.")
+	fmt.Fprintf(&b, "gauge_active_streams %d\n", registry.activeStreams)
+	return b.String()
+}
+
+func writeHeader(b *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s %s\n", name, kind)
+}
+
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
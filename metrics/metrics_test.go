@@ -0,0 +1,55 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func scrape(t *testing.T) string {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/plain") {
+		t.Fatalf("want text/plain content type, got %q", contentType)
+	}
+	return recorder.Body.String()
+}
+
+func assertContains(t *testing.T, body, line string) {
+	t.Helper()
+	if !strings.Contains(body, line+"\n") {
+		t.Errorf("want metrics output to contain %q, got:\n%s", line, body)
+	}
+}
+
+func TestMetricsExposition(t *testing.T) {
+	RecordScenario("passed")
+	RecordScenario("passed")
+	RecordScenario("failed")
+	RecordRunnerRestart()
+	RecordAPIRequest("SpecsRequest", 2*time.Millisecond)
+	StreamStarted()
+	StreamStarted()
+	StreamEnded()
+
+	body := scrape(t)
+
+	assertContains(t, body, `gauge_scenarios_executed_total{status="passed"} 2`)
+	assertContains(t, body, `gauge_scenarios_executed_total{status="failed"} 1`)
+	assertContains(t, body, `gauge_runner_restarts_total 1`)
+	assertContains(t, body, `gauge_api_requests_total{type="SpecsRequest"} 1`)
+	assertContains(t, body, `gauge_api_request_duration_seconds_bucket{le="0.005"} 1`)
+	assertContains(t, body, `gauge_api_request_duration_seconds_bucket{le="0.001"} 0`)
+	assertContains(t, body, `gauge_api_request_duration_seconds_bucket{le="+Inf"} 1`)
+	assertContains(t, body, `gauge_api_request_duration_seconds_count 1`)
+	assertContains(t, body, `gauge_active_streams 1`)
+	assertContains(t, body, "# TYPE gauge_api_request_duration_seconds histogram")
+}
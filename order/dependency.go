@@ -0,0 +1,166 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package order
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+// Specs can declare prerequisites with a `@depends_on: <spec heading>` comment.
+// The scheduler then orders specs so a dependent spec never runs before its
+// prerequisite, and parallel distribution keeps a spec on the same stream as
+// its dependents.
+var dependsOnRegex = regexp.MustCompile(`^\s*@depends_on:\s*(.+?)\s*$`)
+
+// Dependencies gives the spec headings a spec declared as prerequisites.
+func Dependencies(spec *gauge.Specification) []string {
+	var deps []string
+	for _, comment := range spec.Comments {
+		if match := dependsOnRegex.FindStringSubmatch(comment.Value); match != nil {
+			deps = append(deps, match[1])
+		}
+	}
+	return deps
+}
+
+// SortByDependencies topologically orders specs so every spec comes after the
+// specs it depends on, keeping the existing order otherwise. Prerequisites that
+// are not part of the selected specs are ignored since they may have been
+// filtered out deliberately. A dependency cycle is reported as an error.
+func SortByDependencies(specs []*gauge.Specification) ([]*gauge.Specification, error) {
+	groups, err := dependencyGroups(specs)
+	if err != nil {
+		return nil, err
+	}
+	sorted := make([]*gauge.Specification, 0, len(specs))
+	for _, group := range groups {
+		sorted = append(sorted, group...)
+	}
+	return sorted, nil
+}
+
+// DependencyGroups splits specs into groups that must stay on the same
+// execution stream: each group holds a spec and everything related to it via
+// @depends_on declarations, in topological order. Specs without dependencies
+// end up in groups of one.
+func DependencyGroups(specs []*gauge.Specification) [][]*gauge.Specification {
+	groups, err := dependencyGroups(specs)
+	if err != nil {
+		// cycles are caught at validation time; fall back to singleton groups
+		groups = make([][]*gauge.Specification, 0, len(specs))
+		for _, spec := range specs {
+			groups = append(groups, []*gauge.Specification{spec})
+		}
+	}
+	return groups
+}
+
+func dependencyGroups(specs []*gauge.Specification) ([][]*gauge.Specification, error) {
+	byHeading := make(map[string]int)
+	for i, spec := range specs {
+		if spec.Heading != nil {
+			byHeading[spec.Heading.Value] = i
+		}
+	}
+	dependsOn := make([][]int, len(specs))
+	for i, spec := range specs {
+		for _, dep := range Dependencies(spec) {
+			if j, ok := byHeading[dep]; ok && i != j {
+				dependsOn[i] = append(dependsOn[i], j)
+			}
+		}
+	}
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(specs))
+	componentOf := make([]int, len(specs))
+	components := make([][]*gauge.Specification, 0)
+	var visit func(i, component int) error
+	visit = func(i, component int) error {
+		switch state[i] {
+		case visiting:
+			return fmt.Errorf("Circular dependency detected for specification '%s'", heading(specs[i]))
+		case visited:
+			return nil
+		}
+		state[i] = visiting
+		for _, j := range dependsOn[i] {
+			if err := visit(j, component); err != nil {
+				return err
+			}
+		}
+		state[i] = visited
+		componentOf[i] = component
+		components[component] = append(components[component], specs[i])
+		return nil
+	}
+	// union dependency-related specs into one component so that they share a stream
+	component := make(map[int]int)
+	roots := unionComponents(dependsOn)
+	for i := range specs {
+		root := roots[i]
+		if _, ok := component[root]; !ok {
+			component[root] = len(components)
+			components = append(components, nil)
+		}
+	}
+	for i := range specs {
+		if err := visit(i, component[roots[i]]); err != nil {
+			return nil, err
+		}
+	}
+	result := make([][]*gauge.Specification, 0, len(components))
+	for _, c := range components {
+		if len(c) > 0 {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+// unionComponents gives for each spec index the representative index of the
+// connected component it belongs to, treating dependencies as undirected edges.
+func unionComponents(dependsOn [][]int) []int {
+	parent := make([]int, len(dependsOn))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i, deps := range dependsOn {
+		for _, j := range deps {
+			ri, rj := find(i), find(j)
+			if ri != rj {
+				parent[rj] = ri
+			}
+		}
+	}
+	roots := make([]int, len(dependsOn))
+	for i := range roots {
+		roots[i] = find(i)
+	}
+	return roots
+}
+
+func heading(spec *gauge.Specification) string {
+	if spec.Heading != nil {
+		return spec.Heading.Value
+	}
+	return strings.TrimSpace(spec.FileName)
+}
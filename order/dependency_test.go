@@ -0,0 +1,103 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+package order
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func newSpecWithDeps(heading string, deps ...string) *gauge.Specification {
+	spec := &gauge.Specification{Heading: &gauge.Heading{Value: heading}, FileName: heading}
+	for _, dep := range deps {
+		spec.Comments = append(spec.Comments, &gauge.Comment{Value: "@depends_on: " + dep})
+	}
+	return spec
+}
+
+func TestSortByDependenciesOrdersPrerequisiteFirst(t *testing.T) {
+	specA := newSpecWithDeps("A", "B")
+	specB := newSpecWithDeps("B")
+
+	got, err := SortByDependencies([]*gauge.Specification{specA, specB})
+
+	if err != nil {
+		t.Fatalf("SortByDependencies failed: %s", err.Error())
+	}
+	if len(got) != 2 || got[0] != specB || got[1] != specA {
+		t.Errorf("Expected B before A, got %v", headings(got))
+	}
+}
+
+func TestSortByDependenciesKeepsOrderWithoutDeclarations(t *testing.T) {
+	specs := []*gauge.Specification{newSpecWithDeps("A"), newSpecWithDeps("B"), newSpecWithDeps("C")}
+
+	got, err := SortByDependencies(specs)
+
+	if err != nil {
+		t.Fatalf("SortByDependencies failed: %s", err.Error())
+	}
+	want := []string{"A", "B", "C"}
+	for i, h := range headings(got) {
+		if h != want[i] {
+			t.Fatalf("Expected order %v, got %v", want, headings(got))
+		}
+	}
+}
+
+func TestSortByDependenciesDetectsCycle(t *testing.T) {
+	specA := newSpecWithDeps("A", "B")
+	specB := newSpecWithDeps("B", "A")
+
+	_, err := SortByDependencies([]*gauge.Specification{specA, specB})
+
+	if err == nil {
+		t.Errorf("Expected cycle error, got none")
+	}
+}
+
+func TestSortByDependenciesIgnoresUnknownPrerequisite(t *testing.T) {
+	specA := newSpecWithDeps("A", "not selected")
+
+	got, err := SortByDependencies([]*gauge.Specification{specA})
+
+	if err != nil {
+		t.Fatalf("SortByDependencies failed: %s", err.Error())
+	}
+	if len(got) != 1 {
+		t.Errorf("Expected 1 spec, got %d", len(got))
+	}
+}
+
+func TestDependencyGroupsKeepsRelatedSpecsTogether(t *testing.T) {
+	specA := newSpecWithDeps("A", "B")
+	specB := newSpecWithDeps("B")
+	specC := newSpecWithDeps("C")
+
+	groups := DependencyGroups([]*gauge.Specification{specA, specB, specC})
+
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+	var related []*gauge.Specification
+	for _, group := range groups {
+		if len(group) == 2 {
+			related = group
+		}
+	}
+	if related == nil || related[0] != specB || related[1] != specA {
+		t.Errorf("Expected group [B A], got %v", groups)
+	}
+}
+
+func headings(specs []*gauge.Specification) []string {
+	var h []string
+	for _, spec := range specs {
+		h = append(h, spec.Heading.Value)
+	}
+	return h
+}
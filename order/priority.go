@@ -0,0 +1,79 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package order
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// unlistedPriority schedules specs carrying none of the configured tags after
+// every prioritized spec.
+const unlistedPriority = math.MaxInt32
+
+// SortByPriority schedules specs carrying a higher-priority tag (lower
+// number in execution_priority) first. The sort is stable, so specs of equal
+// priority keep the order they already had; parallel distribution picks specs
+// off the sorted list, so the ordering holds in both serial and parallel
+// modes. A spec's priority is the best one among its own tags and its
+// scenarios' tags.
+func SortByPriority(specs []*gauge.Specification) []*gauge.Specification {
+	priorities := parseExecutionPriority(env.ExecutionPriority())
+	if len(priorities) == 0 {
+		return specs
+	}
+	sort.SliceStable(specs, func(i, j int) bool {
+		return specPriority(specs[i], priorities) < specPriority(specs[j], priorities)
+	})
+	return specs
+}
+
+func parseExecutionPriority(value string) map[string]int {
+	if value == "" {
+		return nil
+	}
+	priorities := make(map[string]int)
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			logger.Warningf(true, "Ignoring malformed execution_priority entry '%s', expected tag:number", strings.TrimSpace(entry))
+			continue
+		}
+		priority, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			logger.Warningf(true, "Ignoring execution_priority entry '%s': %s is not a number", strings.TrimSpace(entry), strings.TrimSpace(parts[1]))
+			continue
+		}
+		priorities[strings.TrimSpace(parts[0])] = priority
+	}
+	return priorities
+}
+
+func specPriority(spec *gauge.Specification, priorities map[string]int) int {
+	best := unlistedPriority
+	considerTags := func(tags *gauge.Tags) {
+		if tags == nil {
+			return
+		}
+		for _, tag := range tags.Values() {
+			if priority, ok := priorities[strings.TrimSpace(tag)]; ok && priority < best {
+				best = priority
+			}
+		}
+	}
+	considerTags(spec.Tags)
+	for _, scenario := range spec.Scenarios {
+		considerTags(scenario.Tags)
+	}
+	return best
+}
@@ -0,0 +1,97 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+package order
+
+import (
+	"os"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func taggedSpec(fileName string, tags ...string) *gauge.Specification {
+	return &gauge.Specification{FileName: fileName, Tags: &gauge.Tags{RawValues: [][]string{tags}}}
+}
+
+func TestSortByPrioritySchedulesHigherPriorityTagsFirst(t *testing.T) {
+	os.Setenv("execution_priority", "smoke:1,regression:5")
+	defer os.Unsetenv("execution_priority")
+	specs := []*gauge.Specification{
+		taggedSpec("a.spec", "regression"),
+		{FileName: "b.spec"},
+		taggedSpec("c.spec", "smoke"),
+	}
+
+	sorted := SortByPriority(specs)
+
+	want := []string{"c.spec", "a.spec", "b.spec"}
+	for i, fileName := range want {
+		if sorted[i].FileName != fileName {
+			t.Errorf("sorted[%d].FileName = %s, want %s", i, sorted[i].FileName, fileName)
+		}
+	}
+}
+
+func TestSortByPriorityConsidersScenarioTags(t *testing.T) {
+	os.Setenv("execution_priority", "smoke:1")
+	defer os.Unsetenv("execution_priority")
+	specWithSmokeScenario := &gauge.Specification{
+		FileName:  "b.spec",
+		Scenarios: []*gauge.Scenario{{Tags: &gauge.Tags{RawValues: [][]string{{"smoke"}}}}},
+	}
+	specs := []*gauge.Specification{{FileName: "a.spec"}, specWithSmokeScenario}
+
+	sorted := SortByPriority(specs)
+
+	if sorted[0].FileName != "b.spec" {
+		t.Errorf("Expected spec with a smoke scenario to be scheduled first, got %s", sorted[0].FileName)
+	}
+}
+
+func TestSortByPriorityIsStableForEqualPriorities(t *testing.T) {
+	os.Setenv("execution_priority", "smoke:1")
+	defer os.Unsetenv("execution_priority")
+	specs := []*gauge.Specification{
+		taggedSpec("a.spec", "smoke"),
+		taggedSpec("b.spec", "smoke"),
+		{FileName: "c.spec"},
+		{FileName: "d.spec"},
+	}
+
+	sorted := SortByPriority(specs)
+
+	want := []string{"a.spec", "b.spec", "c.spec", "d.spec"}
+	for i, fileName := range want {
+		if sorted[i].FileName != fileName {
+			t.Errorf("sorted[%d].FileName = %s, want %s", i, sorted[i].FileName, fileName)
+		}
+	}
+}
+
+func TestSortByPriorityIgnoresMalformedEntries(t *testing.T) {
+	os.Setenv("execution_priority", "smoke,regression:high,slow:2")
+	defer os.Unsetenv("execution_priority")
+	specs := []*gauge.Specification{
+		taggedSpec("a.spec", "regression"),
+		taggedSpec("b.spec", "slow"),
+	}
+
+	sorted := SortByPriority(specs)
+
+	if sorted[0].FileName != "b.spec" {
+		t.Errorf("Expected only the well-formed slow:2 entry to take effect, got %s first", sorted[0].FileName)
+	}
+}
+
+func TestSortByPriorityIsANoOpWithoutConfiguration(t *testing.T) {
+	specs := []*gauge.Specification{{FileName: "b.spec"}, {FileName: "a.spec"}}
+
+	sorted := SortByPriority(specs)
+
+	if sorted[0].FileName != "b.spec" {
+		t.Errorf("Expected the order to be untouched, got %s first", sorted[0].FileName)
+	}
+}
@@ -7,13 +7,23 @@
 package order
 
 import (
+	"math/rand"
 	"sort"
+	"time"
 
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
 )
 
 var Sorted bool
 
+// Random shuffles the spec execution order to shake out inter-spec coupling.
+var Random bool
+
+// Seed drives the shuffle when Random is set. 0 picks a fresh seed; the seed
+// in use is always printed so a failing order can be reproduced with --seed.
+var Seed int64
+
 type byFileName []*gauge.Specification
 
 func (s byFileName) Len() int {
@@ -29,8 +39,26 @@ func (s byFileName) Less(i, j int) bool {
 }
 
 func Sort(specs []*gauge.Specification) []*gauge.Specification {
+	if Random {
+		return shuffle(specs)
+	}
 	if Sorted {
 		sort.Sort(byFileName(specs))
 	}
 	return specs
 }
+
+func shuffle(specs []*gauge.Specification) []*gauge.Specification {
+	if Seed == 0 {
+		Seed = time.Now().UnixNano()
+	}
+	// sort first so the same seed reproduces the same order regardless of
+	// the order in which specs were parsed.
+	sort.Sort(byFileName(specs))
+	r := rand.New(rand.NewSource(Seed))
+	r.Shuffle(len(specs), func(i, j int) {
+		specs[i], specs[j] = specs[j], specs[i]
+	})
+	logger.Infof(true, "Random spec execution order, seed: %d. Use --sort=random --seed %d to reproduce this order.", Seed, Seed)
+	return specs
+}
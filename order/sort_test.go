@@ -30,3 +30,38 @@ func TestToSortSpecs(t *testing.T) {
 		}
 	}
 }
+
+func TestShuffleWithSameSeedGivesSameOrder(t *testing.T) {
+	newSpecs := func() []*gauge.Specification {
+		var specs []*gauge.Specification
+		for _, f := range []string{"a", "b", "c", "d", "e", "f"} {
+			specs = append(specs, &gauge.Specification{FileName: f})
+		}
+		return specs
+	}
+	Random = true
+	Seed = 12345
+	defer func() { Random = false; Seed = 0 }()
+
+	first := Sort(newSpecs())
+	Seed = 12345
+	second := Sort(newSpecs())
+
+	for i, s := range first {
+		if second[i].FileName != s.FileName {
+			t.Errorf("Expected '%s' at position %d, got %s", s.FileName, i, second[i].FileName)
+		}
+	}
+}
+
+func TestShufflePicksASeedWhenUnset(t *testing.T) {
+	Random = true
+	Seed = 0
+	defer func() { Random = false; Seed = 0 }()
+
+	Sort([]*gauge.Specification{{FileName: "a"}, {FileName: "b"}})
+
+	if Seed == 0 {
+		t.Error("Expected a seed to be picked, got 0")
+	}
+}
@@ -330,7 +330,7 @@ func (s *MySuite) TestErrorParsingConceptHeadingWithStaticOrSpecialParameter(c *
 
 	_, parseRes = parser.Parse("# my concept with <table: foo> \n * first step \n * second step ", "foo2.spec")
 	c.Assert(len(parseRes.ParseErrors), Not(Equals), 0)
-	c.Assert(parseRes.ParseErrors[0].Error(), Equals, "foo2.spec:1 Dynamic parameter <table: foo> could not be resolved => 'my concept with <table: foo>'")
+	c.Assert(parseRes.ParseErrors[0].Error(), Equals, "foo2.spec:1:17 Dynamic parameter <table: foo> could not be resolved => 'my concept with <table: foo>'")
 }
 
 func (s *MySuite) TestErrorParsingConceptWithoutHeading(c *C) {
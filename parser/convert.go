@@ -21,7 +21,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		return token.Kind == gauge.SpecKind
 	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
 		if spec.Heading != nil {
-			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{spec.FileName, token.LineNo, token.SpanEnd, "Multiple spec headings found in same file", token.LineText()}}}
+			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{FileName: spec.FileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Multiple spec headings found in same file", LineText: token.LineText()}}}
 		}
 
 		spec.AddHeading(&gauge.Heading{LineNo: token.LineNo, Value: token.Value, SpanEnd: token.SpanEnd})
@@ -33,11 +33,11 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		return token.Kind == gauge.ScenarioKind
 	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
 		if spec.Heading == nil {
-			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{spec.FileName, token.LineNo, token.SpanEnd, "Scenario should be defined after the spec heading", token.LineText()}}}
+			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{FileName: spec.FileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Scenario should be defined after the spec heading", LineText: token.LineText()}}}
 		}
 		for _, scenario := range spec.Scenarios {
 			if strings.EqualFold(scenario.Heading.Value, token.Value) {
-				return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{spec.FileName, token.LineNo, token.SpanEnd, "Duplicate scenario definition '" + scenario.Heading.Value + "' found in the same specification", token.LineText()}}}
+				return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{FileName: spec.FileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Duplicate scenario definition '" + scenario.Heading.Value + "' found in the same specification", LineText: token.LineText()}}}
 			}
 		}
 		scenario := &gauge.Scenario{Span: &gauge.Span{Start: token.LineNo, End: token.LineNo}}
@@ -243,9 +243,9 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				spec.AddComment(&gauge.Comment{Value: token.LineText(), LineNo: token.LineNo})
 			}
 		} else {
-			t := spec.DataTable
+			t := &spec.DataTable
 			if isInState(*state, scenarioScope) && env.AllowScenarioDatatable() {
-				t = spec.LatestScenario().DataTable
+				t = &spec.LatestScenario().DataTable
 			}
 
 			tableValues, warnings, err := validateTableRows(token, new(gauge.ArgLookup).FromDataTables(&t.Table), spec.FileName)
@@ -253,6 +253,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				result = ParseResult{Ok: false, Warnings: warnings, ParseErrors: err}
 			} else {
 				t.Table.AddRowValues(tableValues)
+				t.Table.RecordRowLine(token.LineNo)
 				result = ParseResult{Ok: true, Warnings: warnings}
 			}
 		}
@@ -0,0 +1,117 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// FuzzTables turns on table fuzzing: boundary and edge-case values are
+// generated for the typed columns of spec data tables and executed as extra
+// rows, to flush out inputs the hand-written rows miss.
+var FuzzTables bool
+
+// FuzzSeed seeds the fuzz value generator. 0 picks a seed, which is logged
+// so a failing generated row can be reproduced.
+var FuzzSeed int64
+
+// fuzzRowsPerTable is the number of generated rows appended per data table.
+const fuzzRowsPerTable = 4
+
+var fuzzMutex sync.Mutex
+var fuzzedRowStart = map[string]int{}
+
+// IsFuzzedRow reports whether the given data table row of a spec was
+// generated by the fuzzer rather than written by hand.
+func IsFuzzedRow(fileName string, rowIndex int) bool {
+	fuzzMutex.Lock()
+	defer fuzzMutex.Unlock()
+	start, ok := fuzzedRowStart[fileName]
+	return ok && rowIndex >= start
+}
+
+// addFuzzedTableRows appends generated rows to the spec's data table. Column
+// types are inferred from the hand-written values, so an all-numeric column
+// gets numeric boundary values while a text column gets empty, oversized and
+// unicode strings.
+func addFuzzedTableRows(spec *gauge.Specification) {
+	table := &spec.DataTable.Table
+	if !table.IsInitialized() || table.GetRowCount() == 0 {
+		return
+	}
+	fuzzMutex.Lock()
+	if FuzzSeed == 0 {
+		FuzzSeed = time.Now().UnixNano()
+	}
+	seed := FuzzSeed
+	fuzzedRowStart[spec.FileName] = table.GetRowCount()
+	fuzzMutex.Unlock()
+
+	r := rand.New(rand.NewSource(seed))
+	types := make([]columnType, len(table.Headers))
+	for i, header := range table.Headers {
+		cells, err := table.Get(header)
+		if err != nil {
+			continue
+		}
+		types[i] = inferColumnType(cells)
+	}
+	for i := 0; i < fuzzRowsPerTable; i++ {
+		var rowValues []string
+		for _, t := range types {
+			rowValues = append(rowValues, fuzzValueFor(t, r))
+		}
+		table.AddRowValues(table.CreateTableCells(rowValues))
+	}
+	logger.Debugf(true, "Added %d fuzzed rows to the data table of %s", fuzzRowsPerTable, spec.FileName)
+}
+
+type columnType int
+
+const (
+	stringColumn columnType = iota
+	intColumn
+	floatColumn
+)
+
+func inferColumnType(cells []gauge.TableCell) columnType {
+	t := intColumn
+	for _, cell := range cells {
+		value := strings.TrimSpace(cell.Value)
+		if _, err := strconv.Atoi(value); err == nil {
+			continue
+		}
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			t = floatColumn
+			continue
+		}
+		return stringColumn
+	}
+	return t
+}
+
+var intEdgeValues = []string{"0", "-1", "1", "-2147483648", "2147483647", "9223372036854775807"}
+var floatEdgeValues = []string{"0.0", "-0.0", "-1.5", "0.1", "1e308", "-1e308"}
+var stringEdgeValues = []string{"", " ", "ñîçøðé ツ 💥", "null", "'\";--", strings.Repeat("a", 1024)}
+
+func fuzzValueFor(t columnType, r *rand.Rand) string {
+	switch t {
+	case intColumn:
+		return intEdgeValues[r.Intn(len(intEdgeValues))]
+	case floatColumn:
+		return floatEdgeValues[r.Intn(len(floatEdgeValues))]
+	default:
+		return stringEdgeValues[r.Intn(len(stringEdgeValues))]
+	}
+}
@@ -0,0 +1,62 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func newFuzzTestSpec(fileName string) *gauge.Specification {
+	table := gauge.NewTable([]string{"id", "name"}, [][]gauge.TableCell{
+		{gauge.GetTableCell("1"), gauge.GetTableCell("2")},
+		{gauge.GetTableCell("foo"), gauge.GetTableCell("bar")},
+	}, 1)
+	return &gauge.Specification{
+		FileName:  fileName,
+		Heading:   &gauge.Heading{Value: "Spec heading"},
+		DataTable: gauge.DataTable{Table: *table},
+	}
+}
+
+func (s *MySuite) TestAddFuzzedTableRowsAppendsRowsAndRecordsOriginalCount(c *C) {
+	defer func() { fuzzedRowStart = map[string]int{}; FuzzSeed = 0 }()
+	FuzzSeed = 42
+	spec := newFuzzTestSpec("fuzzed.spec")
+
+	addFuzzedTableRows(spec)
+
+	c.Assert(spec.DataTable.Table.GetRowCount(), Equals, 2+fuzzRowsPerTable)
+	c.Assert(IsFuzzedRow("fuzzed.spec", 1), Equals, false)
+	c.Assert(IsFuzzedRow("fuzzed.spec", 2), Equals, true)
+	c.Assert(IsFuzzedRow("other.spec", 2), Equals, false)
+}
+
+func (s *MySuite) TestAddFuzzedTableRowsIsDeterministicForASeed(c *C) {
+	defer func() { fuzzedRowStart = map[string]int{}; FuzzSeed = 0 }()
+	FuzzSeed = 42
+	spec1 := newFuzzTestSpec("one.spec")
+	spec2 := newFuzzTestSpec("two.spec")
+
+	addFuzzedTableRows(spec1)
+	addFuzzedTableRows(spec2)
+
+	c.Assert(spec1.DataTable.Table.Rows(), DeepEquals, spec2.DataTable.Table.Rows())
+}
+
+func (s *MySuite) TestInferColumnType(c *C) {
+	cells := func(values ...string) []gauge.TableCell {
+		var res []gauge.TableCell
+		for _, v := range values {
+			res = append(res, gauge.GetTableCell(v))
+		}
+		return res
+	}
+	c.Assert(inferColumnType(cells("1", "-2", "30")), Equals, intColumn)
+	c.Assert(inferColumnType(cells("1", "2.5")), Equals, floatColumn)
+	c.Assert(inferColumnType(cells("1", "foo")), Equals, stringColumn)
+}
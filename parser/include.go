@@ -0,0 +1,69 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/common"
+)
+
+// includeDirectiveRegex matches an include directive occupying a line of its
+// own, e.g. `<include: common/setup.md>`.
+var includeDirectiveRegex = regexp.MustCompile(`^\s*<include:\s*(.+?)\s*>\s*$`)
+
+// expandIncludes replaces every include directive in the spec text with the
+// contents of the file it names, before tokenizing. Paths are resolved
+// relative to the including file and may include further files; a file
+// including itself, directly or through other files, is an error.
+func expandIncludes(specText, specFile string) (string, ParseError, bool) {
+	if !strings.Contains(specText, "<include:") {
+		return specText, ParseError{}, true
+	}
+	trail := []string{}
+	if abs, err := filepath.Abs(specFile); err == nil {
+		trail = append(trail, abs)
+	}
+	return expandIncludesAlong(specText, specFile, trail)
+}
+
+func expandIncludesAlong(specText, specFile string, trail []string) (string, ParseError, bool) {
+	var expanded []string
+	for lineNo, line := range strings.Split(specText, "\n") {
+		match := includeDirectiveRegex.FindStringSubmatch(line)
+		if match == nil {
+			expanded = append(expanded, line)
+			continue
+		}
+		includedFile := match[1]
+		if !filepath.IsAbs(includedFile) {
+			includedFile = filepath.Join(filepath.Dir(specFile), includedFile)
+		}
+		includedFile, err := filepath.Abs(includedFile)
+		if err != nil {
+			return "", ParseError{FileName: specFile, LineNo: lineNo + 1, Message: err.Error(), LineText: strings.TrimSpace(line)}, false
+		}
+		for _, seen := range trail {
+			if seen == includedFile {
+				return "", ParseError{FileName: specFile, LineNo: lineNo + 1, Message: fmt.Sprintf("Include cycle detected: %s is already being included", includedFile), LineText: strings.TrimSpace(line)}, false
+			}
+		}
+		content, err := common.ReadFileContents(includedFile)
+		if err != nil {
+			return "", ParseError{FileName: specFile, LineNo: lineNo + 1, Message: fmt.Sprintf("Unable to include %s: %s", includedFile, err.Error()), LineText: strings.TrimSpace(line)}, false
+		}
+		includedText, parseErr, ok := expandIncludesAlong(content, includedFile, append(trail, includedFile))
+		if !ok {
+			return "", parseErr, false
+		}
+		expanded = append(expanded, strings.TrimSuffix(includedText, "\n"))
+	}
+	return strings.Join(expanded, "\n"), ParseError{}, true
+}
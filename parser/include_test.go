@@ -0,0 +1,79 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func writeIncludeFile(c *C, dir, name, content string) string {
+	file := filepath.Join(dir, name)
+	c.Assert(os.MkdirAll(filepath.Dir(file), 0755), Equals, nil)
+	c.Assert(ioutil.WriteFile(file, []byte(content), 0644), Equals, nil)
+	return file
+}
+
+func (s *MySuite) TestParseExpandsIncludeDirectives(c *C) {
+	dir := c.MkDir()
+	writeIncludeFile(c, dir, filepath.Join("common", "setup.md"), "## Common setup\n* common setup step\n")
+	specFile := writeIncludeFile(c, dir, "sample.spec", "# Spec Heading\n<include: common/setup.md>\n## Scenario Heading\n* a step\n")
+
+	spec, res, err := new(SpecParser).Parse("# Spec Heading\n<include: common/setup.md>\n## Scenario Heading\n* a step\n", gauge.NewConceptDictionary(), specFile)
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(len(spec.Scenarios), Equals, 2)
+	c.Assert(spec.Scenarios[0].Heading.Value, Equals, "Common setup")
+	c.Assert(spec.Scenarios[0].Steps[0].Value, Equals, "common setup step")
+	c.Assert(spec.Scenarios[1].Heading.Value, Equals, "Scenario Heading")
+}
+
+func (s *MySuite) TestParseExpandsNestedIncludes(c *C) {
+	dir := c.MkDir()
+	writeIncludeFile(c, dir, "steps.md", "* nested step\n")
+	writeIncludeFile(c, dir, "setup.md", "## Nested setup\n<include: steps.md>\n")
+	specFile := writeIncludeFile(c, dir, "sample.spec", "# Spec Heading\n<include: setup.md>\n")
+
+	spec, res, err := new(SpecParser).Parse("# Spec Heading\n<include: setup.md>\n", gauge.NewConceptDictionary(), specFile)
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(len(spec.Scenarios), Equals, 1)
+	c.Assert(spec.Scenarios[0].Steps[0].Value, Equals, "nested step")
+}
+
+func (s *MySuite) TestParseReportsAnIncludeCycle(c *C) {
+	dir := c.MkDir()
+	writeIncludeFile(c, dir, "a.md", "<include: b.md>\n")
+	writeIncludeFile(c, dir, "b.md", "<include: a.md>\n")
+	specFile := writeIncludeFile(c, dir, "sample.spec", "# Spec Heading\n<include: a.md>\n")
+
+	_, res, err := new(SpecParser).Parse("# Spec Heading\n<include: a.md>\n", gauge.NewConceptDictionary(), specFile)
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(len(res.ParseErrors), Equals, 1)
+	c.Assert(res.ParseErrors[0].Code(), Equals, IncludeErrorCode)
+}
+
+func (s *MySuite) TestParseReportsAMissingIncludeFile(c *C) {
+	dir := c.MkDir()
+	specFile := filepath.Join(dir, "sample.spec")
+
+	_, res, err := new(SpecParser).Parse("# Spec Heading\n<include: no/such/file.md>\n", gauge.NewConceptDictionary(), specFile)
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(len(res.ParseErrors), Equals, 1)
+	c.Assert(res.ParseErrors[0].Code(), Equals, IncludeErrorCode)
+	c.Assert(res.ParseErrors[0].LineNo, Equals, 2)
+}
@@ -182,7 +182,12 @@ func (parser *SpecParser) isScenarioHeading(text string) bool {
 
 func (parser *SpecParser) isStep(text string) bool {
 	if len(text) > 1 {
-		return text[0] == '*' && text[1] != '*'
+		if text[0] == '*' && text[1] != '*' {
+			return true
+		}
+		// Dash bullets are opt-in: they would otherwise swallow comment
+		// lines that happen to start with "- ".
+		return env.StepBulletStyle() == "-" && text[0] == '-' && text[1] == ' '
 	}
 	return text[0] == '*'
 }
@@ -29,10 +29,13 @@ import (
 	"strings"
 	"sync"
 
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/annotations"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
@@ -106,8 +109,15 @@ func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictiona
 // ParseSpecs parses specs in the give directory and gives specification and pass/fail status, used in validation.
 func ParseSpecs(args []string, conceptsDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, bool) {
 	specs, failed := parseSpecsInDirs(conceptsDictionary, args, buildErrors)
-	specsToExecute := order.Sort(filter.FilterSpecs(specs))
-	return specsToExecute, failed
+	specsToExecute := order.SortByPriority(order.Sort(filter.FilterSpecs(specs)))
+	orderedSpecs, err := order.SortByDependencies(specsToExecute)
+	if err != nil {
+		for _, spec := range specsToExecute {
+			buildErrors.SpecErrs[spec] = append(buildErrors.SpecErrs[spec], ParseError{FileName: spec.FileName, Message: err.Error()})
+		}
+		return specsToExecute, true
+	}
+	return orderedSpecs, failed
 }
 
 // ParseConcepts creates concept dictionary and concept parse result.
@@ -155,8 +165,7 @@ func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []str
 		i, _ := getIndexFor(specFiles, spec.FileName)
 		specFile := specFiles[i]
 		if len(specFile.indices) > 0 {
-			s, _ := spec.Filter(filter.NewScenarioFilterBasedOnSpan(specFile.indices))
-			allSpecs[i] = s
+			allSpecs[i] = filterSpecByIndices(spec, specFile.indices)
 		} else {
 			allSpecs[i] = spec
 		}
@@ -164,6 +173,49 @@ func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []str
 	return allSpecs, !passed
 }
 
+// filterSpecByIndices keeps the scenarios enclosing the addressed line
+// numbers. A line addressing a data table row keeps every scenario but
+// restricts the table to the addressed rows; a line outside any scenario,
+// such as the spec heading, keeps the whole spec.
+func filterSpecByIndices(spec *gauge.Specification, indices []int) *gauge.Specification {
+	s, _ := spec.Filter(filter.NewScenarioFilterBasedOnSpan(indices))
+	if len(s.Scenarios) > 0 {
+		return s
+	}
+	if rows := addressedTableRows(spec, indices); len(rows) > 0 {
+		restrictDataTableToRows(spec, rows)
+	}
+	return spec
+}
+
+func addressedTableRows(spec *gauge.Specification, indices []int) []int {
+	if !spec.DataTable.IsInitialized() {
+		return nil
+	}
+	var rows []int
+	for _, index := range indices {
+		if row := spec.DataTable.Table.RowIndexOnLine(index); row >= 0 {
+			rows = append(rows, row)
+		}
+	}
+	sort.Ints(rows)
+	return rows
+}
+
+func restrictDataTableToRows(spec *gauge.Specification, rows []int) {
+	t := &spec.DataTable.Table
+	columns := make([][]gauge.TableCell, len(t.Columns))
+	for i, column := range t.Columns {
+		columns[i] = make([]gauge.TableCell, 0)
+		for _, row := range rows {
+			if row < len(column) {
+				columns[i] = append(columns[i], column[row])
+			}
+		}
+	}
+	spec.DataTable.Table = *gauge.NewTable(t.Headers, columns, t.LineNo)
+}
+
 func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specFile) {
 	for _, specSource := range specDirs {
 		if isIndexedSpec(specSource) {
@@ -202,7 +254,7 @@ func addSpecFile(specFiles *[]*specFile, file string) (*specFile, bool) {
 
 func getIndexFor(files []*specFile, file string) (int, bool) {
 	for index, f := range files {
-		if f.filePath == file {
+		if util.PathsEqual(f.filePath, file) {
 			return index, true
 		}
 	}
@@ -210,12 +262,12 @@ func getIndexFor(files []*specFile, file string) (int, bool) {
 }
 
 func isIndexedSpec(specSource string) bool {
-	re := regexp.MustCompile(`(?i).(spec|md):[0-9]+$`)
-	index := re.FindStringIndex(specSource)
-	if index != nil {
-		return index[0] != 0
+	index := getIndex(specSource)
+	if index <= 0 {
+		return false
 	}
-	return false
+	specName := specSource[:index]
+	return len(specName) > len(filepath.Ext(specName)) && util.IsValidSpecExtension(specName)
 }
 
 func getIndexedSpecName(indexedSpec string) (string, int) {
@@ -272,18 +324,28 @@ func getParameterizeStepValue(stepValue string, params []string) string {
 	return stepValue
 }
 
+// Strict makes HandleParseResult treat warnings — duplicate headings, empty
+// scenarios and the like — as errors, so CI can gate on them. Set by the
+// --strict flag.
+var Strict bool
+
 // HandleParseResult collates list of parse result and determines if gauge has to break flow.
 func HandleParseResult(results ...*ParseResult) bool {
 	var failed = false
 	for _, result := range results {
 		if !result.Ok {
-			for _, err := range result.Errors() {
-				logger.Errorf(true, err)
+			for _, err := range result.ParseErrors {
+				logger.Errorf(true, "[ParseError] [%s] %s", err.Code(), err.Error())
+				annotations.Error("gauge-parse", err.FileName, err.LineNo, err.Message)
 			}
 			failed = true
 		}
-		if result.Warnings != nil {
-			for _, warning := range result.Warnings {
+		for _, warning := range result.Warnings {
+			if Strict {
+				logger.Errorf(true, "[ParseError] [%s] %s", ParseWarningCode, warning)
+				annotations.Error("gauge-parse", warning.FileName, warning.LineNo, warning.Message)
+				failed = true
+			} else {
 				logger.Warningf(true, "[ParseWarning] %s", warning)
 			}
 		}
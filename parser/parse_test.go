@@ -272,3 +272,86 @@ func specialTableArg(val string) *gauge.StepArg {
 func specialStringArg(val string) *gauge.StepArg {
 	return &gauge.StepArg{ArgType: gauge.SpecialString, Name: val}
 }
+
+func parseSpecText(c *C, specText string) *gauge.Specification {
+	spec, parseResult, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, Equals, nil)
+	c.Assert(parseResult.Ok, Equals, true)
+	return spec
+}
+
+var dataTableSpecText = `# Spec heading
+|id|name|
+|--|----|
+|1 |a   |
+|2 |b   |
+
+## First scenario
+* a step
+
+## Second scenario
+* another step
+`
+
+func (s *MySuite) TestFilterSpecByIndicesKeepsEnclosingScenario(c *C) {
+	spec := parseSpecText(c, dataTableSpecText)
+
+	filtered := filterSpecByIndices(spec, []int{8})
+
+	c.Assert(len(filtered.Scenarios), Equals, 1)
+	c.Assert(filtered.Scenarios[0].Heading.Value, Equals, "First scenario")
+}
+
+func (s *MySuite) TestFilterSpecByIndicesOnTableRowRestrictsDataTable(c *C) {
+	spec := parseSpecText(c, dataTableSpecText)
+
+	filtered := filterSpecByIndices(spec, []int{5})
+
+	c.Assert(len(filtered.Scenarios), Equals, 2)
+	rows := filtered.DataTable.Table.Rows()
+	c.Assert(len(rows), Equals, 1)
+	c.Assert(rows[0][0], Equals, "2")
+}
+
+func (s *MySuite) TestFilterSpecByIndicesOnSpecHeadingKeepsWholeSpec(c *C) {
+	spec := parseSpecText(c, dataTableSpecText)
+
+	filtered := filterSpecByIndices(spec, []int{1})
+
+	c.Assert(len(filtered.Scenarios), Equals, 2)
+	c.Assert(len(filtered.DataTable.Table.Rows()), Equals, 2)
+}
+
+func (s *MySuite) TestIsIndexedSpecHonoursConfiguredExtensions(c *C) {
+	c.Assert(isIndexedSpec("specs/hello_world.md:10"), Equals, true)
+	c.Assert(isIndexedSpec("specs/hello_world.txt:10"), Equals, false)
+	c.Assert(isIndexedSpec(".spec:10"), Equals, false)
+}
+
+func (s *MySuite) TestHandleParseResultTreatsWarningsAsErrorsInStrictMode(c *C) {
+	Strict = true
+	defer func() { Strict = false }()
+	res := &ParseResult{Ok: true, Warnings: []*Warning{{FileName: "foo.spec", LineNo: 2, Message: "Multiple spec headings found in same file"}}}
+
+	c.Assert(HandleParseResult(res), Equals, true)
+}
+
+func (s *MySuite) TestHandleParseResultOnlyLogsWarningsWithoutStrictMode(c *C) {
+	res := &ParseResult{Ok: true, Warnings: []*Warning{{FileName: "foo.spec", LineNo: 2, Message: "Multiple spec headings found in same file"}}}
+
+	c.Assert(HandleParseResult(res), Equals, false)
+}
+
+func (s *MySuite) TestSpecParsingReportsAllStructuralErrors(c *C) {
+	p := new(SpecParser)
+
+	_, res, err := p.Parse(`# Spec Heading
+## First Scenario
+## Second Scenario
+`, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(len(res.ParseErrors), Equals, 2)
+	c.Assert(res.ParseErrors[0].Error(), Equals, "foo.spec:2 Scenario should have atleast one step => ''")
+	c.Assert(res.ParseErrors[1].Error(), Equals, "foo.spec:3 Scenario should have atleast one step => ''")
+}
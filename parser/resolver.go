@@ -149,16 +149,15 @@ func initializePredefinedResolvers() map[string]resolverFn {
 			}
 			return &gauge.StepArg{Value: fileContent, ArgType: gauge.SpecialString}, nil
 		},
-		"table": func(filePath string) (*gauge.StepArg, error) {
-			csv, err := util.GetFileContents(filePath)
-			if err != nil {
-				return nil, err
-			}
-			csvTable, err := convertCsvToTable(csv)
+		"unique": func(label string) (*gauge.StepArg, error) {
+			return &gauge.StepArg{Value: nextUniqueValue(label), ArgType: gauge.SpecialString}, nil
+		},
+		"table": func(source string) (*gauge.StepArg, error) {
+			table, err := resolveExternalTable(source)
 			if err != nil {
 				return nil, err
 			}
-			return &gauge.StepArg{Table: *csvTable, ArgType: gauge.SpecialTable}, nil
+			return &gauge.StepArg{Table: *table, ArgType: gauge.SpecialTable}, nil
 		},
 	}
 }
@@ -6,25 +6,104 @@
 
 package parser
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // ParseError holds information about a parse failure
 type ParseError struct {
 	FileName string
 	LineNo   int
+	// Col is the 1-based column the error starts at within the line, 0 when
+	// only the line is known.
+	Col      int
 	SpanEnd  int
 	Message  string
 	LineText string
 }
 
 // Error prints error with filename, line number, error message and step text.
+// The column is included when the parser knows where in the line the error starts.
 func (se ParseError) Error() string {
 	if se.LineNo == 0 && se.FileName == "" {
 		return se.Message
 	}
+	if se.Col > 0 {
+		return fmt.Sprintf("%s:%d:%d %s => '%s'", se.FileName, se.LineNo, se.Col, se.Message, se.LineText)
+	}
 	return fmt.Sprintf("%s:%d %s => '%s'", se.FileName, se.LineNo, se.Message, se.LineText)
 }
 
+// Column returns the 1-based column the error starts at, 1 when gauge only
+// tracked the line.
+func (se ParseError) Column() int {
+	if se.Col > 0 {
+		return se.Col
+	}
+	return 1
+}
+
+// Stable codes identifying classes of parse errors, for machine consumers
+// such as --json output and CI annotations. Errors are constructed in many
+// places, so classification is by message; the messages are part of gauge's
+// documented output and do not change between releases.
+const (
+	ParseErrorCode                = "PARSE_ERROR"
+	ParseWarningCode              = "PARSE_WARNING"
+	MultipleSpecHeadingsCode      = "MULTIPLE_SPEC_HEADINGS"
+	ScenarioBeforeSpecHeadingCode = "SCENARIO_BEFORE_SPEC_HEADING"
+	DuplicateScenarioHeadingCode  = "DUPLICATE_SCENARIO_HEADING"
+	EmptySpecCode                 = "EMPTY_SPEC"
+	MissingSpecHeadingCode        = "MISSING_SPEC_HEADING"
+	EmptySpecHeadingCode          = "EMPTY_SPEC_HEADING"
+	EmptyScenarioHeadingCode      = "EMPTY_SCENARIO_HEADING"
+	NoScenariosCode               = "NO_SCENARIOS"
+	EmptyScenarioCode             = "EMPTY_SCENARIO"
+	EmptyDataTableCode            = "EMPTY_DATA_TABLE"
+	InvalidTableCode              = "INVALID_TABLE"
+	UnresolvedParameterCode       = "UNRESOLVED_PARAMETER"
+	ConceptErrorCode              = "CONCEPT_ERROR"
+	IncludeErrorCode              = "INCLUDE_ERROR"
+)
+
+var errorCodes = []struct {
+	prefix string
+	code   string
+}{
+	{"Multiple spec headings found", MultipleSpecHeadingsCode},
+	{"Scenario should be defined after the spec heading", ScenarioBeforeSpecHeadingCode},
+	{"Duplicate scenario definition", DuplicateScenarioHeadingCode},
+	{"Spec does not have any elements", EmptySpecCode},
+	{"Spec heading not found", MissingSpecHeadingCode},
+	{"Spec heading should have at least one character", EmptySpecHeadingCode},
+	{"Scenario heading should have at least one character", EmptyScenarioHeadingCode},
+	{"Spec should have atleast one scenario", NoScenariosCode},
+	{"Scenario should have atleast one step", EmptyScenarioCode},
+	{"Data table should have at least 1 data row", EmptyDataTableCode},
+	{"Table header", InvalidTableCode},
+	{"Dynamic parameter", UnresolvedParameterCode},
+	{"Concept", ConceptErrorCode},
+	{"Include cycle detected", IncludeErrorCode},
+	{"Unable to include", IncludeErrorCode},
+}
+
+// columnInLine locates text within a line as a 1-based column, 0 when the
+// line does not contain it.
+func columnInLine(line, text string) int {
+	return strings.Index(line, text) + 1
+}
+
+// Code returns the stable code identifying the class of this error.
+func (se ParseError) Code() string {
+	for _, c := range errorCodes {
+		if strings.HasPrefix(se.Message, c.prefix) {
+			return c.code
+		}
+	}
+	return ParseErrorCode
+}
+
 func (token *Token) String() string {
 	return fmt.Sprintf("kind:%d, lineNo:%d, value:%s, line:%s, args:%s", token.Kind, token.LineNo, token.Value, token.LineText(), token.Args)
 }
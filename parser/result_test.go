@@ -0,0 +1,37 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestParseErrorCodeClassifiesKnownMessages(c *C) {
+	c.Assert(ParseError{Message: "Spec heading not found"}.Code(), Equals, MissingSpecHeadingCode)
+	c.Assert(ParseError{Message: "Duplicate scenario definition 'Login' found in the same specification"}.Code(), Equals, DuplicateScenarioHeadingCode)
+	c.Assert(ParseError{Message: "Scenario should have atleast one step"}.Code(), Equals, EmptyScenarioCode)
+	c.Assert(ParseError{Message: "Dynamic parameter <a> could not be resolved"}.Code(), Equals, UnresolvedParameterCode)
+	c.Assert(ParseError{Message: "Table header should not be blank"}.Code(), Equals, InvalidTableCode)
+}
+
+func (s *MySuite) TestParseErrorCodeFallsBackForUnknownMessages(c *C) {
+	c.Assert(ParseError{Message: "something else entirely"}.Code(), Equals, ParseErrorCode)
+}
+
+func (s *MySuite) TestParseErrorIncludesColumnWhenKnown(c *C) {
+	err := ParseError{FileName: "foo.spec", LineNo: 4, Col: 5, Message: "Dynamic parameter <a> could not be resolved", LineText: "def <a>"}
+
+	c.Assert(err.Error(), Equals, "foo.spec:4:5 Dynamic parameter <a> could not be resolved => 'def <a>'")
+	c.Assert(err.Column(), Equals, 5)
+}
+
+func (s *MySuite) TestParseErrorColumnDefaultsToLineStart(c *C) {
+	err := ParseError{FileName: "foo.spec", LineNo: 4, Message: "Spec heading not found"}
+
+	c.Assert(err.Error(), Equals, "foo.spec:4 Spec heading not found => ''")
+	c.Assert(err.Column(), Equals, 1)
+}
@@ -24,7 +24,12 @@ type SpecParser struct {
 }
 
 // Parse generates tokens for the given spec text and creates the specification.
+// Include directives are expanded before tokenizing.
 func (parser *SpecParser) Parse(specText string, conceptDictionary *gauge.ConceptDictionary, specFile string) (*gauge.Specification, *ParseResult, error) {
+	specText, includeErr, ok := expandIncludes(specText, specFile)
+	if !ok {
+		return nil, &ParseResult{FileName: specFile, ParseErrors: []ParseError{includeErr}, Ok: false}, nil
+	}
 	tokens, errs := parser.GenerateTokens(specText, specFile)
 	spec, res, err := parser.CreateSpecification(tokens, conceptDictionary, specFile)
 	if err != nil {
@@ -57,10 +62,9 @@ func (parser *SpecParser) CreateSpecification(tokens []*Token, conceptDictionary
 	if err := specification.ProcessConceptStepsFrom(conceptDictionary); err != nil {
 		return nil, nil, err
 	}
-	err := parser.validateSpec(specification)
-	if err != nil {
+	if errs := parser.validateSpec(specification); len(errs) > 0 {
 		finalResult.Ok = false
-		finalResult.ParseErrors = append([]ParseError{err.(ParseError)}, finalResult.ParseErrors...)
+		finalResult.ParseErrors = append(errs, finalResult.ParseErrors...)
 	}
 	return specification, finalResult, nil
 }
@@ -93,32 +97,37 @@ func (parser *SpecParser) createSpecification(tokens []*Token, specFile string)
 	return specification, finalResult
 }
 
-func (parser *SpecParser) validateSpec(specification *gauge.Specification) error {
+// validateSpec reports every structural problem in the specification, not
+// just the first, so one validation pass surfaces all of them.
+func (parser *SpecParser) validateSpec(specification *gauge.Specification) []ParseError {
 	if len(specification.Items) == 0 {
 		specification.AddHeading(&gauge.Heading{})
-		return ParseError{FileName: specification.FileName, LineNo: 1, SpanEnd: 1, Message: "Spec does not have any elements"}
+		return []ParseError{{FileName: specification.FileName, LineNo: 1, SpanEnd: 1, Message: "Spec does not have any elements"}}
 	}
-	if specification.Heading == nil {
+	var errs []ParseError
+	headingPresent := specification.Heading != nil
+	if !headingPresent {
 		specification.AddHeading(&gauge.Heading{})
-		return ParseError{FileName: specification.FileName, LineNo: 1, SpanEnd: 1, Message: "Spec heading not found"}
-	}
-	if len(strings.TrimSpace(specification.Heading.Value)) < 1 {
-		return ParseError{FileName: specification.FileName, LineNo: specification.Heading.LineNo, SpanEnd: specification.Heading.LineNo, Message: "Spec heading should have at least one character"}
+		errs = append(errs, ParseError{FileName: specification.FileName, LineNo: 1, SpanEnd: 1, Message: "Spec heading not found"})
+	} else if len(strings.TrimSpace(specification.Heading.Value)) < 1 {
+		errs = append(errs, ParseError{FileName: specification.FileName, LineNo: specification.Heading.LineNo, SpanEnd: specification.Heading.LineNo, Message: "Spec heading should have at least one character"})
 	}
 
 	dataTable := specification.DataTable.Table
 	if dataTable.IsInitialized() && dataTable.GetRowCount() == 0 {
-		return ParseError{FileName: specification.FileName, LineNo: dataTable.LineNo, SpanEnd: dataTable.LineNo, Message: "Data table should have at least 1 data row"}
+		errs = append(errs, ParseError{FileName: specification.FileName, LineNo: dataTable.LineNo, SpanEnd: dataTable.LineNo, Message: "Data table should have at least 1 data row"})
 	}
-	if len(specification.Scenarios) == 0 {
-		return ParseError{FileName: specification.FileName, LineNo: specification.Heading.LineNo, SpanEnd: specification.Heading.SpanEnd, Message: "Spec should have atleast one scenario"}
+	// A missing heading already rejected every scenario; reporting the lack
+	// of scenarios on top of it would only restate that error.
+	if headingPresent && len(specification.Scenarios) == 0 {
+		errs = append(errs, ParseError{FileName: specification.FileName, LineNo: specification.Heading.LineNo, SpanEnd: specification.Heading.SpanEnd, Message: "Spec should have atleast one scenario"})
 	}
 	for _, sce := range specification.Scenarios {
 		if len(sce.Steps) == 0 {
-			return ParseError{FileName: specification.FileName, LineNo: sce.Heading.LineNo, SpanEnd: sce.Heading.SpanEnd, Message: "Scenario should have atleast one step"}
+			errs = append(errs, ParseError{FileName: specification.FileName, LineNo: sce.Heading.LineNo, SpanEnd: sce.Heading.SpanEnd, Message: "Scenario should have atleast one step"})
 		}
 	}
-	return nil
+	return errs
 }
 
 func createStep(spec *gauge.Specification, scn *gauge.Scenario, stepToken *Token) (*gauge.Step, *ParseResult) {
@@ -138,7 +147,7 @@ func createStep(spec *gauge.Specification, scn *gauge.Scenario, stepToken *Token
 func CreateStepUsingLookup(stepToken *Token, lookup *gauge.ArgLookup, specFileName string) (*gauge.Step, *ParseResult) {
 	stepValue, argsType := extractStepValueAndParameterTypes(stepToken.Value)
 	if argsType != nil && len(argsType) != len(stepToken.Args) {
-		return nil, &ParseResult{ParseErrors: []ParseError{ParseError{specFileName, stepToken.LineNo, stepToken.SpanEnd, "Step text should not have '{static}' or '{dynamic}' or '{special}'", stepToken.LineText()}}, Warnings: nil}
+		return nil, &ParseResult{ParseErrors: []ParseError{{FileName: specFileName, LineNo: stepToken.LineNo, SpanEnd: stepToken.SpanEnd, Message: "Step text should not have '{static}' or '{dynamic}' or '{special}'", LineText: stepToken.LineText()}}, Warnings: nil}
 	}
 	lineText := strings.Join(stepToken.Lines, " ")
 	step := &gauge.Step{FileName: specFileName, LineNo: stepToken.LineNo, Value: stepValue, LineText: strings.TrimSpace(lineText), LineSpanEnd: stepToken.SpanEnd}
@@ -1008,7 +1008,7 @@ Scenario Heading
 	c.Assert(err, IsNil)
 	c.Assert(len(res.ParseErrors), Equals, 2)
 	c.Assert(res.ParseErrors[0].Error(), Equals, "foo.spec:1 Spec heading should have at least one character => ''")
-	c.Assert(res.ParseErrors[1].Error(), Equals, "foo.spec:4 Dynamic parameter <a> could not be resolved => 'def <a>'")
+	c.Assert(res.ParseErrors[1].Error(), Equals, "foo.spec:4:5 Dynamic parameter <a> could not be resolved => 'def <a>'")
 }
 
 func (s *MySuite) TestSpecParsingWhenSpecHeadingIsNotPresent(c *C) {
@@ -7,12 +7,18 @@
 package parser
 
 import (
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"os"
+	"os/exec"
+	"sort"
 	"strings"
 
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
 )
 
 func convertCsvToTable(csvContents string) (*gauge.Table, error) {
@@ -36,3 +42,75 @@ func convertCsvToTable(csvContents string) (*gauge.Table, error) {
 	}
 	return table, nil
 }
+
+// resolveExternalTable materializes a data table from an external source:
+// a CSV file, a JSON file, or a SQL query from the env config (`table: db:<name>`).
+func resolveExternalTable(source string) (*gauge.Table, error) {
+	if strings.HasPrefix(source, "db:") {
+		return convertDBQueryToTable(strings.TrimSpace(strings.TrimPrefix(source, "db:")))
+	}
+	contents, err := util.GetFileContents(source)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(strings.ToLower(source), ".json") {
+		return convertJSONToTable(contents)
+	}
+	return convertCsvToTable(contents)
+}
+
+// convertJSONToTable materializes a table from a JSON array of flat objects.
+// The first object defines the columns; every row must carry all of them.
+func convertJSONToTable(jsonContents string) (*gauge.Table, error) {
+	var rows []map[string]string
+	if err := json.Unmarshal([]byte(jsonContents), &rows); err != nil {
+		return nil, fmt.Errorf("expected a JSON array of objects with string values: %s", err.Error())
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("JSON table has no rows")
+	}
+	headers := make([]string, 0, len(rows[0]))
+	for header := range rows[0] {
+		headers = append(headers, header)
+	}
+	sort.Strings(headers)
+	table := new(gauge.Table)
+	table.AddHeaders(headers)
+	for i, row := range rows {
+		values := make([]string, 0, len(headers))
+		for _, header := range headers {
+			value, ok := row[header]
+			if !ok {
+				return nil, fmt.Errorf("row %d is missing column '%s'", i+1, header)
+			}
+			values = append(values, value)
+		}
+		table.AddRowValues(table.CreateTableCells(values))
+	}
+	return table, nil
+}
+
+// convertDBQueryToTable materializes a table from a SQL query defined in the
+// env config. The query named `db:<name>` is read from the property <name> and
+// piped to the command configured via gauge_db_command, which is expected to
+// print the result set as CSV.
+func convertDBQueryToTable(queryName string) (*gauge.Table, error) {
+	query := os.Getenv(queryName)
+	if query == "" {
+		return nil, fmt.Errorf("no query found for '%s' in the env config", queryName)
+	}
+	dbCommand := os.Getenv(env.GaugeDBCommand)
+	if dbCommand == "" {
+		return nil, fmt.Errorf("%s is not configured, cannot materialize query '%s'", env.GaugeDBCommand, queryName)
+	}
+	parts := strings.Fields(dbCommand)
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Stdin = strings.NewReader(query)
+	var out, stderr bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("query '%s' failed: %s %s", queryName, err.Error(), strings.TrimSpace(stderr.String()))
+	}
+	return convertCsvToTable(out.String())
+}
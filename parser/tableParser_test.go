@@ -23,3 +23,30 @@ func (s *MySuite) TestIgnoreCommentLines(c *C) {
 	c.Assert(table.Rows()[0][1], Equals, "bar")
 	c.Assert(table.Rows()[0][2], Equals, "baz")
 }
+
+func (s *MySuite) TestConvertJsonToTable(c *C) {
+	jsonContents := `[{"id": "1", "name": "foo"}, {"id": "2", "name": "bar"}]`
+
+	table, err := convertJSONToTable(jsonContents)
+
+	c.Assert(err, Equals, nil)
+	c.Assert(len(table.Headers), Equals, 2)
+	c.Assert(table.Headers[0], Equals, "id")
+	c.Assert(table.Headers[1], Equals, "name")
+	c.Assert(table.GetRowCount(), Equals, 2)
+}
+
+func (s *MySuite) TestConvertJsonToTableWithMissingColumn(c *C) {
+	jsonContents := `[{"id": "1", "name": "foo"}, {"id": "2"}]`
+
+	_, err := convertJSONToTable(jsonContents)
+
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "row 2 is missing column 'name'")
+}
+
+func (s *MySuite) TestConvertJsonToTableWithInvalidJson(c *C) {
+	_, err := convertJSONToTable(`{"not": "an array"}`)
+
+	c.Assert(err, NotNil)
+}
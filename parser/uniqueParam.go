@@ -0,0 +1,29 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// `<unique:label>` parameters resolve to identifiers that are guaranteed unique
+// across the whole run, including parallel streams: values are allocated from a
+// single atomic counter in the gauge process, combined with a per-run nonce so
+// successive runs do not collide either. Useful for creating uniquely-named
+// entities without coordination between streams.
+var uniqueParamCounter int64
+
+var uniqueRunNonce = time.Now().Unix()
+
+func nextUniqueValue(label string) string {
+	if label == "" {
+		label = "gauge"
+	}
+	return fmt.Sprintf("%s-%d-%d", label, uniqueRunNonce, atomic.AddInt64(&uniqueParamCounter, 1))
+}
@@ -0,0 +1,47 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"strings"
+	"sync"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestNextUniqueValueCarriesLabel(c *C) {
+	value := nextUniqueValue("user")
+
+	c.Assert(strings.HasPrefix(value, "user-"), Equals, true)
+}
+
+func (s *MySuite) TestNextUniqueValueDefaultsLabel(c *C) {
+	value := nextUniqueValue("")
+
+	c.Assert(strings.HasPrefix(value, "gauge-"), Equals, true)
+}
+
+func (s *MySuite) TestNextUniqueValuesAreUniqueAcrossGoroutines(c *C) {
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	values := make(map[string]bool)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				v := nextUniqueValue("entity")
+				mutex.Lock()
+				values[v] = true
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	c.Assert(len(values), Equals, 1000)
+}
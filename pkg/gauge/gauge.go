@@ -0,0 +1,264 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package gauge is the public API for embedding gauge in other Go programs.
+// It wraps the internal packages behind a small, stable surface: load a
+// project, validate it, and run its specs getting typed results and a stream
+// of execution events — instead of shelling out to the gauge CLI and
+// scraping console output.
+//
+// The internal packages still coordinate through process-wide state, so at
+// most one Run may be in flight per process; runs of different projects must
+// be sequential.
+package gauge
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/execution"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/project"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
+)
+
+// eventBufferSize bounds the Events channel. Events beyond it are dropped
+// rather than stalling the suite when the consumer does not keep up.
+const eventBufferSize = 1024
+
+// Project is one gauge project. It is created by LoadProject and passed to
+// Validate and NewExecutor.
+type Project = project.Project
+
+// LoadProject resolves the given directory to a gauge project. The directory
+// must hold a gauge manifest. Without environments the default environment
+// is used.
+func LoadProject(root string, environments ...string) (*Project, error) {
+	return project.New(root, environments...)
+}
+
+// ValidationReport is the outcome of validating a project's specs.
+type ValidationReport struct {
+	// ParseOk is false when the specs could not even be parsed.
+	ParseOk bool
+	// Errors lists parse and validation errors, empty when the project is valid.
+	Errors []string
+}
+
+// Ok reports whether the project parsed and validated without errors.
+func (r *ValidationReport) Ok() bool {
+	return r.ParseOk && len(r.Errors) == 0
+}
+
+// Validate parses the project's specs and validates the steps against the
+// project's language runner.
+func Validate(p *Project, specDirs []string) (*ValidationReport, error) {
+	res, err := validation.ValidateSpecsInProject(p, specDirs, false)
+	if err != nil {
+		return nil, err
+	}
+	if res.Runner != nil {
+		if err := res.Runner.Kill(); err != nil {
+			return nil, err
+		}
+	}
+	report := &ValidationReport{ParseOk: res.ParseOk}
+	for _, e := range res.Errs {
+		report.Errors = append(report.Errors, e.Error())
+	}
+	return report, nil
+}
+
+// Event is one execution lifecycle notification, delivered on the channel
+// returned by Executor.Events.
+type Event struct {
+	// Topic is the lifecycle phase, e.g. "suiteStart", "scenarioEnd".
+	Topic string
+	// Stream is the parallel execution stream the event belongs to.
+	Stream int
+	// Failed reports whether the item failed; meaningful on *End topics.
+	Failed bool
+}
+
+var topicNames = map[event.Topic]string{
+	event.SuiteStart:    "suiteStart",
+	event.SpecStart:     "specStart",
+	event.ScenarioStart: "scenarioStart",
+	event.ConceptStart:  "conceptStart",
+	event.StepStart:     "stepStart",
+	event.StepEnd:       "stepEnd",
+	event.ConceptEnd:    "conceptEnd",
+	event.ScenarioEnd:   "scenarioEnd",
+	event.SpecEnd:       "specEnd",
+	event.SuiteEnd:      "suiteEnd",
+}
+
+// Result is the outcome of a suite run.
+type Result struct {
+	// ExitCode is what the gauge CLI would have exited with.
+	ExitCode int
+	// Failed reports whether the suite failed.
+	Failed bool
+	// SpecsFailed and SpecsSkipped count the specs that failed or were skipped.
+	SpecsFailed  int
+	SpecsSkipped int
+	// ExecutionTime is the time the suite took.
+	ExecutionTime time.Duration
+	// Environment names the environments the suite ran with.
+	Environment string
+}
+
+// Executor runs the specs of one project.
+type Executor struct {
+	project  *Project
+	specDirs []string
+	parallel bool
+	streams  int
+	tags     string
+	verbose  bool
+
+	events   chan Event
+	suiteRes *result.SuiteResult
+}
+
+// Option configures an Executor.
+type Option func(e *Executor)
+
+// WithParallel executes specs in parallel on the given number of streams; a
+// non-positive count uses the number of CPU cores.
+func WithParallel(streams int) Option {
+	return func(e *Executor) {
+		e.parallel = true
+		e.streams = streams
+	}
+}
+
+// WithTags restricts the run to scenarios matching the given tag expression.
+func WithTags(expression string) Option {
+	return func(e *Executor) { e.tags = expression }
+}
+
+// WithVerbose reports each step instead of one line per scenario.
+func WithVerbose(verbose bool) Option {
+	return func(e *Executor) { e.verbose = verbose }
+}
+
+// NewExecutor creates an Executor for the project's specs in the given
+// directories; without directories the project's default specs directory is
+// used.
+func NewExecutor(p *Project, specDirs []string, opts ...Option) *Executor {
+	if len(specDirs) == 0 {
+		specDirs = []string{common.SpecsDirectoryName}
+	}
+	e := &Executor{project: p, specDirs: specDirs}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Events returns the channel execution events are delivered on. Call it
+// before Run; the channel is closed when the run finishes. Events are
+// dropped when the consumer does not keep up, so a slow embedder cannot
+// stall the suite.
+func (e *Executor) Events() <-chan Event {
+	if e.events == nil {
+		e.events = make(chan Event, eventBufferSize)
+	}
+	return e.events
+}
+
+// Run activates the project and executes its specs, blocking until the
+// suite finishes. A context that is already cancelled prevents the run from
+// starting; cancellation is not propagated into a run already in flight.
+func (e *Executor) Run(ctx context.Context) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := e.project.Activate(); err != nil {
+		return nil, err
+	}
+	e.setExecutionFlags()
+	previous := execution.CustomListeners
+	execution.CustomListeners = append(previous[:len(previous):len(previous)], e.listen)
+	defer func() { execution.CustomListeners = previous }()
+
+	exitCode := execution.ExecuteSpecs(e.specDirs)
+	if e.events != nil {
+		close(e.events)
+	}
+	res := &Result{ExitCode: exitCode, Failed: exitCode != execution.Success}
+	if e.suiteRes != nil {
+		res.SpecsFailed = e.suiteRes.SpecsFailedCount
+		res.SpecsSkipped = e.suiteRes.SpecsSkippedCount
+		res.ExecutionTime = time.Millisecond * time.Duration(e.suiteRes.ExecutionTime)
+		res.Environment = e.suiteRes.Environment
+	}
+	return res, nil
+}
+
+// setExecutionFlags bridges the Executor's options onto the package-level
+// globals the execution and filter packages still read, filling in the
+// defaults the CLI flags would otherwise provide.
+func (e *Executor) setExecutionFlags() {
+	execution.InParallel = e.parallel
+	execution.ExecuteTags = e.tags
+	filter.ExecuteTags = e.tags
+	execution.Verbose = e.verbose
+	if e.parallel {
+		if e.streams < 1 {
+			e.streams = util.NumberOfCores()
+		}
+		execution.NumberOfExecutionStreams = e.streams
+		filter.NumberOfExecutionStreams = e.streams
+	}
+	if execution.Strategy == "" {
+		execution.Strategy = execution.Lazy
+	}
+	if execution.MaxRetriesCount < 1 {
+		execution.MaxRetriesCount = 1
+	}
+}
+
+// listen subscribes to the run's event registry, forwarding events to the
+// embedder and capturing the suite result. It follows the pattern of the
+// Listen* functions in the execution package.
+func (e *Executor) listen(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteStart, event.SpecStart, event.ScenarioStart, event.ConceptStart,
+		event.StepStart, event.StepEnd, event.ConceptEnd, event.ScenarioEnd, event.SpecEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			ev := <-ch
+			e.forward(ev)
+			if ev.Topic == event.SuiteEnd {
+				e.suiteRes = ev.Result.(*result.SuiteResult)
+				wg.Done()
+			}
+		}
+	}()
+}
+
+func (e *Executor) forward(ev event.ExecutionEvent) {
+	if e.events == nil {
+		return
+	}
+	forwarded := Event{Topic: topicNames[ev.Topic], Stream: ev.Stream}
+	if ev.Result != nil {
+		forwarded.Failed = ev.Result.GetFailed()
+	}
+	select {
+	case e.events <- forwarded:
+	default:
+	}
+}
@@ -0,0 +1,103 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/execution/event"
+)
+
+func gaugeProject(t *testing.T) *Project {
+	dir, err := ioutil.TempDir("", "gauge_pkg_")
+	if err != nil {
+		t.Fatalf("Unable to create temp dir: %s", err.Error())
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	if err := ioutil.WriteFile(filepath.Join(dir, common.ManifestFile), []byte("{}"), 0600); err != nil {
+		t.Fatalf("Unable to write manifest: %s", err.Error())
+	}
+	p, err := LoadProject(dir)
+	if err != nil {
+		t.Fatalf("LoadProject failed: %s", err.Error())
+	}
+	return p
+}
+
+func TestLoadProjectRejectsDirectoryWithoutManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "not_a_project_")
+	if err != nil {
+		t.Fatalf("Unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := LoadProject(dir); err == nil {
+		t.Errorf("Expected an error for a directory without a manifest")
+	}
+}
+
+func TestNewExecutorDefaultsToTheSpecsDirectory(t *testing.T) {
+	e := NewExecutor(gaugeProject(t), nil)
+
+	if len(e.specDirs) != 1 || e.specDirs[0] != common.SpecsDirectoryName {
+		t.Errorf("specDirs = %v, want the default specs directory", e.specDirs)
+	}
+}
+
+func TestOptionsConfigureTheExecutor(t *testing.T) {
+	e := NewExecutor(gaugeProject(t), []string{"specs"}, WithParallel(4), WithTags("!flaky"), WithVerbose(true))
+
+	if !e.parallel || e.streams != 4 {
+		t.Errorf("parallel = %v, streams = %d, want a parallel run on 4 streams", e.parallel, e.streams)
+	}
+	if e.tags != "!flaky" {
+		t.Errorf("tags = %s, want !flaky", e.tags)
+	}
+	if !e.verbose {
+		t.Errorf("Expected a verbose run")
+	}
+}
+
+func TestRunDoesNotStartWithACancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := NewExecutor(gaugeProject(t), nil).Run(ctx); err != context.Canceled {
+		t.Errorf("Run error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestValidationReportIsOkOnlyWithoutErrors(t *testing.T) {
+	if ok := (&ValidationReport{ParseOk: true}).Ok(); !ok {
+		t.Errorf("Expected a report without errors to be ok")
+	}
+	if ok := (&ValidationReport{ParseOk: true, Errors: []string{"unimplemented step"}}).Ok(); ok {
+		t.Errorf("Expected a report with errors to not be ok")
+	}
+	if ok := (&ValidationReport{ParseOk: false}).Ok(); ok {
+		t.Errorf("Expected a report with parse failures to not be ok")
+	}
+}
+
+func TestForwardDropsEventsWhenTheConsumerLags(t *testing.T) {
+	e := &Executor{events: make(chan Event, 1)}
+
+	e.forward(event.ExecutionEvent{Topic: event.ScenarioStart, Stream: 1})
+	e.forward(event.ExecutionEvent{Topic: event.ScenarioEnd, Stream: 1})
+
+	if len(e.events) != 1 {
+		t.Fatalf("Expected the second event to be dropped, got %d buffered events", len(e.events))
+	}
+	if got := <-e.events; got.Topic != "scenarioStart" {
+		t.Errorf("Topic = %s, want scenarioStart", got.Topic)
+	}
+}
@@ -32,6 +32,12 @@ type PluginDescriptor struct {
 	GaugeVersionSupport version.VersionSupport
 	pluginPath          string
 	Capabilities        []string
+	// Limits constrains the resources of the plugin process. Zero values
+	// leave the corresponding resource unlimited.
+	Limits struct {
+		CPUSeconds int
+		MemoryMB   int
+	}
 }
 
 func (pd *PluginDescriptor) hasScope(scope pluginScope) bool {
@@ -8,69 +8,158 @@ package plugin
 
 import (
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
 )
 
+// pluginQueueSize bounds the number of notifications buffered per plugin.
+// When a plugin falls this far behind, NotifyPlugins blocks until it catches
+// up, applying back-pressure instead of growing the queue without limit.
+const pluginQueueSize = 128
+
 // Handler manages plugins listed in project manifest.
 type Handler interface {
 	NotifyPlugins(*gauge_messages.Message)
 	GracefullyKillPlugins()
 }
 
+// messageListener is where a dispatcher delivers notifications: an
+// out-of-process plugin over its connection, or an in-process Go plugin.
+type messageListener interface {
+	listenerName() string
+	sendNotification(m *gauge_messages.Message, timeout time.Duration) error
+	// forceKill stops the listener after a failed notification.
+	forceKill()
+	// gracefulKill stops the listener at the end of execution and must call
+	// wg.Done once the listener has stopped.
+	gracefulKill(wg *sync.WaitGroup) error
+}
+
 // GaugePlugins holds a reference to all plugins launched. The plugins are listed in project manifest
 type GaugePlugins struct {
-	pluginsMap map[string]*plugin
+	mutex      sync.Mutex
+	pluginsMap map[string]*pluginDispatcher
+}
+
+// pluginDispatcher delivers notifications to a single plugin on its own
+// goroutine, so one slow plugin does not stall execution or other plugins.
+// Messages are delivered in the order they were enqueued.
+type pluginDispatcher struct {
+	listener messageListener
+	queue    chan *gauge_messages.Message
+	wg       sync.WaitGroup
+	dead     int32
+	// back-pressure metrics: how often and for how long NotifyPlugins
+	// blocked because this plugin's queue was full.
+	stalls       int64
+	stalledNanos int64
 }
 
 func (gp *GaugePlugins) addPlugin(pluginID string, pluginToAdd *plugin) {
+	gp.addListener(pluginID, pluginToAdd)
+}
+
+func (gp *GaugePlugins) addListener(id string, listener messageListener) {
+	gp.mutex.Lock()
+	defer gp.mutex.Unlock()
 	if gp.pluginsMap == nil {
-		gp.pluginsMap = make(map[string]*plugin)
+		gp.pluginsMap = make(map[string]*pluginDispatcher)
 	}
-	gp.pluginsMap[pluginID] = pluginToAdd
+	d := &pluginDispatcher{listener: listener, queue: make(chan *gauge_messages.Message, pluginQueueSize)}
+	d.wg.Add(1)
+	go d.dispatch(gp, id)
+	gp.pluginsMap[id] = d
 }
 
-func (gp *GaugePlugins) removePlugin(pluginID string) {
-	delete(gp.pluginsMap, pluginID)
+// NotifyPlugins passes a message to all plugins listed in the manifest.
+// Dispatch is asynchronous per plugin; this only blocks when a plugin's
+// queue is full.
+func (gp *GaugePlugins) NotifyPlugins(message *gauge_messages.Message) {
+	for _, d := range gp.dispatchers() {
+		d.enqueue(message)
+	}
 }
 
-// NotifyPlugins passes a message to all plugins listed in the manifest
-func (gp *GaugePlugins) NotifyPlugins(message *gauge_messages.Message) {
-	var handle = func(id string, p *plugin, err error) {
-		if err != nil {
-			logger.Errorf(true, "Unable to connect to plugin %s %s. %s\n", p.descriptor.Name, p.descriptor.Version, err.Error())
-			gp.killPlugin(id)
-		}
+func (gp *GaugePlugins) dispatchers() []*pluginDispatcher {
+	gp.mutex.Lock()
+	defer gp.mutex.Unlock()
+	dispatchers := make([]*pluginDispatcher, 0, len(gp.pluginsMap))
+	for _, d := range gp.pluginsMap {
+		dispatchers = append(dispatchers, d)
 	}
+	return dispatchers
+}
 
-	for id, plugin := range gp.pluginsMap {
-		handle(id, plugin, plugin.sendMessage(message))
+func (d *pluginDispatcher) enqueue(message *gauge_messages.Message) {
+	if atomic.LoadInt32(&d.dead) == 1 {
+		return
+	}
+	select {
+	case d.queue <- message:
+	default:
+		start := time.Now()
+		d.queue <- message
+		atomic.AddInt64(&d.stalls, 1)
+		atomic.AddInt64(&d.stalledNanos, int64(time.Since(start)))
 	}
 }
 
-func (gp *GaugePlugins) killPlugin(pluginID string) {
-	plugin := gp.pluginsMap[pluginID]
-	logger.Debugf(true, "Killing Plugin %s %s\n", plugin.descriptor.Name, plugin.descriptor.Version)
-	err := plugin.pluginCmd.Process.Kill()
-	if err != nil {
-		logger.Errorf(true, "Failed to kill plugin %s %s. %s\n", plugin.descriptor.Name, plugin.descriptor.Version, err.Error())
+func (d *pluginDispatcher) dispatch(gp *GaugePlugins, id string) {
+	defer d.wg.Done()
+	timeout := config.PluginNotificationTimeout()
+	for message := range d.queue {
+		if atomic.LoadInt32(&d.dead) == 1 {
+			continue
+		}
+		if err := d.listener.sendNotification(message, timeout); err != nil {
+			logger.Errorf(true, "Unable to notify plugin %s. %s\n", d.listener.listenerName(), err.Error())
+			atomic.StoreInt32(&d.dead, 1)
+			gp.killPlugin(id)
+		}
 	}
-	gp.removePlugin(pluginID)
 }
 
-// GracefullyKillPlugins tells the plugins to stop, letting them cleanup whatever they need to
+func (gp *GaugePlugins) killPlugin(id string) {
+	gp.mutex.Lock()
+	defer gp.mutex.Unlock()
+	d, ok := gp.pluginsMap[id]
+	if !ok {
+		return
+	}
+	atomic.StoreInt32(&d.dead, 1)
+	logger.Debugf(true, "Killing Plugin %s\n", d.listener.listenerName())
+	d.listener.forceKill()
+}
+
+// GracefullyKillPlugins drains every plugin's notification queue, then tells
+// the plugins to stop, letting them cleanup whatever they need to
 func (gp *GaugePlugins) GracefullyKillPlugins() {
+	dispatchers := gp.dispatchers()
+	for _, d := range dispatchers {
+		close(d.queue)
+		d.wg.Wait()
+		if stalls := atomic.LoadInt64(&d.stalls); stalls > 0 {
+			stalled := time.Duration(atomic.LoadInt64(&d.stalledNanos))
+			logger.Debugf(true, "Plugin %s applied back-pressure %d times, blocking execution for %s in total.", d.listener.listenerName(), stalls, stalled)
+		}
+	}
 	var wg sync.WaitGroup
-	for _, pl := range gp.pluginsMap {
+	for _, d := range dispatchers {
+		if atomic.LoadInt32(&d.dead) == 1 {
+			continue
+		}
 		wg.Add(1)
-		logger.Debugf(true, "Sending kill message to %s plugin.", pl.descriptor.Name)
-		go func(p *plugin) {
-			err := p.kill(&wg)
+		logger.Debugf(true, "Sending kill message to %s plugin.", d.listener.listenerName())
+		go func(l messageListener) {
+			err := l.gracefulKill(&wg)
 			if err != nil {
-				logger.Errorf(false, "Unable to kill plugin %s : %s", p.descriptor.Name, err.Error())
+				logger.Errorf(false, "Unable to kill plugin %s : %s", l.listenerName(), err.Error())
 			}
-		}(pl)
+		}(d.listener)
 	}
 	wg.Wait()
 }
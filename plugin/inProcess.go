@@ -0,0 +1,84 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package plugin
+
+import (
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// InProcessPlugin is a plugin written in Go and loaded in the gauge process
+// itself, avoiding the protobuf-over-TCP overhead of out-of-process plugins.
+// It receives the same notification messages an execution scope plugin gets.
+// Register implementations with RegisterInProcessPlugin before execution
+// starts; each registered plugin gets its own dispatch queue, so ordering is
+// guaranteed per plugin.
+type InProcessPlugin interface {
+	// Name identifies the plugin in logs.
+	Name() string
+	// Notify handles an execution notification. An error marks the plugin
+	// dead; it receives no further notifications.
+	Notify(m *gauge_messages.Message) error
+	// Stop is called once at the end of execution, after all queued
+	// notifications are delivered.
+	Stop()
+}
+
+var inProcessMutex sync.Mutex
+var inProcessPlugins []InProcessPlugin
+
+// RegisterInProcessPlugin adds a plugin to be notified in-process during
+// execution, alongside the plugins listed in the project manifest.
+func RegisterInProcessPlugin(p InProcessPlugin) {
+	inProcessMutex.Lock()
+	defer inProcessMutex.Unlock()
+	inProcessPlugins = append(inProcessPlugins, p)
+}
+
+func registeredInProcessPlugins() []InProcessPlugin {
+	inProcessMutex.Lock()
+	defer inProcessMutex.Unlock()
+	return append([]InProcessPlugin{}, inProcessPlugins...)
+}
+
+// inProcessListener adapts an InProcessPlugin to the message listener
+// contract the plugin handler dispatches to.
+type inProcessListener struct {
+	p InProcessPlugin
+}
+
+func (l *inProcessListener) listenerName() string {
+	return l.p.Name()
+}
+
+func (l *inProcessListener) sendNotification(m *gauge_messages.Message, timeout time.Duration) error {
+	if timeout <= 0 {
+		return l.p.Notify(m)
+	}
+	errChan := make(chan error, 1)
+	go func() { errChan <- l.p.Notify(m) }()
+	select {
+	case err := <-errChan:
+		return err
+	case <-time.After(timeout):
+		logger.Warningf(true, "Plugin %s did not handle %s within %s. Skipping this notification.", l.p.Name(), m.GetMessageType(), timeout)
+		return nil
+	}
+}
+
+func (l *inProcessListener) forceKill() {
+	l.p.Stop()
+}
+
+func (l *inProcessListener) gracefulKill(wg *sync.WaitGroup) error {
+	defer wg.Done()
+	l.p.Stop()
+	return nil
+}
@@ -0,0 +1,88 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package plugin
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+type fakeInProcessPlugin struct {
+	mutex    sync.Mutex
+	messages []*gauge_messages.Message
+	stopped  bool
+	err      error
+}
+
+func (p *fakeInProcessPlugin) Name() string { return "fake" }
+
+func (p *fakeInProcessPlugin) Notify(m *gauge_messages.Message) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.messages = append(p.messages, m)
+	return p.err
+}
+
+func (p *fakeInProcessPlugin) Stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.stopped = true
+}
+
+func TestInProcessPluginReceivesNotificationsInOrder(t *testing.T) {
+	p := &fakeInProcessPlugin{}
+	handler := &GaugePlugins{}
+	handler.addListener("in-process-0-fake", &inProcessListener{p: p})
+
+	first := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecutionStarting}
+	second := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecutionEnding}
+	handler.NotifyPlugins(first)
+	handler.NotifyPlugins(second)
+	handler.GracefullyKillPlugins()
+
+	if len(p.messages) != 2 || p.messages[0] != first || p.messages[1] != second {
+		t.Errorf("expected notifications in order, got %v", p.messages)
+	}
+	if !p.stopped {
+		t.Error("expected plugin to be stopped after GracefullyKillPlugins")
+	}
+}
+
+func TestFailingInProcessPluginIsMarkedDead(t *testing.T) {
+	p := &fakeInProcessPlugin{err: fmt.Errorf("listener failed")}
+	handler := &GaugePlugins{}
+	handler.addListener("in-process-0-fake", &inProcessListener{p: p})
+
+	handler.NotifyPlugins(&gauge_messages.Message{MessageType: gauge_messages.Message_ExecutionStarting})
+	handler.GracefullyKillPlugins()
+
+	if len(p.messages) != 1 {
+		t.Errorf("expected a single notification, got %d", len(p.messages))
+	}
+	if !p.stopped {
+		t.Error("expected failing plugin to be stopped")
+	}
+}
+
+func TestRegisterInProcessPlugin(t *testing.T) {
+	defer func() {
+		inProcessMutex.Lock()
+		inProcessPlugins = nil
+		inProcessMutex.Unlock()
+	}()
+	p := &fakeInProcessPlugin{}
+
+	RegisterInProcessPlugin(p)
+
+	registered := registeredInProcessPlugins()
+	if len(registered) != 1 || registered[0] != InProcessPlugin(p) {
+		t.Errorf("expected the registered plugin, got %v", registered)
+	}
+}
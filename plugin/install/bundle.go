@@ -0,0 +1,184 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/version"
+)
+
+const bundleManifestFile = "bundle.json"
+
+type bundleEntry struct {
+	ID      string
+	Version string
+}
+
+type bundleManifest struct {
+	GaugeVersion string
+	Plugins      []bundleEntry
+}
+
+// ExportPlugins writes the project's plugin set - the language runner and
+// every plugin in the project manifest, at the installed versions - into a
+// single zip bundle, so the exact same set can be installed on an air-gapped
+// machine with `gauge install --from`.
+func ExportPlugins(bundleFile string) error {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return err
+	}
+	ids := append([]string{m.Language}, m.Plugins...)
+	f, err := os.Create(bundleFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	defer w.Close()
+
+	bundle := bundleManifest{GaugeVersion: version.CurrentGaugeVersion.String()}
+	for _, id := range ids {
+		pluginDir, err := plugin.GetInstallDir(id, "")
+		if err != nil {
+			return fmt.Errorf("unable to export plugin %s: %s", id, err.Error())
+		}
+		pluginVersion := filepath.Base(pluginDir)
+		logger.Infof(true, "Exporting %s %s", id, pluginVersion)
+		if err := addDirToZip(w, pluginDir, fmt.Sprintf("%s/%s", id, pluginVersion)); err != nil {
+			return err
+		}
+		bundle.Plugins = append(bundle.Plugins, bundleEntry{ID: id, Version: pluginVersion})
+	}
+	contents, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	entry, err := w.Create(bundleManifestFile)
+	if err != nil {
+		return err
+	}
+	if _, err = entry.Write(contents); err != nil {
+		return err
+	}
+	logger.Infof(true, "Exported %d plugin(s) to %s", len(bundle.Plugins), bundleFile)
+	return nil
+}
+
+// InstallPluginsFromBundle installs every plugin recorded in a bundle created
+// by `gauge export-plugins`. The bundle can be the zip file itself or a
+// directory it was extracted to. Already installed versions are skipped.
+func InstallPluginsFromBundle(from string) error {
+	bundleDir := from
+	if !common.DirExists(from) {
+		tempDir := common.GetTempDir()
+		defer func() {
+			if err := common.Remove(tempDir); err != nil {
+				logger.Errorf(false, "unable to remove temp directory: %s", err.Error())
+			}
+		}()
+		unzipped, err := common.UnzipArchive(from, tempDir)
+		if err != nil {
+			return err
+		}
+		bundleDir = unzipped
+	}
+	bundle, err := readBundleManifest(bundleDir)
+	if err != nil {
+		return err
+	}
+	var failed []string
+	for _, entry := range bundle.Plugins {
+		if err := installBundledPlugin(bundleDir, entry); err != nil {
+			logger.Errorf(true, "Failed to install %s %s. %s", entry.ID, entry.Version, err.Error())
+			failed = append(failed, entry.ID)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to install plugin(s): %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func readBundleManifest(bundleDir string) (*bundleManifest, error) {
+	contents, err := common.ReadFileContents(filepath.Join(bundleDir, bundleManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("not a valid plugin bundle, missing %s. %s", bundleManifestFile, err.Error())
+	}
+	bundle := &bundleManifest{}
+	if err = json.Unmarshal([]byte(contents), bundle); err != nil {
+		return nil, fmt.Errorf("not a valid plugin bundle. %s", err.Error())
+	}
+	return bundle, nil
+}
+
+func installBundledPlugin(bundleDir string, entry bundleEntry) error {
+	if common.IsPluginInstalled(entry.ID, entry.Version) {
+		logger.Infof(true, "Plugin %s %s is already installed.", entry.ID, entry.Version)
+		return nil
+	}
+	pluginDir := filepath.Join(bundleDir, entry.ID, entry.Version)
+	gp, err := parsePluginJSON(pluginDir, entry.ID)
+	if err != nil {
+		return err
+	}
+	if err = runPlatformCommands(gp.PreInstall, pluginDir); err != nil {
+		return err
+	}
+	if err = runPlatformCommands(gp.PostInstall, pluginDir); err != nil {
+		return err
+	}
+	installDir, err := getPluginInstallDir(entry.ID, entry.Version)
+	if err != nil {
+		return err
+	}
+	logger.Infof(true, "Installing plugin %s %s", entry.ID, entry.Version)
+	_, err = common.MirrorDir(pluginDir, installDir)
+	return err
+}
+
+// addDirToZip writes the contents of dir into the zip under the given prefix,
+// using forward slashes as entry separators.
+func addDirToZip(w *zip.Writer, dir, prefix string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = prefix + "/" + filepath.ToSlash(relPath)
+		header.Method = zip.Deflate
+		entry, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(entry, f)
+		return err
+	})
+}
@@ -8,7 +8,6 @@ package install
 
 import (
 	"fmt"
-	"net/http"
 	"regexp"
 	"runtime/debug"
 	"strings"
@@ -16,6 +15,7 @@ import (
 
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin/pluginInfo"
+	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/version"
 )
 
@@ -42,8 +42,9 @@ func (u *UpdateFacade) PrintUpdateBuffer() {
 func PrintUpdateInfoWithDetails() {
 	updates := checkUpdates()
 	if len(updates) > 0 {
+		logger.Infof(true, fmt.Sprintf("%-10s\t\t%-10s\t%-10s\t%s", "Name", "Installed", "Latest", ""))
 		for _, update := range updates {
-			logger.Infof(true, fmt.Sprintf("%-10s\t\t%-10s\t%s", update.Name, update.CompatibleVersion, update.Message))
+			logger.Infof(true, fmt.Sprintf("%-10s\t\t%-10s\t%-10s\t%s", update.Name, update.CurrentVersion, update.CompatibleVersion, update.Message))
 		}
 	} else {
 		logger.Infof(true, "No Updates available.")
@@ -96,13 +97,14 @@ func checkGaugeUpdate() []UpdateInfo {
 	}
 	isLatestVersion := version.CurrentGaugeVersion.IsLesserThan(latestVersion)
 	if isLatestVersion {
-		updateInfos = append(updateInfos, UpdateInfo{"Gauge", latestVersion.String(), "Download the installer from https://gauge.org/get-started/"})
+		updateInfos = append(updateInfos, UpdateInfo{"Gauge", version.CurrentGaugeVersion.String(), latestVersion.String(), "Download the installer from https://gauge.org/get-started/"})
 	}
 	return updateInfos
 }
 
 type UpdateInfo struct {
 	Name              string
+	CurrentVersion    string
 	CompatibleVersion string
 	Message           string
 }
@@ -139,13 +141,13 @@ func createPluginUpdateDetail(currentVersion string, latestVersionDetails instal
 		if err != nil {
 			return updateInfo
 		}
-		updateInfo = append(updateInfo, UpdateInfo{latestVersionDetails.Name, versionDesc.Version, fmt.Sprintf("Run 'gauge update %s'", latestVersionDetails.Name)})
+		updateInfo = append(updateInfo, UpdateInfo{latestVersionDetails.Name, currentVersion, versionDesc.Version, fmt.Sprintf("Run 'gauge update %s'", latestVersionDetails.Name)})
 	}
 	return updateInfo
 }
 
 var getLatestGaugeVersion = func(url string) (string, error) {
-	res, err := http.Get(url)
+	res, err := util.GetWithAuth(url)
 	if err != nil {
 		return "", err
 	}
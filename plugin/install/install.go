@@ -7,9 +7,12 @@
 package install
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
@@ -46,6 +49,15 @@ type versionInstallDescription struct {
 	GaugeVersionSupport version.VersionSupport
 	Install             platformSpecificCommand
 	DownloadUrls        downloadUrls
+	// Sha256 optionally holds the hex encoded sha256 checksum of the
+	// artifact behind the corresponding entry in DownloadUrls. Downloads
+	// are verified against it when declared.
+	Sha256 downloadUrls
+	// Signature optionally holds the url of a detached GPG signature for
+	// the artifact behind the corresponding entry in DownloadUrls.
+	// Downloads are verified against it when declared, and required when
+	// require_signed_plugins is enabled.
+	Signature downloadUrls
 }
 
 type downloadUrls struct {
@@ -272,6 +284,16 @@ func installPluginVersion(installDesc *installDescription, versionInstallDescrip
 	if err != nil {
 		return installError(fmt.Errorf("Failed to download the plugin. %s", err.Error()))
 	}
+	if checksum, _ := getDownloadLink(versionInstallDescription.Sha256); checksum != "" {
+		if err := verifyChecksum(pluginZip, checksum); err != nil {
+			return installError(fmt.Errorf("Failed to verify the downloaded plugin. %s", err.Error()))
+		}
+		logger.Debugf(true, "Verified checksum of %s", filepath.Base(pluginZip))
+	}
+	signatureURL, _ := getDownloadLink(versionInstallDescription.Signature)
+	if err := verifySignature(pluginZip, signatureURL, tempDir, silent); err != nil {
+		return installError(fmt.Errorf("Failed to verify the downloaded plugin. %s", err.Error()))
+	}
 	res := InstallPluginFromZipFile(pluginZip, installDesc.Name)
 	res.Version = versionInstallDescription.Version
 	return res
@@ -391,7 +413,7 @@ func getDownloadLink(downloadUrls downloadUrls) (string, error) {
 
 func getInstallDescription(plugin string, silent bool) (*installDescription, InstallResult) {
 	versionInstallDescriptionJSONFile := plugin + "-install.json"
-	versionInstallDescriptionJSONUrl, result := constructPluginInstallJSONURL(plugin)
+	versionInstallDescriptionJSONUrls, result := constructPluginInstallJSONURLs(plugin)
 	if !result.Success {
 		return nil, installError(fmt.Errorf("Could not construct plugin install json file URL. %s", result.Error))
 	}
@@ -403,13 +425,15 @@ func getInstallDescription(plugin string, silent bool) (*installDescription, Ins
 		}
 	}()
 
-	downloadedFile, downloadErr := util.Download(versionInstallDescriptionJSONUrl, tempDir, versionInstallDescriptionJSONFile, silent)
-	if downloadErr != nil {
-		logger.Debugf(true, "Failed to download %s file: %s", versionInstallDescriptionJSONFile, downloadErr)
-		return nil, installError(fmt.Errorf("Invalid plugin. Could not download %s file.", versionInstallDescriptionJSONFile))
+	for _, versionInstallDescriptionJSONUrl := range versionInstallDescriptionJSONUrls {
+		downloadedFile, downloadErr := util.Download(versionInstallDescriptionJSONUrl, tempDir, versionInstallDescriptionJSONFile, silent)
+		if downloadErr != nil {
+			logger.Debugf(true, "Failed to download %s file from %s: %s", versionInstallDescriptionJSONFile, versionInstallDescriptionJSONUrl, downloadErr)
+			continue
+		}
+		return getInstallDescriptionFromJSON(downloadedFile)
 	}
-
-	return getInstallDescriptionFromJSON(downloadedFile)
+	return nil, installError(fmt.Errorf("Invalid plugin. Could not download %s file.", versionInstallDescriptionJSONFile))
 }
 
 func getInstallDescriptionFromJSON(installJSON string) (*installDescription, InstallResult) {
@@ -424,16 +448,41 @@ func getInstallDescriptionFromJSON(installJSON string) (*installDescription, Ins
 	return installDescription, installSuccess("")
 }
 
-func constructPluginInstallJSONURL(p string) (string, InstallResult) {
-	repoURL := config.GaugeRepositoryUrl()
-	if repoURL == "" {
-		return "", installError(fmt.Errorf("Could not find gauge repository url from configuration."))
+// constructPluginInstallJSONURLs builds the install json URL for every
+// configured plugin repository, in the order the repositories are listed.
+func constructPluginInstallJSONURLs(p string) ([]string, InstallResult) {
+	repoURLs := config.PluginRepositoryUrls()
+	if len(repoURLs) == 0 {
+		return nil, installError(fmt.Errorf("Could not find gauge repository url from configuration."))
 	}
-	jsonURL := fmt.Sprintf("%s/%s", repoURL, p)
-	if qp := plugin.QueryParams(); qp != "" {
-		jsonURL += qp
+	var jsonURLs []string
+	for _, repoURL := range repoURLs {
+		jsonURL := fmt.Sprintf("%s/%s", strings.TrimSuffix(repoURL, "/"), p)
+		if qp := plugin.QueryParams(); qp != "" {
+			jsonURL += qp
+		}
+		jsonURLs = append(jsonURLs, jsonURL)
 	}
-	return jsonURL, installSuccess("")
+	return jsonURLs, installSuccess("")
+}
+
+// verifyChecksum compares the sha256 checksum of the given file against the
+// expected hex encoded value.
+func verifyChecksum(file, expected string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	actual := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(actual, strings.TrimSpace(expected)) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", filepath.Base(file), expected, actual)
+	}
+	return nil
 }
 
 func (installDesc *installDescription) getVersion(version string) (*versionInstallDescription, error) {
@@ -455,6 +504,27 @@ func (installDesc *installDescription) getLatestCompatibleVersionTo(currentVersi
 	return nil, fmt.Errorf("Compatible version to %s not found", currentVersion)
 }
 
+// getLatestCompatibleVersionSatisfying works like
+// getLatestCompatibleVersionTo but also requires the version to satisfy the
+// given manifest constraint, e.g. ">=4.0 <5".
+func (installDesc *installDescription) getLatestCompatibleVersionSatisfying(currentVersion *version.Version, constraintText string) (*versionInstallDescription, error) {
+	constraint, err := version.ParseConstraint(constraintText)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid constraint %q for plugin %s in manifest.json. %s", constraintText, installDesc.Name, err.Error())
+	}
+	installDesc.sortVersionInstallDescriptions()
+	for _, versionInstallDesc := range installDesc.Versions {
+		v, err := version.ParseVersion(versionInstallDesc.Version)
+		if err != nil || !constraint.Satisfies(v) {
+			continue
+		}
+		if err := version.CheckCompatibility(currentVersion, &versionInstallDesc.GaugeVersionSupport); err == nil {
+			return &versionInstallDesc, nil
+		}
+	}
+	return nil, fmt.Errorf("No version satisfying the constraint %q is compatible with gauge %s", constraintText, currentVersion.String())
+}
+
 func (installDesc *installDescription) sortVersionInstallDescriptions() {
 	sort.Sort(byDecreasingVersion(installDesc.Versions))
 }
@@ -499,17 +569,34 @@ func AllPlugins(silent, languageOnly bool) {
 	installPluginsFromManifest(manifest, silent, languageOnly)
 }
 
-// UpdatePlugins updates all the currently installed plugins to its latest version
-func UpdatePlugins(silent bool) {
+// UpdatePlugins updates all the currently installed plugins to the latest
+// version satisfying any constraint the project manifest declares for them.
+// With dryRun, it only reports what would be updated.
+func UpdatePlugins(silent, dryRun bool) {
 	var failedPlugin []string
 	pluginInfos, err := pluginInfo.GetPluginsInfo()
 	if err != nil {
 		logger.Infof(true, err.Error())
 		os.Exit(0)
 	}
+	constraints := manifestPluginConstraints()
 	for _, pluginInfo := range pluginInfos {
 		logger.Debugf(true, "Updating plugin '%s'", pluginInfo.Name)
-		passed := HandleUpdateResult(Plugin(pluginInfo.Name, "", silent), pluginInfo.Name, false)
+		versionDesc, err := resolveUpdateVersion(pluginInfo.Name, constraints[pluginInfo.Name])
+		if err != nil {
+			logger.Errorf(true, "Failed to update plugin '%s'.\nReason: %s", pluginInfo.Name, err.Error())
+			failedPlugin = append(failedPlugin, pluginInfo.Name)
+			continue
+		}
+		if dryRun {
+			if common.IsPluginInstalled(pluginInfo.Name, versionDesc.Version) {
+				logger.Infof(true, "Plugin '%s' is up to date at version %s.", pluginInfo.Name, versionDesc.Version)
+			} else {
+				logger.Infof(true, "Would update plugin '%s' to version %s.", pluginInfo.Name, versionDesc.Version)
+			}
+			continue
+		}
+		passed := HandleUpdateResult(Plugin(pluginInfo.Name, versionDesc.Version, silent), pluginInfo.Name, false)
 		if !passed {
 			failedPlugin = append(failedPlugin, pluginInfo.Name)
 		}
@@ -517,9 +604,37 @@ func UpdatePlugins(silent bool) {
 	if len(failedPlugin) > 0 {
 		logger.Fatalf(true, "Failed to update '%s' plugins.", strings.Join(failedPlugin, ", "))
 	}
+	if dryRun {
+		logger.Infof(true, "Dry run, no plugins were updated.")
+		return
+	}
 	logger.Infof(true, "Successfully updated all the plugins.")
 }
 
+// manifestPluginConstraints returns the version constraints the project
+// manifest declares per plugin, or nil when run outside a project.
+func manifestPluginConstraints() map[string]string {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return nil
+	}
+	return m.PluginConstraints
+}
+
+// resolveUpdateVersion picks the version an update should install: the
+// latest gauge-compatible version, narrowed to the manifest's constraint
+// when one is declared.
+func resolveUpdateVersion(pluginName, constraintText string) (*versionInstallDescription, error) {
+	installDescription, result := getInstallDescription(pluginName, true)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if constraintText == "" {
+		return installDescription.getLatestCompatibleVersionTo(version.CurrentGaugeVersion)
+	}
+	return installDescription.getLatestCompatibleVersionSatisfying(version.CurrentGaugeVersion, constraintText)
+}
+
 // HandleInstallResult handles the result of plugin Installation
 // TODO: Merge both HandleInstallResult and HandleUpdateResult, eliminate boolean exitIfFailure
 func HandleInstallResult(result InstallResult, pluginName string, exitIfFailure bool) bool {
@@ -591,6 +706,13 @@ func installPluginsFromManifest(manifest *manifest.Manifest, silent, languageOnl
 			logger.Debugf(true, "Plugin %s is already installed.", pluginName)
 		}
 	}
+	if !languageOnly {
+		for _, pluginName := range manifest.Plugins {
+			if _, err := plugin.ResolvePluginVersion(manifest, pluginName); err != nil {
+				logger.Fatalf(true, err.Error())
+			}
+		}
+	}
 }
 
 // IsCompatiblePluginInstalled checks if a plugin compatible to gauge is installed
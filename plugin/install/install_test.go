@@ -8,6 +8,7 @@ package install
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"runtime"
 	"testing"
@@ -69,6 +70,33 @@ func (s *MySuite) TestFindingLatestCompatibleVersionFailing(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *MySuite) TestFindingLatestCompatibleVersionSatisfyingConstraint(c *C) {
+	installDescription := createInstallDescriptionWithVersions("5.8.8", "1.7.8", "4.8.9", "0.7.6")
+	addVersionSupportToInstallDescription(installDescription,
+		&version.VersionSupport{Minimum: "0.0.2"},
+		&version.VersionSupport{Minimum: "0.0.2"},
+		&version.VersionSupport{Minimum: "0.0.2"},
+		&version.VersionSupport{Minimum: "0.0.2"})
+	versionInstallDesc, err := installDescription.getLatestCompatibleVersionSatisfying(&version.Version{Major: 1, Minor: 0, Patch: 0}, ">=1.0 <5")
+	c.Assert(err, Equals, nil)
+	c.Assert(versionInstallDesc.Version, Equals, "4.8.9")
+}
+
+func (s *MySuite) TestFindingLatestCompatibleVersionSatisfyingConstraintFailing(c *C) {
+	installDescription := createInstallDescriptionWithVersions("5.8.8", "1.7.8")
+	addVersionSupportToInstallDescription(installDescription,
+		&version.VersionSupport{Minimum: "0.0.2"},
+		&version.VersionSupport{Minimum: "0.0.2"})
+	_, err := installDescription.getLatestCompatibleVersionSatisfying(&version.Version{Major: 1, Minor: 0, Patch: 0}, ">=6.0")
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestFindingLatestCompatibleVersionSatisfyingInvalidConstraint(c *C) {
+	installDescription := createInstallDescriptionWithVersions("5.8.8")
+	_, err := installDescription.getLatestCompatibleVersionSatisfying(&version.Version{Major: 1, Minor: 0, Patch: 0}, "not-a-constraint")
+	c.Assert(err, NotNil)
+}
+
 func createInstallDescriptionWithVersions(versionNumbers ...string) *installDescription {
 	var versionInstallDescriptions []versionInstallDescription
 	for _, version := range versionNumbers {
@@ -171,3 +199,14 @@ func (s *MySuite) TestIsPlatformIndependentZipFile(c *C) {
 	c.Assert(isPlatformIndependent(javaNightly), Equals, false)
 	c.Assert(isPlatformIndependent(csharpNightly), Equals, true)
 }
+
+func (s *MySuite) TestVerifyChecksumOfFile(c *C) {
+	file := filepath.Join(c.MkDir(), "plugin.zip")
+	err := ioutil.WriteFile(file, []byte("plugin contents"), 0644)
+	c.Assert(err, IsNil)
+	// sha256 of "plugin contents"
+	checksum := "2470e41dbb98724beb2afc3f60db8e74ffd5eb6104c9c2e32a50bdae6c14843f"
+
+	c.Assert(verifyChecksum(file, checksum), IsNil)
+	c.Assert(verifyChecksum(file, checksum[:63]+"0"), NotNil)
+}
@@ -0,0 +1,222 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/version"
+)
+
+// Gauge can update its own binary from the release server configured in
+// gauge_update_url, without an OS package manager. The server publishes a
+// manifest per release channel at <gauge_update_url>/<channel>.json:
+//
+//	{
+//	  "version": "1.1.0",
+//	  "downloadUrls": {"x64": {"linux": "...", "darwin": "...", "windows": "..."}},
+//	  "sha256": {"x64": {"linux": "...", "darwin": "...", "windows": "..."}}
+//	}
+//
+// The zip behind the platform's download url contains the gauge binary. It
+// is verified against the declared checksum, then swapped in place of the
+// running executable; the previous binary is restored if the swap fails.
+
+// SelfUpdateChannels lists the release channels a self-update can follow.
+var SelfUpdateChannels = []string{"stable", "nightly"}
+
+type selfUpdateManifest struct {
+	Version      string
+	DownloadUrls downloadUrls
+	Sha256       downloadUrls
+}
+
+// SelfUpdate downloads the latest gauge release on the given channel,
+// verifies it and atomically replaces the running gauge binary.
+func SelfUpdate(channel string, silent bool) error {
+	updateURL := config.GaugeUpdateUrl()
+	if updateURL == "" {
+		return fmt.Errorf("gauge_update_url is not configured.")
+	}
+	if !isValidChannel(channel) {
+		return fmt.Errorf("Unknown channel '%s', expected one of: %s.", channel, joinChannels())
+	}
+	tempDir := common.GetTempDir()
+	defer func() {
+		if err := common.Remove(tempDir); err != nil {
+			logger.Errorf(false, "unable to remove temp directory: %s", err.Error())
+		}
+	}()
+	manifest, err := fetchSelfUpdateManifest(updateURL, channel, tempDir, silent)
+	if err != nil {
+		return err
+	}
+	latest, err := version.ParseVersion(manifest.Version)
+	if err != nil {
+		return fmt.Errorf("Release manifest declares an invalid version '%s'.", manifest.Version)
+	}
+	if !latest.IsGreaterThan(version.CurrentGaugeVersion) {
+		logger.Infof(true, "Gauge is up to date, %s is the latest %s release.", version.CurrentGaugeVersion.String(), channel)
+		return nil
+	}
+	logger.Infof(true, "Updating gauge from %s to %s...", version.CurrentGaugeVersion.String(), latest.String())
+	binary, err := downloadGaugeBinary(manifest, tempDir, silent)
+	if err != nil {
+		return err
+	}
+	if err := replaceGaugeBinary(binary); err != nil {
+		return err
+	}
+	logger.Infof(true, "Successfully updated gauge to %s.", latest.String())
+	return nil
+}
+
+func isValidChannel(channel string) bool {
+	for _, c := range SelfUpdateChannels {
+		if channel == c {
+			return true
+		}
+	}
+	return false
+}
+
+func joinChannels() string {
+	out := ""
+	for i, c := range SelfUpdateChannels {
+		if i > 0 {
+			out += ", "
+		}
+		out += c
+	}
+	return out
+}
+
+func fetchSelfUpdateManifest(updateURL, channel, tempDir string, silent bool) (*selfUpdateManifest, error) {
+	manifestURL := fmt.Sprintf("%s/%s.json", updateURL, channel)
+	manifestFile, err := util.Download(manifestURL, tempDir, "", silent)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to fetch the %s release manifest. %s", channel, err.Error())
+	}
+	contents, err := common.ReadFileContents(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &selfUpdateManifest{}
+	if err := json.Unmarshal([]byte(contents), manifest); err != nil {
+		return nil, fmt.Errorf("Failed to parse the %s release manifest. %s", channel, err.Error())
+	}
+	return manifest, nil
+}
+
+func downloadGaugeBinary(manifest *selfUpdateManifest, tempDir string, silent bool) (string, error) {
+	downloadLink, err := getDownloadLink(manifest.DownloadUrls)
+	if err != nil {
+		return "", fmt.Errorf("Could not get download link: %s", err.Error())
+	}
+	gaugeZip, err := util.Download(downloadLink, tempDir, "", silent)
+	if err != nil {
+		return "", fmt.Errorf("Failed to download gauge. %s", err.Error())
+	}
+	if checksum, _ := getDownloadLink(manifest.Sha256); checksum != "" {
+		if err := verifyChecksum(gaugeZip, checksum); err != nil {
+			return "", fmt.Errorf("Failed to verify the downloaded gauge binary. %s", err.Error())
+		}
+		logger.Debugf(true, "Verified checksum of %s", filepath.Base(gaugeZip))
+	}
+	unzipped, err := common.UnzipArchive(gaugeZip, tempDir)
+	if err != nil {
+		return "", fmt.Errorf("Failed to unzip the downloaded gauge archive. %s", err.Error())
+	}
+	binary := filepath.Join(unzipped, gaugeBinaryName())
+	if !common.FileExists(binary) {
+		return "", fmt.Errorf("The downloaded archive does not contain a %s binary.", gaugeBinaryName())
+	}
+	return binary, nil
+}
+
+func gaugeBinaryName() string {
+	if runtime.GOOS == "windows" {
+		return "gauge.exe"
+	}
+	return "gauge"
+}
+
+// currentGaugeExecutable resolves the path of the running gauge binary,
+// following symlinks so the real file is replaced.
+var currentGaugeExecutable = func() (string, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(executable)
+}
+
+// replaceGaugeBinary swaps the running gauge binary with the new one. The
+// new binary is staged next to the current one so the final rename is
+// atomic, and the previous binary is put back if the swap fails.
+func replaceGaugeBinary(newBinary string) error {
+	current, err := currentGaugeExecutable()
+	if err != nil {
+		return fmt.Errorf("Failed to locate the running gauge binary. %s", err.Error())
+	}
+	staged := current + ".new"
+	if err := copyFile(newBinary, staged); err != nil {
+		return fmt.Errorf("Failed to stage the new gauge binary. %s", err.Error())
+	}
+	backup := current + ".old"
+	if err := os.Rename(current, backup); err != nil {
+		os.Remove(staged)
+		return fmt.Errorf("Failed to replace %s. %s", current, err.Error())
+	}
+	if err := os.Rename(staged, current); err != nil {
+		if restoreErr := os.Rename(backup, current); restoreErr != nil {
+			return fmt.Errorf("Failed to install the new gauge binary and to restore the previous one: %s. The previous binary is at %s.", restoreErr.Error(), backup)
+		}
+		os.Remove(staged)
+		return fmt.Errorf("Failed to install the new gauge binary, the previous one has been restored. %s", err.Error())
+	}
+	if err := os.Remove(backup); err != nil {
+		logger.Debugf(false, "unable to remove the previous gauge binary at %s: %s", backup, err.Error())
+	}
+	return nil
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := ioutil.TempFile(filepath.Dir(dest), filepath.Base(dest))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(out.Name())
+		return err
+	}
+	if err := os.Chmod(out.Name(), 0755); err != nil {
+		os.Remove(out.Name())
+		return err
+	}
+	return os.Rename(out.Name(), dest)
+}
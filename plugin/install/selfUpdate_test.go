@@ -0,0 +1,71 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestIsValidChannel(c *C) {
+	c.Assert(isValidChannel("stable"), Equals, true)
+	c.Assert(isValidChannel("nightly"), Equals, true)
+	c.Assert(isValidChannel("beta"), Equals, false)
+}
+
+func (s *MySuite) TestFetchSelfUpdateManifest(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/nightly.json" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"version":"1.2.0","downloadUrls":{"x64":{"linux":"https://example.com/gauge.zip"}}}`)
+	}))
+	defer server.Close()
+
+	manifest, err := fetchSelfUpdateManifest(server.URL, "nightly", c.MkDir(), true)
+	c.Assert(err, IsNil)
+	c.Assert(manifest.Version, Equals, "1.2.0")
+	c.Assert(manifest.DownloadUrls.X64.Linux, Equals, "https://example.com/gauge.zip")
+}
+
+func (s *MySuite) TestReplaceGaugeBinarySwapsTheRunningBinary(c *C) {
+	dir := c.MkDir()
+	current := filepath.Join(dir, "gauge")
+	c.Assert(ioutil.WriteFile(current, []byte("old binary"), 0755), IsNil)
+	newBinary := filepath.Join(c.MkDir(), "gauge")
+	c.Assert(ioutil.WriteFile(newBinary, []byte("new binary"), 0755), IsNil)
+	old := currentGaugeExecutable
+	currentGaugeExecutable = func() (string, error) { return current, nil }
+	defer func() { currentGaugeExecutable = old }()
+
+	c.Assert(replaceGaugeBinary(newBinary), IsNil)
+
+	contents, err := ioutil.ReadFile(current)
+	c.Assert(err, IsNil)
+	c.Assert(string(contents), Equals, "new binary")
+	_, err = os.Stat(current + ".old")
+	c.Assert(os.IsNotExist(err), Equals, true)
+	_, err = os.Stat(current + ".new")
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestReplaceGaugeBinaryFailsWhenExecutableCannotBeLocated(c *C) {
+	old := currentGaugeExecutable
+	currentGaugeExecutable = func() (string, error) { return "", fmt.Errorf("no executable") }
+	defer func() { currentGaugeExecutable = old }()
+
+	err := replaceGaugeBinary(filepath.Join(c.MkDir(), "gauge"))
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "Failed to locate the running gauge binary. no executable")
+}
@@ -0,0 +1,61 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+// Plugin zips can publish a detached GPG signature alongside the artifact,
+// declared per platform in the install description's Signature urls. When a
+// signature is declared it is downloaded and verified with gpg before the
+// zip is unpacked; set require_signed_plugins=true to refuse plugins that do
+// not publish one.
+
+// verifySignature checks the downloaded plugin zip against its published
+// detached signature. An empty signatureURL means the plugin is unsigned,
+// which is an error only when require_signed_plugins is enabled.
+func verifySignature(pluginZip, signatureURL, tempDir string, silent bool) error {
+	if signatureURL == "" {
+		if env.RequireSignedPlugins() {
+			return fmt.Errorf("Plugin does not publish a signature and require_signed_plugins is enabled.")
+		}
+		return nil
+	}
+	signatureFile, err := util.Download(signatureURL, tempDir, "", silent)
+	if err != nil {
+		return fmt.Errorf("Failed to download the plugin signature. %s", err.Error())
+	}
+	cmd := gpgCommand(signatureFile, pluginZip)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if isCommandNotFound(err) {
+			if env.RequireSignedPlugins() {
+				return fmt.Errorf("gpg is required to verify plugin signatures when require_signed_plugins is enabled.")
+			}
+			logger.Warningf(true, "Skipping signature verification, gpg is not installed.")
+			return nil
+		}
+		return fmt.Errorf("Signature verification failed. %s", strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+var gpgCommand = func(signatureFile, file string) *exec.Cmd {
+	return exec.Command("gpg", "--verify", signatureFile, file)
+}
+
+func isCommandNotFound(err error) bool {
+	execErr, ok := err.(*exec.Error)
+	return ok && execErr.Err == exec.ErrNotFound
+}
@@ -0,0 +1,94 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	. "gopkg.in/check.v1"
+)
+
+func stubRequireSignedPlugins(required bool) func() {
+	old := env.RequireSignedPlugins
+	env.RequireSignedPlugins = func() bool { return required }
+	return func() { env.RequireSignedPlugins = old }
+}
+
+func (s *MySuite) TestVerifySignatureAllowsUnsignedPluginByDefault(c *C) {
+	defer stubRequireSignedPlugins(false)()
+
+	c.Assert(verifySignature("plugin.zip", "", c.MkDir(), true), IsNil)
+}
+
+func (s *MySuite) TestVerifySignatureRefusesUnsignedPluginWhenRequired(c *C) {
+	defer stubRequireSignedPlugins(true)()
+
+	err := verifySignature("plugin.zip", "", c.MkDir(), true)
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "Plugin does not publish a signature and require_signed_plugins is enabled.")
+}
+
+func (s *MySuite) TestVerifySignatureRunsGpgOnDownloadedSignature(c *C) {
+	defer stubRequireSignedPlugins(false)()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "signature bytes")
+	}))
+	defer server.Close()
+	var verifiedFile string
+	old := gpgCommand
+	gpgCommand = func(signatureFile, file string) *exec.Cmd {
+		verifiedFile = file
+		return exec.Command("true")
+	}
+	defer func() { gpgCommand = old }()
+
+	c.Assert(verifySignature("plugin.zip", server.URL+"/plugin.zip.sig", c.MkDir(), true), IsNil)
+	c.Assert(verifiedFile, Equals, "plugin.zip")
+}
+
+func (s *MySuite) TestVerifySignatureReportsGpgFailure(c *C) {
+	defer stubRequireSignedPlugins(false)()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "signature bytes")
+	}))
+	defer server.Close()
+	old := gpgCommand
+	gpgCommand = func(signatureFile, file string) *exec.Cmd {
+		return exec.Command("sh", "-c", "echo BAD signature; exit 1")
+	}
+	defer func() { gpgCommand = old }()
+
+	err := verifySignature("plugin.zip", server.URL+"/plugin.zip.sig", c.MkDir(), true)
+	c.Assert(err, NotNil)
+	c.Assert(strings.Contains(err.Error(), "BAD signature"), Equals, true)
+}
+
+func (s *MySuite) TestVerifySignatureWithoutGpgInstalled(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "signature bytes")
+	}))
+	defer server.Close()
+	old := gpgCommand
+	gpgCommand = func(signatureFile, file string) *exec.Cmd {
+		return exec.Command("gauge-no-such-gpg-binary")
+	}
+	defer func() { gpgCommand = old }()
+
+	defer stubRequireSignedPlugins(false)()
+	c.Assert(verifySignature("plugin.zip", server.URL+"/plugin.zip.sig", c.MkDir(), true), IsNil)
+
+	restore := stubRequireSignedPlugins(true)
+	defer restore()
+	err := verifySignature("plugin.zip", server.URL+"/plugin.zip.sig", c.MkDir(), true)
+	c.Assert(err, NotNil)
+	c.Assert(strings.Contains(err.Error(), "gpg is required"), Equals, true)
+}
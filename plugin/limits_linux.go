@@ -0,0 +1,36 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package plugin
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// applyResourceLimits applies the CPU and memory limits declared in the
+// plugin descriptor to the started plugin process, so a misbehaving plugin
+// cannot starve the suite. Zero values leave the resource unlimited.
+func applyResourceLimits(pd *PluginDescriptor, pid int) {
+	if pd.Limits.CPUSeconds > 0 {
+		setLimit(pd, pid, syscall.RLIMIT_CPU, uint64(pd.Limits.CPUSeconds), "CPU seconds")
+	}
+	if pd.Limits.MemoryMB > 0 {
+		setLimit(pd, pid, syscall.RLIMIT_AS, uint64(pd.Limits.MemoryMB)*1024*1024, "bytes of memory")
+	}
+}
+
+func setLimit(pd *PluginDescriptor, pid int, resource int, value uint64, unit string) {
+	limit := syscall.Rlimit{Cur: value, Max: value}
+	_, _, errno := syscall.RawSyscall6(syscall.SYS_PRLIMIT64, uintptr(pid), uintptr(resource), uintptr(unsafe.Pointer(&limit)), 0, 0, 0)
+	if errno != 0 {
+		logger.Warningf(true, "Unable to limit plugin %s to %d %s: %s", pd.Name, value, unit, errno.Error())
+		return
+	}
+	logger.Debugf(true, "Limited plugin %s to %d %s", pd.Name, value, unit)
+}
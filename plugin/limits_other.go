@@ -0,0 +1,21 @@
+// +build !linux
+
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package plugin
+
+import (
+	"github.com/getgauge/gauge/logger"
+)
+
+// applyResourceLimits is a no-op on platforms without prlimit support;
+// configured limits are reported so the gap is visible.
+func applyResourceLimits(pd *PluginDescriptor, pid int) {
+	if pd.Limits.CPUSeconds > 0 || pd.Limits.MemoryMB > 0 {
+		logger.Warningf(true, "Resource limits for plugin %s are not supported on this platform.", pd.Name)
+	}
+}
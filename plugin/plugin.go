@@ -41,6 +41,7 @@ type pluginScope string
 const (
 	executionScope          pluginScope = "execution"
 	docScope                pluginScope = "documentation"
+	resultProcessorScope    pluginScope = "result-processor"
 	pluginConnectionPortEnv             = "plugin_connection_port"
 )
 
@@ -177,13 +178,52 @@ func getPluginJSONPath(pluginName, pluginVersion string) (string, error) {
 		return "", fmt.Errorf("Plugin %s is not installed", plugin)
 	}
 
-	pluginInstallDir, err := GetInstallDir(pluginName, "")
+	pluginInstallDir, err := GetInstallDir(pluginName, pluginVersion)
 	if err != nil {
 		return "", err
 	}
 	return filepath.Join(pluginInstallDir, common.PluginJSONFile), nil
 }
 
+// ResolvePluginVersion picks the installed version of a plugin that satisfies
+// the manifest's version constraint, preferring the latest match. It returns
+// an empty version when the manifest does not constrain the plugin, leaving
+// the latest installed version in effect.
+func ResolvePluginVersion(m *manifest.Manifest, pluginID string) (string, error) {
+	constraintText := m.ConstraintFor(pluginID)
+	if constraintText == "" {
+		return "", nil
+	}
+	constraint, err := version.ParseConstraint(constraintText)
+	if err != nil {
+		return "", fmt.Errorf("Invalid constraint %q for plugin %s in manifest.json. %s", constraintText, pluginID, err.Error())
+	}
+	allPluginsInstallDir, err := common.GetPluginsInstallDir(pluginID)
+	if err != nil {
+		return "", err
+	}
+	installed, err := pluginInfo.GetAllInstalledVersionsOfPlugin(filepath.Join(allPluginsInstallDir, pluginID))
+	if err != nil {
+		return "", fmt.Errorf("Plugin %s is not installed. To install, run `gauge install %s`.", pluginID, pluginID)
+	}
+	var available, matching []*version.Version
+	for _, p := range installed {
+		available = append(available, p.Version)
+		if constraint.Satisfies(p.Version) {
+			matching = append(matching, p.Version)
+		}
+	}
+	if len(matching) == 0 {
+		var availableTexts []string
+		for _, v := range available {
+			availableTexts = append(availableTexts, v.String())
+		}
+		return "", fmt.Errorf("No installed version of plugin %s (installed: %s) satisfies the constraint %q in manifest.json. To install a matching version, run `gauge install %s --version <version>`.",
+			pluginID, strings.Join(availableTexts, ", "), constraintText, pluginID)
+	}
+	return version.GetLatestVersion(matching).String(), nil
+}
+
 // GetPluginDescriptor return the information about the plugin including name, id, commands to start etc.
 func GetPluginDescriptor(pluginID, pluginVersion string) (*PluginDescriptor, error) {
 	pluginJSON, err := getPluginJSONPath(pluginID, pluginVersion)
@@ -220,10 +260,17 @@ func startPlugin(pd *PluginDescriptor, action pluginScope) (*plugin, error) {
 	if len(command) == 0 {
 		return nil, fmt.Errorf("Platform specific command not specified: %s.", runtime.GOOS)
 	}
+	var p *plugin
+	var err error
 	if pd.hasCapability(gRPCSupportCapability) {
-		return startGRPCPlugin(pd, command)
+		p, err = startGRPCPlugin(pd, command)
+	} else {
+		p, err = startLegacyPlugin(pd, command)
+	}
+	if err == nil && p.pluginCmd != nil && p.pluginCmd.Process != nil {
+		applyResourceLimits(pd, p.pluginCmd.Process.Pid)
 	}
-	return startLegacyPlugin(pd, command)
+	return p, err
 }
 
 func startGRPCPlugin(pd *PluginDescriptor, command []string) (*plugin, error) {
@@ -251,10 +298,15 @@ func startGRPCPlugin(pd *PluginDescriptor, command []string) (*plugin, error) {
 		return nil, fmt.Errorf("timed out connecting to %s", pd.ID)
 	}
 	logger.Debugf(true, "Attempting to connect to grpc server at port: %s", port)
-	gRPCConn, err := grpc.Dial(fmt.Sprintf("%s:%s", "127.0.0.1", port),
-		grpc.WithInsecure(),
-		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(1024*1024*1024), grpc.MaxCallRecvMsgSize(1024*1024*1024)),
-		grpc.WithBlock())
+	var gRPCConn *grpc.ClientConn
+	err = conn.AttemptWithRetry(fmt.Sprintf("%s plugin at port %s", pd.ID, port), func() error {
+		var dialErr error
+		gRPCConn, dialErr = grpc.Dial(fmt.Sprintf("%s:%s", "127.0.0.1", port),
+			grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(1024*1024*1024), grpc.MaxCallRecvMsgSize(1024*1024*1024)),
+			grpc.WithBlock())
+		return dialErr
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +374,11 @@ func startPluginsForExecution(m *manifest.Manifest) (Handler, []string) {
 	envProperties := make(map[string]string)
 
 	for _, pluginID := range m.Plugins {
-		pd, err := GetPluginDescriptor(pluginID, "")
+		pluginVersion, err := ResolvePluginVersion(m, pluginID)
+		if err != nil {
+			logger.Fatalf(true, err.Error())
+		}
+		pd, err := GetPluginDescriptor(pluginID, pluginVersion)
 		if err != nil {
 			warnings = append(warnings, fmt.Sprintf("Unable to start plugin %s. %s. To install, run `gauge install %s`.", pluginID, err.Error(), pluginID))
 			continue
@@ -375,6 +431,10 @@ func startPluginsForExecution(m *manifest.Manifest) (Handler, []string) {
 		}
 
 	}
+	for i, ip := range registeredInProcessPlugins() {
+		logger.Debugf(true, "Adding in-process plugin %s", ip.Name())
+		handler.addListener(fmt.Sprintf("in-process-%d-%s", i, ip.Name()), &inProcessListener{p: ip})
+	}
 	return handler, warnings
 }
 
@@ -467,6 +527,46 @@ func (p *plugin) sendMessage(message *gauge_messages.Message) error {
 	return nil
 }
 
+// sendMessageWithTimeout sends a notification to the plugin, giving up after
+// the given timeout so a hanging plugin does not delay the whole suite.
+// A timed out notification is skipped with a warning, not treated as a
+// plugin failure. A timeout of 0 or less waits indefinitely.
+func (p *plugin) sendMessageWithTimeout(message *gauge_messages.Message, timeout time.Duration) error {
+	if timeout <= 0 {
+		return p.sendMessage(message)
+	}
+	errChan := make(chan error, 1)
+	go func() { errChan <- p.sendMessage(message) }()
+	select {
+	case err := <-errChan:
+		return err
+	case <-time.After(timeout):
+		logger.Warningf(true, "Plugin %s did not handle %s within %s. Skipping this notification.", p.descriptor.Name, message.GetMessageType(), timeout)
+		return nil
+	}
+}
+
+// messageListener implementation, so the handler can dispatch to
+// out-of-process plugins and in-process Go plugins alike.
+
+func (p *plugin) listenerName() string {
+	return fmt.Sprintf("%s %s", p.descriptor.Name, p.descriptor.Version)
+}
+
+func (p *plugin) sendNotification(message *gauge_messages.Message, timeout time.Duration) error {
+	return p.sendMessageWithTimeout(message, timeout)
+}
+
+func (p *plugin) forceKill() {
+	if err := p.pluginCmd.Process.Kill(); err != nil {
+		logger.Errorf(true, "Failed to kill plugin %s. %s\n", p.listenerName(), err.Error())
+	}
+}
+
+func (p *plugin) gracefulKill(wg *sync.WaitGroup) error {
+	return p.kill(wg)
+}
+
 func StartPlugins(m *manifest.Manifest) Handler {
 	pluginHandler, warnings := startPluginsForExecution(m)
 	logger.HandleWarningMessages(true, warnings)
@@ -79,6 +79,34 @@ var GetAllInstalledPluginsWithVersion = func() ([]PluginInfo, error) {
 	return sortPlugins(allPlugins), nil
 }
 
+// GetAllInstalledVersionsOfPlugin returns every valid installed version of
+// the plugin in pluginDir.
+func GetAllInstalledVersionsOfPlugin(pluginDir string) ([]PluginInfo, error) {
+	files, err := ioutil.ReadDir(pluginDir)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing files in plugin directory %s: %s", pluginDir, err.Error())
+	}
+	pluginName := filepath.Base(pluginDir)
+	var plugins []PluginInfo
+	for _, file := range files {
+		if !file.IsDir() {
+			continue
+		}
+		v := file.Name()
+		if strings.Contains(file.Name(), "nightly") {
+			v = file.Name()[:strings.LastIndex(file.Name(), ".")]
+		}
+		vp, err := version.ParseVersion(v)
+		if err == nil {
+			plugins = append(plugins, PluginInfo{pluginName, vp, filepath.Join(pluginDir, file.Name())})
+		}
+	}
+	if len(plugins) < 1 {
+		return nil, fmt.Errorf("No valid versions of plugin %s found in %s", pluginName, pluginDir)
+	}
+	return plugins, nil
+}
+
 func GetLatestInstalledPlugin(pluginDir string) (*PluginInfo, error) {
 	files, err := ioutil.ReadDir(pluginDir)
 	if err != nil {
@@ -0,0 +1,96 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/version"
+)
+
+// ProcessSuiteResult runs the suite result through every result-processor
+// plugin listed in the manifest, in order. Each processor receives the
+// ProtoSuiteResult and returns a (possibly modified) result, e.g. to
+// reclassify known failures or attach links, before reports are generated
+// and the exit code is decided. A failing processor is skipped with a
+// warning; the result it was given is carried forward unmodified.
+func ProcessSuiteResult(m *manifest.Manifest, suiteResult *gauge_messages.ProtoSuiteResult) *gauge_messages.ProtoSuiteResult {
+	for _, pluginID := range m.Plugins {
+		pluginVersion, err := ResolvePluginVersion(m, pluginID)
+		if err != nil {
+			logger.Warningf(true, err.Error())
+			continue
+		}
+		pd, err := GetPluginDescriptor(pluginID, pluginVersion)
+		if err != nil || !pd.hasScope(resultProcessorScope) {
+			continue
+		}
+		if err := version.CheckCompatibility(version.CurrentGaugeVersion, &pd.GaugeVersionSupport); err != nil {
+			logger.Warningf(true, "Compatible %s plugin version to current Gauge version %s not found", pd.Name, version.CurrentGaugeVersion)
+			continue
+		}
+		processed, err := processWithPlugin(pd, m, suiteResult)
+		if err != nil {
+			logger.Warningf(true, "Result processor %s %s failed: %s. Continuing with unprocessed result.", pd.Name, pd.Version, err.Error())
+			continue
+		}
+		suiteResult = processed
+	}
+	return suiteResult
+}
+
+func processWithPlugin(pd *PluginDescriptor, m *manifest.Manifest, suiteResult *gauge_messages.ProtoSuiteResult) (*gauge_messages.ProtoSuiteResult, error) {
+	if pd.hasCapability(gRPCSupportCapability) {
+		return nil, fmt.Errorf("result-processor plugins must use the message based connection")
+	}
+	gaugeConnectionHandler, err := conn.NewGaugeConnectionHandler(0, nil)
+	if err != nil {
+		return nil, err
+	}
+	envProperties := map[string]string{pluginConnectionPortEnv: strconv.Itoa(gaugeConnectionHandler.ConnectionPortNumber())}
+	if err := SetEnvForPlugin(resultProcessorScope, pd, m, envProperties); err != nil {
+		return nil, err
+	}
+	logger.Debugf(true, "Starting %s plugin as result processor", pd.Name)
+	p, err := startPlugin(pd, resultProcessorScope)
+	if err != nil {
+		return nil, err
+	}
+	pluginConnection, err := gaugeConnectionHandler.AcceptConnection(config.PluginConnectionTimeout(), make(chan error))
+	if err != nil {
+		if killErr := p.pluginCmd.Process.Kill(); killErr != nil {
+			logger.Errorf(false, "unable to kill plugin %s: %s", pd.Name, killErr.Error())
+		}
+		return nil, fmt.Errorf("failed to connect to plugin. %s", err.Error())
+	}
+	p.connection = pluginConnection
+	defer func() {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		if err := p.kill(&wg); err != nil {
+			logger.Errorf(false, "Unable to kill plugin %s : %s", pd.Name, err.Error())
+		}
+	}()
+	message := &gauge_messages.Message{MessageType: gauge_messages.Message_SuiteExecutionResult,
+		SuiteExecutionResult: &gauge_messages.SuiteExecutionResult{SuiteResult: suiteResult}}
+	response, err := conn.GetResponseForMessageWithTimeout(message, pluginConnection, config.PluginConnectionTimeout())
+	if err != nil {
+		return nil, err
+	}
+	processed := response.GetSuiteExecutionResult().GetSuiteResult()
+	if processed == nil {
+		return nil, fmt.Errorf("plugin did not return a suite result")
+	}
+	return processed, nil
+}
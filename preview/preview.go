@@ -0,0 +1,223 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package preview serves a live-reloading HTML rendering of the project's
+// specifications, so reviewers can read specs in a browser while they are
+// being edited, without an IDE or a documentation plugin.
+package preview
+
+import (
+	"fmt"
+	"hash/fnv"
+	"html/template"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// Start serves the spec preview on the given port (an available port is
+// picked when port is 0) and blocks until the server stops. Specs are
+// re-parsed on every request; the page polls for file changes and reloads
+// itself, so edits show up without manual refreshes.
+func Start(specDirs []string, port int) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to start preview server: %s", err.Error())
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		renderPreview(w, specDirs)
+	})
+	mux.HandleFunc("/state", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%d", specsState(specDirs))
+	})
+	logger.Infof(true, "Gauge preview available at http://%s", listener.Addr().String())
+	return http.Serve(listener, mux)
+}
+
+// specsState fingerprints the spec and concept files so the page can detect
+// edits by polling; any change to a file's path, size or mtime changes it.
+func specsState(specDirs []string) uint64 {
+	files := append(util.GetSpecFiles(specDirs), util.GetConceptFiles()...)
+	sort.Strings(files)
+	h := fnv.New64a()
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(h, "%s:%d:%d;", file, info.Size(), info.ModTime().UnixNano())
+	}
+	return h.Sum64()
+}
+
+type previewData struct {
+	Specs  []*specView
+	Errors []string
+}
+
+type specView struct {
+	Heading   string
+	FileName  string
+	Tags      []string
+	Table     *tableView
+	Contexts  []*stepView
+	Scenarios []*scenarioView
+	Teardown  []*stepView
+}
+
+type scenarioView struct {
+	Heading string
+	Tags    []string
+	Table   *tableView
+	Steps   []*stepView
+}
+
+type stepView struct {
+	Text         string
+	ConceptSteps []*stepView
+}
+
+type tableView struct {
+	Headers []string
+	Rows    [][]string
+}
+
+func renderPreview(w http.ResponseWriter, specDirs []string) {
+	data := gatherPreviewData(specDirs)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := previewTemplate.Execute(w, data); err != nil {
+		logger.Warningf(true, "Failed to render spec preview: %s", err.Error())
+	}
+}
+
+func gatherPreviewData(specDirs []string) *previewData {
+	data := &previewData{}
+	conceptDict, conceptResult, err := parser.ParseConcepts()
+	if err != nil {
+		data.Errors = append(data.Errors, err.Error())
+		return data
+	}
+	for _, e := range conceptResult.ParseErrors {
+		data.Errors = append(data.Errors, e.Error())
+	}
+	buildErrors := gauge.NewBuildErrors()
+	specs, _ := parser.ParseSpecs(specDirs, conceptDict, buildErrors)
+	for _, errs := range buildErrors.SpecErrs {
+		for _, e := range errs {
+			data.Errors = append(data.Errors, e.Error())
+		}
+	}
+	sort.Strings(data.Errors)
+	for _, spec := range specs {
+		data.Specs = append(data.Specs, newSpecView(spec))
+	}
+	return data
+}
+
+func newSpecView(spec *gauge.Specification) *specView {
+	view := &specView{
+		Heading:  spec.Heading.Value,
+		FileName: util.RelPathToProjectRoot(spec.FileName),
+		Tags:     tagValues(spec.Tags),
+		Table:    newTableView(&spec.DataTable.Table),
+		Contexts: newStepViews(spec.Contexts),
+		Teardown: newStepViews(spec.TearDownSteps),
+	}
+	for _, scn := range spec.Scenarios {
+		view.Scenarios = append(view.Scenarios, &scenarioView{
+			Heading: scn.Heading.Value,
+			Tags:    tagValues(scn.Tags),
+			Table:   newTableView(&scn.DataTable.Table),
+			Steps:   newStepViews(scn.Steps),
+		})
+	}
+	return view
+}
+
+func newStepViews(steps []*gauge.Step) []*stepView {
+	views := make([]*stepView, 0, len(steps))
+	for _, step := range steps {
+		view := &stepView{Text: step.GetLineText()}
+		if step.IsConcept {
+			view.ConceptSteps = newStepViews(step.ConceptSteps)
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+func newTableView(table *gauge.Table) *tableView {
+	if table == nil || !table.IsInitialized() {
+		return nil
+	}
+	return &tableView{Headers: table.Headers, Rows: table.Rows()}
+}
+
+func tagValues(tags *gauge.Tags) []string {
+	if tags == nil {
+		return nil
+	}
+	return tags.Values()
+}
+
+var previewTemplate = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8"/>
+<title>Gauge Spec Preview</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Roboto, sans-serif; margin: 2em auto; max-width: 60em; color: #333; }
+h1 { border-bottom: 2px solid #eee; padding-bottom: 0.2em; }
+h2 { margin-bottom: 0.2em; }
+.file { color: #999; font-size: 0.8em; }
+.tag { background: #e8f0fe; color: #1a56a8; border-radius: 1em; padding: 0.1em 0.8em; font-size: 0.8em; margin-right: 0.3em; }
+.error { background: #fdecea; color: #b3261e; padding: 0.5em 1em; border-radius: 0.3em; margin: 0.3em 0; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+th, td { border: 1px solid #ddd; padding: 0.3em 0.8em; text-align: left; }
+th { background: #f5f5f5; }
+ul.steps { list-style: none; padding-left: 1em; }
+ul.steps > li { padding: 0.15em 0; }
+ul.steps > li:before { content: "\25B8"; color: #bbb; margin-right: 0.5em; }
+details { margin-left: 1em; }
+summary { cursor: pointer; color: #666; font-size: 0.85em; }
+.section { color: #666; font-size: 0.85em; text-transform: uppercase; letter-spacing: 0.05em; margin-top: 1em; }
+</style>
+</head>
+<body>
+{{range .Errors}}<div class="error">{{.}}</div>{{end}}
+{{range .Specs}}
+<h1>{{.Heading}}</h1>
+<div class="file">{{.FileName}}</div>
+<div>{{range .Tags}}<span class="tag">{{.}}</span>{{end}}</div>
+{{template "table" .Table}}
+{{if .Contexts}}<div class="section">Context</div>{{template "steps" .Contexts}}{{end}}
+{{range .Scenarios}}
+<h2>{{.Heading}}</h2>
+<div>{{range .Tags}}<span class="tag">{{.}}</span>{{end}}</div>
+{{template "table" .Table}}
+{{template "steps" .Steps}}
+{{end}}
+{{if .Teardown}}<div class="section">Teardown</div>{{template "steps" .Teardown}}{{end}}
+{{end}}
+<script>
+var state = null;
+setInterval(function () {
+	fetch("/state").then(function (r) { return r.text(); }).then(function (s) {
+		if (state === null) { state = s; } else if (s !== state) { location.reload(); }
+	});
+}, 1000);
+</script>
+</body>
+</html>
+{{define "table"}}{{if .}}<table><tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>{{end}}</table>{{end}}{{end}}
+{{define "steps"}}<ul class="steps">{{range .}}<li>{{.Text}}{{if .ConceptSteps}}<details><summary>concept</summary>{{template "steps" .ConceptSteps}}</details>{{end}}</li>{{end}}</ul>{{end}}`))
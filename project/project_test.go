@@ -0,0 +1,85 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package project
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+)
+
+func projectDir(t *testing.T, manifestContent string) string {
+	dir, err := ioutil.TempDir("", "gauge_project_")
+	if err != nil {
+		t.Fatalf("Unable to create temp dir: %s", err.Error())
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	if err := ioutil.WriteFile(filepath.Join(dir, common.ManifestFile), []byte(manifestContent), 0600); err != nil {
+		t.Fatalf("Unable to write manifest: %s", err.Error())
+	}
+	return dir
+}
+
+func TestNewRejectsDirectoryWithoutManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "not_a_project_")
+	if err != nil {
+		t.Fatalf("Unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := New(dir); err == nil {
+		t.Errorf("Expected an error for a directory without a manifest")
+	}
+}
+
+func TestNewDefaultsToTheDefaultEnvironment(t *testing.T) {
+	p, err := New(projectDir(t, "{}"))
+
+	if err != nil {
+		t.Fatalf("New failed: %s", err.Error())
+	}
+	if len(p.Environments) != 1 || p.Environments[0] != common.DefaultEnvDir {
+		t.Errorf("Environments = %v, want the default environment", p.Environments)
+	}
+}
+
+func TestManifestIsReadFromTheProjectRoot(t *testing.T) {
+	p, err := New(projectDir(t, `{"Language": "java"}`), "ci")
+	if err != nil {
+		t.Fatalf("New failed: %s", err.Error())
+	}
+
+	m, err := p.Manifest()
+
+	if err != nil {
+		t.Fatalf("Manifest failed: %s", err.Error())
+	}
+	if m.Language != "java" {
+		t.Errorf("Language = %s, want java", m.Language)
+	}
+}
+
+func TestActivatePointsTheGlobalsAtTheProject(t *testing.T) {
+	oldRoot := config.ProjectRoot
+	defer func() { config.ProjectRoot = oldRoot }()
+	p, err := New(projectDir(t, "{}"))
+	if err != nil {
+		t.Fatalf("New failed: %s", err.Error())
+	}
+
+	if err := p.Activate(); err != nil {
+		t.Fatalf("Activate failed: %s", err.Error())
+	}
+
+	if config.ProjectRoot != p.Root {
+		t.Errorf("config.ProjectRoot = %s, want %s", config.ProjectRoot, p.Root)
+	}
+}
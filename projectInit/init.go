@@ -39,6 +39,15 @@ type templateMetadata struct {
 	Version        string
 	PostInstallCmd string
 	PostInstallMsg string
+	Variables      []templateVariable
+}
+
+// templateVariable is a placeholder declared in a template's metadata. Its
+// {{name}} occurrences in the template files are replaced on init.
+type templateVariable struct {
+	Name        string
+	Description string
+	Default     string
 }
 
 func initializeTemplate(templateName string) error {
@@ -135,6 +144,12 @@ func InitializeProject(templateName string, silent bool) {
 	if isGaugeProject() {
 		logger.Fatalf(true, "This is already a Gauge Project. Please try to initialize a Gauge project in a different location.")
 	}
+	if isTemplateSource(templateName) {
+		if err = initializeFromTemplateSource(templateName, silent); err != nil {
+			logger.Fatalf(true, "Failed to initialize project. %s", err.Error())
+		}
+		return
+	}
 	exists, _ := common.UrlExists(getTemplateURL(templateName))
 	if exists {
 		err = initializeTemplate(templateName)
@@ -9,12 +9,12 @@ package projectInit
 import (
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"path/filepath"
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
 )
 
 type template struct {
@@ -32,7 +32,7 @@ func ListTemplates() {
 	if err != nil {
 		logger.Fatalf(true, "Gauge templates URL %s is not reachable: %s", templatesURL, err.Error())
 	}
-	res, err := http.Get(templatesURL)
+	res, err := util.GetWithAuth(templatesURL)
 	if err != nil {
 		logger.Fatalf(true, "Error occurred while downloading templates list from %s: %s", templatesURL, err.Error())
 	}
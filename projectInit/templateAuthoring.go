@@ -0,0 +1,134 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package projectInit
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+)
+
+const exampleSpecContent = `# {{project_name}}
+
+## Example scenario
+
+* Say "hello" to "{{project_name}}"
+`
+
+// CreateTemplate scaffolds a new template directory: a metadata file
+// declaring the template's variables and post install hooks, and an example
+// spec using the built in {{project_name}} variable.
+func CreateTemplate(name string) error {
+	if common.DirExists(name) {
+		return fmt.Errorf("directory %s already exists", name)
+	}
+	if err := os.MkdirAll(filepath.Join(name, specsDirName), common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	metadata := &templateMetadata{
+		Name:        name,
+		Description: fmt.Sprintf("Gauge template %s", name),
+		Version:     "0.0.1",
+		Variables:   []templateVariable{},
+	}
+	contents, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := common.SaveFile(filepath.Join(name, metadataFileName), string(contents), false); err != nil {
+		return err
+	}
+	if err := common.SaveFile(filepath.Join(name, specsDirName, skelFileName), exampleSpecContent, false); err != nil {
+		return err
+	}
+	logger.Infof(true, "Successfully created template %s. Edit %s to declare variables and post install hooks.", name, filepath.Join(name, metadataFileName))
+	return nil
+}
+
+// PackTemplate zips the template directory into <name>.zip, the layout
+// `gauge init` downloads from the templates URL: a top level directory named
+// after the template, containing the template files.
+func PackTemplate(templateDir string) (string, error) {
+	metadata, err := loadTemplateMetadata(templateDir)
+	if err != nil {
+		return "", err
+	}
+	if metadata == nil {
+		return "", fmt.Errorf("%s is not a template directory, %s not found", templateDir, metadataFileName)
+	}
+	if metadata.Name == "" {
+		return "", fmt.Errorf("template metadata in %s does not have a name", templateDir)
+	}
+	zipFileName := metadata.Name + ".zip"
+	zipFile, err := os.Create(zipFileName)
+	if err != nil {
+		return "", err
+	}
+	defer zipFile.Close()
+	writer := zip.NewWriter(zipFile)
+	defer writer.Close()
+	err = filepath.Walk(templateDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(templateDir, path)
+		if err != nil {
+			return err
+		}
+		entry, err := writer.Create(filepath.ToSlash(filepath.Join(metadata.Name, relPath)))
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(entry, file)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	logger.Infof(true, "Successfully packed template %s into %s.", metadata.Name, zipFileName)
+	return zipFileName, nil
+}
+
+// PublishTemplate uploads the packed template zip to the configured
+// templates URL, so it can be initialized by name with `gauge init`.
+func PublishTemplate(zipFileName string) error {
+	if !strings.HasSuffix(zipFileName, ".zip") {
+		return fmt.Errorf("%s is not a packed template, publish takes the zip created by `gauge template pack`", zipFileName)
+	}
+	contents, err := ioutil.ReadFile(zipFileName)
+	if err != nil {
+		return err
+	}
+	templatesURL := config.GaugeTemplatesUrl()
+	uploadURL := templatesURL + "/" + filepath.Base(zipFileName)
+	res, err := http.Post(uploadURL, "application/zip", bytes.NewReader(contents))
+	if err != nil {
+		return fmt.Errorf("failed to publish template to %s: %s", uploadURL, err.Error())
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 400 {
+		return fmt.Errorf("failed to publish template to %s: %s", uploadURL, res.Status)
+	}
+	logger.Infof(true, "Successfully published %s to %s.", zipFileName, templatesURL)
+	return nil
+}
@@ -0,0 +1,80 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package projectInit
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestCreateTemplateScaffoldsMetadataAndExampleSpec(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-template")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	wd, _ := os.Getwd()
+	c.Assert(os.Chdir(dir), IsNil)
+	defer os.Chdir(wd)
+
+	c.Assert(CreateTemplate("my_template"), IsNil)
+
+	metadata, err := loadTemplateMetadata(filepath.Join(dir, "my_template"))
+	c.Assert(err, IsNil)
+	c.Assert(metadata, NotNil)
+	c.Assert(metadata.Name, Equals, "my_template")
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "my_template", specsDirName, skelFileName))
+	c.Assert(err, IsNil)
+	c.Assert(string(contents), Matches, `(?s).*\{\{project_name\}\}.*`)
+}
+
+func (s *MySuite) TestCreateTemplateFailsForExistingDirectory(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-template")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	wd, _ := os.Getwd()
+	c.Assert(os.Chdir(dir), IsNil)
+	defer os.Chdir(wd)
+	c.Assert(os.Mkdir("my_template", 0755), IsNil)
+
+	c.Assert(CreateTemplate("my_template"), ErrorMatches, "directory my_template already exists")
+}
+
+func (s *MySuite) TestPackTemplateZipsFilesUnderTemplateName(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-template")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	wd, _ := os.Getwd()
+	c.Assert(os.Chdir(dir), IsNil)
+	defer os.Chdir(wd)
+	c.Assert(CreateTemplate("my_template"), IsNil)
+
+	zipFileName, err := PackTemplate("my_template")
+	c.Assert(err, IsNil)
+	c.Assert(zipFileName, Equals, "my_template.zip")
+
+	reader, err := zip.OpenReader(filepath.Join(dir, zipFileName))
+	c.Assert(err, IsNil)
+	defer reader.Close()
+	names := make(map[string]bool)
+	for _, file := range reader.File {
+		names[file.Name] = true
+	}
+	c.Assert(names["my_template/metadata.json"], Equals, true)
+	c.Assert(names["my_template/specs/example.spec"], Equals, true)
+}
+
+func (s *MySuite) TestPackTemplateFailsWithoutMetadata(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-template")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	_, err = PackTemplate(dir)
+	c.Assert(err, ErrorMatches, ".*not a template directory.*")
+}
@@ -0,0 +1,195 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package projectInit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	projectNameVariable = "{{project_name}}"
+	packageVariable     = "{{package}}"
+	gitDirName          = ".git"
+)
+
+// isTemplateSource reports whether the template argument is a git URL or a
+// local directory rather than a template name to fetch from
+// gauge_templates_url.
+func isTemplateSource(templateName string) bool {
+	if strings.HasSuffix(templateName, ".git") || strings.HasPrefix(templateName, "git@") {
+		return true
+	}
+	if strings.HasPrefix(templateName, "http://") || strings.HasPrefix(templateName, "https://") {
+		return true
+	}
+	return common.DirExists(templateName)
+}
+
+// initializeFromTemplateSource initializes the project from a git repository
+// or a local directory. Occurrences of {{project_name}} and {{package}} in
+// the template files are replaced with values prompted from the user; with
+// silent, the defaults are used without prompting.
+func initializeFromTemplateSource(templateSource string, silent bool) error {
+	tempDir := common.GetTempDir()
+	defer util.Remove(tempDir)
+	templateDir, err := fetchTemplateSource(templateSource, tempDir)
+	if err != nil {
+		return err
+	}
+	metadata, err := loadTemplateMetadata(templateDir)
+	if err != nil {
+		return err
+	}
+	variables := templateVariables(metadata, silent)
+	if err := substituteTemplateVariables(templateDir, variables); err != nil {
+		return err
+	}
+
+	wd := config.ProjectRoot
+	if common.FileExists(gitignoreFileName) {
+		templateGitIgnore := filepath.Join(templateDir, gitignoreFileName)
+		if common.FileExists(templateGitIgnore) {
+			if err := common.AppendToFile(gitignoreFileName, templateGitIgnore); err != nil {
+				return err
+			}
+		}
+	}
+	logger.Infof(true, "Copying template %s to current directory ...", templateSource)
+	if _, err := common.MirrorDir(templateDir, wd); err != nil {
+		return fmt.Errorf("Failed to copy template: %s", err.Error())
+	}
+
+	metadataFile := filepath.Join(wd, metadataFileName)
+	if common.FileExists(metadataFile) {
+		util.Remove(metadataFile)
+	}
+	if metadata != nil && metadata.PostInstallCmd != "" {
+		logger.Debugf(true, "Running post install command %s", metadata.PostInstallCmd)
+		cmd, err := common.ExecuteSystemCommand(strings.Fields(metadata.PostInstallCmd), wd, os.Stdout, os.Stderr)
+		if err != nil {
+			return fmt.Errorf("Failed to run post install commands: %s", err.Error())
+		}
+		if err = cmd.Wait(); err != nil {
+			return err
+		}
+	}
+	message := fmt.Sprintf("Successfully initialized the project from %s.", templateSource)
+	if metadata != nil && metadata.PostInstallMsg != "" {
+		message = fmt.Sprintf("%s %s", message, metadata.PostInstallMsg)
+	}
+	logger.Infof(true, "%s", message)
+	return nil
+}
+
+// loadTemplateMetadata reads the template's metadata file if it has one.
+func loadTemplateMetadata(templateDir string) (*templateMetadata, error) {
+	metadataFile := filepath.Join(templateDir, metadataFileName)
+	if !common.FileExists(metadataFile) {
+		return nil, nil
+	}
+	contents, err := common.ReadFileContents(metadataFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read file contents of %s: %s", metadataFile, err.Error())
+	}
+	metadata := &templateMetadata{}
+	if err := json.Unmarshal([]byte(contents), metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// fetchTemplateSource clones the git repository, or mirrors the local
+// directory, into tempDir and returns the template's root directory.
+func fetchTemplateSource(templateSource, tempDir string) (string, error) {
+	if common.DirExists(templateSource) {
+		if _, err := common.MirrorDir(templateSource, tempDir); err != nil {
+			return "", fmt.Errorf("Failed to copy template from %s: %s", templateSource, err.Error())
+		}
+	} else {
+		logger.Infof(true, "Cloning template from %s ...", templateSource)
+		cmd, err := common.ExecuteSystemCommand([]string{"git", "clone", "--depth=1", templateSource, tempDir}, config.ProjectRoot, os.Stdout, os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("Failed to clone template from %s: %s", templateSource, err.Error())
+		}
+		if err := cmd.Wait(); err != nil {
+			return "", fmt.Errorf("Failed to clone template from %s: %s", templateSource, err.Error())
+		}
+	}
+	util.Remove(filepath.Join(tempDir, gitDirName))
+	return tempDir, nil
+}
+
+// templateVariables prompts for the project name and package, defaulting
+// both to the name of the current directory, and for every variable the
+// template's metadata declares.
+func templateVariables(metadata *templateMetadata, silent bool) map[string]string {
+	projectName := filepath.Base(config.ProjectRoot)
+	packageName := strings.ToLower(strings.Replace(projectName, "-", "", -1))
+	if !silent {
+		projectName = prompt("Project name", projectName)
+		packageName = prompt("Package", packageName)
+	}
+	variables := map[string]string{projectNameVariable: projectName, packageVariable: packageName}
+	if metadata == nil {
+		return variables
+	}
+	for _, variable := range metadata.Variables {
+		value := variable.Default
+		if !silent {
+			label := variable.Name
+			if variable.Description != "" {
+				label = fmt.Sprintf("%s (%s)", variable.Name, variable.Description)
+			}
+			value = prompt(label, value)
+		}
+		variables[fmt.Sprintf("{{%s}}", variable.Name)] = value
+	}
+	return variables
+}
+
+func prompt(label, defaultValue string) string {
+	fmt.Printf("%s [%s]: ", label, defaultValue)
+	value, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil || strings.TrimSpace(value) == "" {
+		return defaultValue
+	}
+	return strings.TrimSpace(value)
+}
+
+// substituteTemplateVariables rewrites every file under dir, replacing each
+// template variable with its value. Files without variables are left
+// untouched.
+func substituteTemplateVariables(dir string, variables map[string]string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		contents, err := common.ReadFileContents(path)
+		if err != nil {
+			return err
+		}
+		substituted := contents
+		for variable, value := range variables {
+			substituted = strings.Replace(substituted, variable, value, -1)
+		}
+		if substituted == contents {
+			return nil
+		}
+		return ioutil.WriteFile(path, []byte(substituted), info.Mode())
+	})
+}
@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package projectInit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestIsTemplateSourceForGitURLs(c *C) {
+	c.Assert(isTemplateSource("git@github.com:org/template.git"), Equals, true)
+	c.Assert(isTemplateSource("https://github.com/org/template.git"), Equals, true)
+	c.Assert(isTemplateSource("https://example.com/template"), Equals, true)
+}
+
+func (s *MySuite) TestIsTemplateSourceForTemplateName(c *C) {
+	c.Assert(isTemplateSource("java"), Equals, false)
+	c.Assert(isTemplateSource("java_maven_selenium"), Equals, false)
+}
+
+func (s *MySuite) TestIsTemplateSourceForLocalDir(c *C) {
+	c.Assert(isTemplateSource("_testdata"), Equals, true)
+}
+
+func (s *MySuite) TestSubstituteTemplateVariables(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-template")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "manifest.json")
+	c.Assert(ioutil.WriteFile(file, []byte("name: {{project_name}}, package: {{package}}"), 0644), IsNil)
+
+	err = substituteTemplateVariables(dir, map[string]string{projectNameVariable: "shop", packageVariable: "com.shop"})
+	c.Assert(err, IsNil)
+
+	contents, err := ioutil.ReadFile(file)
+	c.Assert(err, IsNil)
+	c.Assert(string(contents), Equals, "name: shop, package: com.shop")
+}
+
+func (s *MySuite) TestTemplateVariablesDefaults(c *C) {
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = filepath.Join("some", "path", "my-project")
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	variables := templateVariables(nil, true)
+
+	c.Assert(variables[projectNameVariable], Equals, "my-project")
+	c.Assert(variables[packageVariable], Equals, "myproject")
+}
+
+func (s *MySuite) TestTemplateVariablesFromMetadata(c *C) {
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = filepath.Join("some", "path", "my-project")
+	defer func() { config.ProjectRoot = oldRoot }()
+	metadata := &templateMetadata{Variables: []templateVariable{{Name: "base_url", Default: "http://localhost"}}}
+
+	variables := templateVariables(metadata, true)
+
+	c.Assert(variables["{{base_url}}"], Equals, "http://localhost")
+}
@@ -0,0 +1,70 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package refactor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+// printDiff prints each edit's removed and added lines, diff style.
+func printDiff(edits []fileEdit) {
+	for _, edit := range edits {
+		logger.Infof(true, "\n--- %s", util.RelPathToProjectRoot(edit.FileName))
+		for _, line := range diffLines(edit.OldContent, edit.NewContent) {
+			logger.Infof(true, "%s", line)
+		}
+	}
+}
+
+// diffLines compares two file contents line by line and returns the removed
+// and added lines prefixed with "-" and "+", in file order. Unchanged lines
+// are omitted. Spec files are small, so the quadratic longest common
+// subsequence is fine here.
+func diffLines(oldContent, newContent string) []string {
+	oldLines := strings.Split(oldContent, "\n")
+	newLines := strings.Split(newContent, "\n")
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var diff []string
+	i, j := 0, 0
+	for i < len(oldLines) && j < len(newLines) {
+		switch {
+		case oldLines[i] == newLines[j]:
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, fmt.Sprintf("- %s", oldLines[i]))
+			i++
+		default:
+			diff = append(diff, fmt.Sprintf("+ %s", newLines[j]))
+			j++
+		}
+	}
+	for ; i < len(oldLines); i++ {
+		diff = append(diff, fmt.Sprintf("- %s", oldLines[i]))
+	}
+	for ; j < len(newLines); j++ {
+		diff = append(diff, fmt.Sprintf("+ %s", newLines[j]))
+	}
+	return diff
+}
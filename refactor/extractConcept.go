@@ -0,0 +1,225 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package refactor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+// stepSequenceOccurrence is one place where the extracted step sequence
+// appears: the lines startLine through endLine of a spec file.
+type stepSequenceOccurrence struct {
+	fileName  string
+	startLine int
+	endLine   int
+}
+
+// ExtractConcept extracts the steps between startLine and endLine of the
+// given spec file into a concept named conceptName in conceptFileName, and
+// replaces every occurrence of that step sequence across the project's specs
+// with the new concept. With dryRun, the diff is printed and nothing is
+// written.
+func ExtractConcept(file string, startLine, endLine int, conceptName, conceptFileName string, specDirs []string, dryRun bool) error {
+	if startLine > endLine || startLine < 1 {
+		return fmt.Errorf("invalid line range %d-%d", startLine, endLine)
+	}
+	if !util.IsConcept(conceptFileName) {
+		return fmt.Errorf("%s is not a concept file, concepts go into .cpt files", conceptFileName)
+	}
+	if strings.TrimSpace(conceptName) == "" {
+		return fmt.Errorf("concept name cannot be empty")
+	}
+	result, specs, dictionary := parseSpecsAndConcepts(specDirs)
+	if !result.Success {
+		return fmt.Errorf("refactoring failed due to parse errors:\n%s", strings.Join(result.Errors, "\n"))
+	}
+	if dictionary.Search(conceptName) != nil {
+		return fmt.Errorf("a concept named %q already exists", conceptName)
+	}
+	sequence, err := stepSequenceIn(specs, absolutePath(file), startLine, endLine)
+	if err != nil {
+		return err
+	}
+	occurrences := findOccurrences(specs, sequence)
+	conceptEdit, err := conceptFileEdit(conceptName, sequence, absolutePath(conceptFileName))
+	if err != nil {
+		return err
+	}
+	occurrenceEdits, err := occurrenceEdits(occurrences, conceptName)
+	if err != nil {
+		return err
+	}
+	edits := append([]fileEdit{conceptEdit}, occurrenceEdits...)
+	if dryRun {
+		logger.Infof(true, "Extracting concept %q would change %d file(s):", conceptName, len(edits))
+		printDiff(edits)
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(conceptEdit.FileName), common.NewDirectoryPermissions); err != nil {
+		return fmt.Errorf("unable to create directory %s: %s", filepath.Dir(conceptEdit.FileName), err.Error())
+	}
+	if err := applyEdits(fmt.Sprintf("extract concept %q", conceptName), edits); err != nil {
+		return err
+	}
+	logger.Infof(true, "Extracted %d step(s) into concept %q in %s.", len(sequence), conceptName, conceptFileName)
+	logger.Infof(true, "Replaced %d occurrence(s) in %d file(s).", len(occurrences), len(occurrenceEdits))
+	return nil
+}
+
+func absolutePath(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(config.ProjectRoot, file)
+}
+
+// stepSequenceIn returns the consecutive steps of the given spec file that
+// lie entirely within the line range. The range must cover whole steps from
+// a single scenario, context or teardown block.
+func stepSequenceIn(specs []*gauge.Specification, file string, startLine, endLine int) ([]*gauge.Step, error) {
+	for _, spec := range specs {
+		if spec.FileName != file {
+			continue
+		}
+		for _, block := range stepBlocks(spec) {
+			var sequence []*gauge.Step
+			for _, step := range block {
+				if step.LineNo >= startLine && step.LineNo <= endLine {
+					sequence = append(sequence, step)
+				}
+			}
+			if len(sequence) == 0 {
+				continue
+			}
+			for _, step := range sequence {
+				if step.HasInlineTable {
+					return nil, fmt.Errorf("step %q has an inline table and cannot be extracted into a concept", strings.TrimSpace(step.LineText))
+				}
+			}
+			return sequence, nil
+		}
+		return nil, fmt.Errorf("no steps found between lines %d and %d in %s", startLine, endLine, util.RelPathToProjectRoot(file))
+	}
+	return nil, fmt.Errorf("%s is not a spec file in the given spec directories", util.RelPathToProjectRoot(file))
+}
+
+// stepBlocks lists the spec's step lists an extracted sequence may not span
+// across: contexts, each scenario's steps and teardown.
+func stepBlocks(spec *gauge.Specification) [][]*gauge.Step {
+	blocks := [][]*gauge.Step{spec.Contexts}
+	for _, scenario := range spec.Scenarios {
+		blocks = append(blocks, scenario.Steps)
+	}
+	return append(blocks, spec.TearDownSteps)
+}
+
+// findOccurrences locates every run of steps matching the extracted
+// sequence's text, across all specs. Matches never overlap.
+func findOccurrences(specs []*gauge.Specification, sequence []*gauge.Step) []stepSequenceOccurrence {
+	texts := stepTexts(sequence)
+	var occurrences []stepSequenceOccurrence
+	for _, spec := range specs {
+		for _, block := range stepBlocks(spec) {
+			for i := 0; i+len(texts) <= len(block); i++ {
+				candidate := block[i : i+len(texts)]
+				if !matches(candidate, texts) {
+					continue
+				}
+				last := candidate[len(candidate)-1]
+				endLine := last.LineNo
+				if last.LineSpanEnd > endLine {
+					endLine = last.LineSpanEnd
+				}
+				occurrences = append(occurrences, stepSequenceOccurrence{
+					fileName:  spec.FileName,
+					startLine: candidate[0].LineNo,
+					endLine:   endLine,
+				})
+				i += len(texts) - 1
+			}
+		}
+	}
+	return occurrences
+}
+
+func matches(steps []*gauge.Step, texts []string) bool {
+	for i, step := range steps {
+		if step.HasInlineTable || strings.TrimSpace(step.LineText) != texts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func stepTexts(steps []*gauge.Step) []string {
+	texts := make([]string, 0, len(steps))
+	for _, step := range steps {
+		texts = append(texts, strings.TrimSpace(step.LineText))
+	}
+	return texts
+}
+
+// conceptFileEdit builds the edit that appends the new concept to the
+// concept file, which may not exist yet.
+func conceptFileEdit(conceptName string, sequence []*gauge.Step, conceptFileName string) (fileEdit, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", conceptName)
+	for _, text := range stepTexts(sequence) {
+		fmt.Fprintf(&b, "%s %s\n", env.StepBulletStyle(), text)
+	}
+	content := b.String()
+	oldContent := ""
+	if existing, err := common.ReadFileContents(conceptFileName); err == nil {
+		oldContent = existing
+		if strings.TrimSpace(existing) != "" {
+			content = strings.TrimRight(existing, "\n") + "\n\n" + content
+		}
+	}
+	return fileEdit{FileName: conceptFileName, OldContent: oldContent, NewContent: content}, nil
+}
+
+// occurrenceEdits builds one edit per file, rewriting each occurrence's
+// lines with a single usage of the concept. Occurrences are applied bottom
+// up so earlier line numbers stay valid.
+func occurrenceEdits(occurrences []stepSequenceOccurrence, conceptName string) ([]fileEdit, error) {
+	byFile := make(map[string][]stepSequenceOccurrence)
+	for _, o := range occurrences {
+		byFile[o.fileName] = append(byFile[o.fileName], o)
+	}
+	files := make([]string, 0, len(byFile))
+	for file := range byFile {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	var edits []fileEdit
+	for _, file := range files {
+		fileOccurrences := byFile[file]
+		sort.Slice(fileOccurrences, func(i, j int) bool { return fileOccurrences[i].startLine > fileOccurrences[j].startLine })
+		content, err := common.ReadFileContents(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %s", file, err.Error())
+		}
+		lines := strings.Split(content, "\n")
+		for _, o := range fileOccurrences {
+			usage := []string{fmt.Sprintf("%s %s", env.StepBulletStyle(), conceptName)}
+			lines = append(lines[:o.startLine-1], append(usage, lines[o.endLine:]...)...)
+		}
+		edits = append(edits, fileEdit{FileName: file, OldContent: content, NewContent: strings.Join(lines, "\n")})
+	}
+	return edits, nil
+}
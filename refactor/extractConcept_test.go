@@ -0,0 +1,94 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package refactor
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func step(lineText string, lineNo int) *gauge.Step {
+	return &gauge.Step{LineText: lineText, LineNo: lineNo, Value: lineText}
+}
+
+func specWithScenarioSteps(fileName string, steps ...*gauge.Step) *gauge.Specification {
+	return &gauge.Specification{
+		FileName:  fileName,
+		Heading:   &gauge.Heading{Value: "Spec"},
+		Scenarios: []*gauge.Scenario{{Heading: &gauge.Heading{Value: "Scenario"}, Steps: steps}},
+	}
+}
+
+func (s *MySuite) TestStepSequenceInPicksStepsWithinRange(c *C) {
+	spec := specWithScenarioSteps("/project/specs/login.spec",
+		step("Open the login page", 5),
+		step("Enter credentials", 6),
+		step("See the dashboard", 7),
+	)
+
+	sequence, err := stepSequenceIn([]*gauge.Specification{spec}, "/project/specs/login.spec", 5, 6)
+
+	c.Assert(err, IsNil)
+	c.Assert(len(sequence), Equals, 2)
+	c.Assert(sequence[0].LineText, Equals, "Open the login page")
+	c.Assert(sequence[1].LineText, Equals, "Enter credentials")
+}
+
+func (s *MySuite) TestStepSequenceInFailsWhenRangeHasNoSteps(c *C) {
+	spec := specWithScenarioSteps("/project/specs/login.spec", step("Open the login page", 5))
+
+	_, err := stepSequenceIn([]*gauge.Specification{spec}, "/project/specs/login.spec", 10, 12)
+
+	c.Assert(err, NotNil)
+	c.Assert(err, ErrorMatches, "no steps found between lines 10 and 12 in.*")
+}
+
+func (s *MySuite) TestStepSequenceInRejectsInlineTables(c *C) {
+	tableStep := step("Enter users", 6)
+	tableStep.HasInlineTable = true
+	spec := specWithScenarioSteps("/project/specs/login.spec", step("Open the login page", 5), tableStep)
+
+	_, err := stepSequenceIn([]*gauge.Specification{spec}, "/project/specs/login.spec", 5, 6)
+
+	c.Assert(err, NotNil)
+	c.Assert(err, ErrorMatches, ".*inline table.*")
+}
+
+func (s *MySuite) TestFindOccurrencesAcrossSpecs(c *C) {
+	sequence := []*gauge.Step{step("Open the login page", 5), step("Enter credentials", 6)}
+	specA := specWithScenarioSteps("/project/specs/a.spec",
+		step("Open the login page", 5),
+		step("Enter credentials", 6),
+		step("See the dashboard", 7),
+	)
+	specB := specWithScenarioSteps("/project/specs/b.spec",
+		step("Something else", 4),
+		step("Open the login page", 5),
+		step("Enter credentials", 6),
+	)
+
+	occurrences := findOccurrences([]*gauge.Specification{specA, specB}, sequence)
+
+	c.Assert(len(occurrences), Equals, 2)
+	c.Assert(occurrences[0].fileName, Equals, "/project/specs/a.spec")
+	c.Assert(occurrences[0].startLine, Equals, 5)
+	c.Assert(occurrences[0].endLine, Equals, 6)
+	c.Assert(occurrences[1].fileName, Equals, "/project/specs/b.spec")
+	c.Assert(occurrences[1].startLine, Equals, 5)
+}
+
+func (s *MySuite) TestFindOccurrencesDoesNotMatchPartialSequences(c *C) {
+	sequence := []*gauge.Step{step("Open the login page", 5), step("Enter credentials", 6)}
+	spec := specWithScenarioSteps("/project/specs/a.spec",
+		step("Open the login page", 5),
+		step("See the dashboard", 6),
+	)
+
+	occurrences := findOccurrences([]*gauge.Specification{spec}, sequence)
+
+	c.Assert(len(occurrences), Equals, 0)
+}
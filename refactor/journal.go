@@ -0,0 +1,105 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package refactor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const refactorJournalFile = "refactor_journal.json"
+
+// fileEdit is one file modification made by a refactoring, with enough
+// information to show a diff and to revert the change.
+type fileEdit struct {
+	FileName   string `json:"fileName"`
+	OldContent string `json:"oldContent"`
+	NewContent string `json:"newContent"`
+}
+
+// refactorJournal records the file modifications of the last refactoring in
+// .gauge/refactor_journal.json, so it can be reverted with --undo-last.
+type refactorJournal struct {
+	Timestamp   time.Time  `json:"timestamp"`
+	Description string     `json:"description"`
+	Edits       []fileEdit `json:"edits"`
+}
+
+func journalPath() string {
+	return filepath.Join(config.ProjectRoot, common.DotGauge, refactorJournalFile)
+}
+
+// applyEdits journals the given edits and then writes them to disk. The
+// journal is written first: if writing a file fails halfway, the journal
+// still describes how to restore every file.
+func applyEdits(description string, edits []fileEdit) error {
+	if err := writeJournal(description, edits); err != nil {
+		return err
+	}
+	for _, edit := range edits {
+		util.SaveFile(edit.FileName, edit.NewContent, true)
+	}
+	return nil
+}
+
+func writeJournal(description string, edits []fileEdit) error {
+	journal := &refactorJournal{Timestamp: time.Now(), Description: description, Edits: edits}
+	contents, err := json.MarshalIndent(journal, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal refactoring journal: %s", err.Error())
+	}
+	dotGaugeDir := filepath.Join(config.ProjectRoot, common.DotGauge)
+	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+		return fmt.Errorf("unable to create %s: %s", dotGaugeDir, err.Error())
+	}
+	if err := ioutil.WriteFile(journalPath(), contents, common.NewFilePermissions); err != nil {
+		return fmt.Errorf("unable to write refactoring journal: %s", err.Error())
+	}
+	return nil
+}
+
+// UndoLast reverts the file modifications recorded by the last refactoring.
+// Files that were edited again after the refactoring are left alone with a
+// warning, so unrelated work is never overwritten.
+func UndoLast() error {
+	contents, err := ioutil.ReadFile(journalPath())
+	if err != nil {
+		return fmt.Errorf("no refactoring journal found, nothing to undo")
+	}
+	journal := &refactorJournal{}
+	if err := json.Unmarshal(contents, journal); err != nil {
+		return fmt.Errorf("unable to read refactoring journal: %s", err.Error())
+	}
+	reverted := 0
+	for _, edit := range journal.Edits {
+		current, err := common.ReadFileContents(edit.FileName)
+		if err != nil {
+			logger.Warningf(true, "Skipping %s: %s", util.RelPathToProjectRoot(edit.FileName), err.Error())
+			continue
+		}
+		if current != edit.NewContent {
+			logger.Warningf(true, "Skipping %s: modified since the refactoring.", util.RelPathToProjectRoot(edit.FileName))
+			continue
+		}
+		util.SaveFile(edit.FileName, edit.OldContent, true)
+		reverted++
+	}
+	if err := os.Remove(journalPath()); err != nil {
+		logger.Warningf(true, "Unable to remove refactoring journal: %s", err.Error())
+	}
+	logger.Infof(true, "Reverted %q: restored %d of %d file(s).", journal.Description, reverted, len(journal.Edits))
+	return nil
+}
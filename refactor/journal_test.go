@@ -0,0 +1,99 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestApplyEditsWritesJournalAndFiles(c *C) {
+	projectRoot, err := ioutil.TempDir("", "gauge-refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(projectRoot)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = projectRoot
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	specFile := filepath.Join(projectRoot, "example.spec")
+	c.Assert(ioutil.WriteFile(specFile, []byte("old content"), 0644), IsNil)
+
+	err = applyEdits("test refactoring", []fileEdit{{FileName: specFile, OldContent: "old content", NewContent: "new content"}})
+	c.Assert(err, IsNil)
+
+	written, err := ioutil.ReadFile(specFile)
+	c.Assert(err, IsNil)
+	c.Assert(string(written), Equals, "new content")
+	_, err = os.Stat(journalPath())
+	c.Assert(err, IsNil)
+}
+
+func (s *MySuite) TestUndoLastRestoresJournaledContent(c *C) {
+	projectRoot, err := ioutil.TempDir("", "gauge-refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(projectRoot)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = projectRoot
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	specFile := filepath.Join(projectRoot, "example.spec")
+	c.Assert(ioutil.WriteFile(specFile, []byte("old content"), 0644), IsNil)
+	c.Assert(applyEdits("test refactoring", []fileEdit{{FileName: specFile, OldContent: "old content", NewContent: "new content"}}), IsNil)
+
+	c.Assert(UndoLast(), IsNil)
+
+	restored, err := ioutil.ReadFile(specFile)
+	c.Assert(err, IsNil)
+	c.Assert(string(restored), Equals, "old content")
+	_, err = os.Stat(journalPath())
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestUndoLastSkipsFilesModifiedAfterRefactoring(c *C) {
+	projectRoot, err := ioutil.TempDir("", "gauge-refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(projectRoot)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = projectRoot
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	specFile := filepath.Join(projectRoot, "example.spec")
+	c.Assert(ioutil.WriteFile(specFile, []byte("old content"), 0644), IsNil)
+	c.Assert(applyEdits("test refactoring", []fileEdit{{FileName: specFile, OldContent: "old content", NewContent: "new content"}}), IsNil)
+	c.Assert(ioutil.WriteFile(specFile, []byte("edited afterwards"), 0644), IsNil)
+
+	c.Assert(UndoLast(), IsNil)
+
+	current, err := ioutil.ReadFile(specFile)
+	c.Assert(err, IsNil)
+	c.Assert(string(current), Equals, "edited afterwards")
+}
+
+func (s *MySuite) TestUndoLastWithoutJournal(c *C) {
+	projectRoot, err := ioutil.TempDir("", "gauge-refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(projectRoot)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = projectRoot
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	c.Assert(UndoLast(), ErrorMatches, "no refactoring journal found, nothing to undo")
+}
+
+func (s *MySuite) TestDiffLinesListsRemovedAndAddedLines(c *C) {
+	diff := diffLines("a\nb\nc", "a\nx\nc")
+
+	c.Assert(diff, DeepEquals, []string{"- b", "+ x"})
+}
+
+func (s *MySuite) TestDiffLinesWithIdenticalContent(c *C) {
+	c.Assert(len(diffLines("a\nb", "a\nb")), Equals, 0)
+}
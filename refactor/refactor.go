@@ -19,9 +19,11 @@ package refactor
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/formatter"
 	"github.com/getgauge/gauge/gauge"
@@ -37,6 +39,14 @@ type rephraseRefactorer struct {
 	newStep   *gauge.Step
 	isConcept bool
 	runner    runner.Runner
+	// stepNameResponse caches the runner's description of the implementation
+	// the old step maps to, so it is requested only once per refactoring.
+	stepNameResponse *gauge_messages.StepNameResponse
+	// aliasSteps holds the implementation's other step texts when the old
+	// step is one of several aliases; they are rephrased along with it.
+	aliasSteps     []*gauge.Step
+	matchedAlias   string
+	aliasesChanged []string
 }
 
 type refactoringResult struct {
@@ -46,6 +56,12 @@ type refactoringResult struct {
 	RunnerFilesChanged []*gauge_messages.FileChanges
 	Errors             []string
 	Warnings           []string
+	// MatchedAlias is the step text the implementation was matched by, set
+	// only when the refactored step has aliases.
+	MatchedAlias string
+	// AliasesChanged lists the implementation's other step texts that were
+	// rephrased together with the matched one.
+	AliasesChanged []string
 }
 
 func (refactoringResult *refactoringResult) String() string {
@@ -54,6 +70,10 @@ func (refactoringResult *refactoringResult) String() string {
 	result += fmt.Sprintf("Concepts changed     : %s\n", refactoringResult.conceptFilesChanged())
 	result += fmt.Sprintf("Source files changed : %s\n", refactoringResult.runnerFilesChanged())
 	result += fmt.Sprintf("Warnings             : %s\n", refactoringResult.Warnings)
+	if refactoringResult.MatchedAlias != "" {
+		result += fmt.Sprintf("Alias matched        : %s\n", refactoringResult.MatchedAlias)
+		result += fmt.Sprintf("Aliases changed      : %s\n", refactoringResult.AliasesChanged)
+	}
 	return result
 }
 
@@ -102,14 +122,37 @@ func (refactoringResult *refactoringResult) WriteToDisk() {
 	if !refactoringResult.Success {
 		return
 	}
-	for _, fileChange := range refactoringResult.SpecsChanged {
-		util.SaveFile(fileChange.FileName, fileChange.FileContent, true)
+	changes := append(refactoringResult.SpecsChanged, refactoringResult.ConceptsChanged...)
+	edits, err := editsFromFileChanges(changes)
+	if err == nil {
+		if err = applyEdits("step rephrase", edits); err == nil {
+			return
+		}
 	}
-	for _, fileChange := range refactoringResult.ConceptsChanged {
+	logger.Warningf(true, "Unable to journal refactoring, writing changes without undo support: %s", err.Error())
+	for _, fileChange := range changes {
 		util.SaveFile(fileChange.FileName, fileChange.FileContent, true)
 	}
 }
 
+// editsFromFileChanges pairs each file change with the file's current
+// content, so the change can be journalled and undone.
+func editsFromFileChanges(changes []*gauge_messages.FileChanges) ([]fileEdit, error) {
+	var edits []fileEdit
+	for _, change := range changes {
+		oldContent := ""
+		if _, err := os.Stat(change.FileName); err == nil {
+			content, err := common.ReadFileContents(change.FileName)
+			if err != nil {
+				return nil, err
+			}
+			oldContent = content
+		}
+		edits = append(edits, fileEdit{FileName: change.FileName, OldContent: oldContent, NewContent: change.FileContent})
+	}
+	return edits, nil
+}
+
 // GetRefactoringChanges given an old step and new step gives the list of steps that need to be changed to perform refactoring.
 // It also provides the changes to be made on the implementation files.
 func GetRefactoringChanges(oldStep, newStep string, r runner.Runner, specDirs []string, saveToDisk bool) *refactoringResult {
@@ -176,15 +219,67 @@ func addErrorsAndWarningsToRefactoringResult(refactorResult *refactoringResult,
 }
 
 func (agent *rephraseRefactorer) getRefactoringChangesFor(specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary, saveToDisk bool) *refactoringResult {
+	agent.discoverAliases()
 	specsRefactored, conceptFilesRefactored := agent.rephraseInSpecsAndConcepts(&specs, conceptDictionary)
 	result := agent.refactorStepImplementations(saveToDisk)
 	if !result.Success {
 		return result
 	}
+	result.MatchedAlias = agent.matchedAlias
+	result.AliasesChanged = agent.aliasesChanged
 	result.SpecsChanged, result.ConceptsChanged = getFileChanges(specs, conceptDictionary, specsRefactored, conceptFilesRefactored)
 	return result
 }
 
+// discoverAliases asks the runner whether the old step is one of several
+// texts mapping to the same implementation, and prepares the other texts for
+// rephrasing so all aliases are refactored together. Aliases are assumed to
+// share the implementation's parameter list.
+func (agent *rephraseRefactorer) discoverAliases() {
+	if agent.runner == nil {
+		return
+	}
+	response, err := agent.stepNameResponseFromRunner(agent.runner)
+	if err != nil || !response.GetIsStepPresent() || !response.GetHasAlias() {
+		return
+	}
+	agent.matchedAlias = agent.matchedAliasName(response)
+	for _, name := range response.GetStepName() {
+		aliasStep, ok := stepFromName(name)
+		if !ok || aliasStep.Value == agent.oldStep.Value {
+			continue
+		}
+		agent.aliasSteps = append(agent.aliasSteps, aliasStep)
+		agent.aliasesChanged = append(agent.aliasesChanged, name)
+	}
+}
+
+// matchedAliasName picks, out of the implementation's aliases, the text the
+// user named, so the refactor request renames the annotation that was
+// actually matched.
+func (agent *rephraseRefactorer) matchedAliasName(response *gauge_messages.StepNameResponse) string {
+	for _, name := range response.GetStepName() {
+		if stepValue, err := parser.ExtractStepValueAndParams(name, false); err == nil && stepValue.StepValue == agent.oldStep.Value {
+			return name
+		}
+	}
+	return response.GetStepName()[0]
+}
+
+// stepFromName builds a step out of one of the texts the runner reports for
+// an implementation.
+func stepFromName(name string) (*gauge.Step, bool) {
+	stepTokens, errs := new(parser.SpecParser).GenerateTokens("* "+name, "")
+	if len(errs) > 0 || len(stepTokens) == 0 {
+		return nil, false
+	}
+	step, parseRes := parser.CreateStepUsingLookup(stepTokens[0], nil, "")
+	if step == nil || (parseRes != nil && len(parseRes.ParseErrors) > 0) {
+		return nil, false
+	}
+	return step, true
+}
+
 func (agent *rephraseRefactorer) refactorStepImplementations(shouldSaveChanges bool) *refactoringResult {
 	result := &refactoringResult{Success: false, Errors: make([]string, 0), Warnings: make([]string, 0)}
 	if !agent.isConcept {
@@ -212,10 +307,13 @@ func (agent *rephraseRefactorer) rephraseInSpecsAndConcepts(specs *[]*gauge.Spec
 	specsRefactored := make(map[*gauge.Specification][]*gauge.StepDiff)
 	conceptsRefactored := make(map[string][]*gauge.StepDiff)
 	orderMap := agent.createOrderOfArgs()
+	oldSteps := append([]*gauge.Step{agent.oldStep}, agent.aliasSteps...)
 	for _, spec := range *specs {
-		diffs, isRefactored := spec.RenameSteps(agent.oldStep, agent.newStep, orderMap)
-		if isRefactored {
-			specsRefactored[spec] = diffs
+		for _, oldStep := range oldSteps {
+			diffs, isRefactored := spec.RenameSteps(oldStep, agent.newStep, orderMap)
+			if isRefactored {
+				specsRefactored[spec] = append(specsRefactored[spec], diffs...)
+			}
 		}
 	}
 	isConcept := false
@@ -223,9 +321,11 @@ func (agent *rephraseRefactorer) rephraseInSpecsAndConcepts(specs *[]*gauge.Spec
 		isRefactored := false
 		for _, item := range concept.ConceptStep.Items {
 			if item.Kind() == gauge.StepKind {
-				diff, isRefactored := item.(*gauge.Step).Rename(agent.oldStep, agent.newStep, isRefactored, orderMap, &isConcept)
-				if isRefactored {
-					conceptsRefactored[concept.FileName] = append(conceptsRefactored[concept.FileName], diff)
+				for _, oldStep := range oldSteps {
+					diff, isRefactored := item.(*gauge.Step).Rename(oldStep, agent.newStep, isRefactored, orderMap, &isConcept)
+					if isRefactored {
+						conceptsRefactored[concept.FileName] = append(conceptsRefactored[concept.FileName], diff)
+					}
 				}
 			}
 		}
@@ -336,22 +436,36 @@ func (agent *rephraseRefactorer) generateNewStepName(args []string, orderMap map
 }
 
 func (agent *rephraseRefactorer) getStepNameFromRunner(r runner.Runner) (string, error, *parser.Warning) {
-	stepNameMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_StepNameRequest, StepNameRequest: &gauge_messages.StepNameRequest{StepValue: agent.oldStep.Value}}
-	responseMessage, err := r.ExecuteMessageWithTimeout(stepNameMessage)
-
+	response, err := agent.stepNameResponseFromRunner(r)
 	if err != nil {
 		return "", err, nil
 	}
-	if !(responseMessage.GetStepNameResponse().GetIsStepPresent()) {
+	if !response.GetIsStepPresent() {
 		return "", nil, &parser.Warning{Message: fmt.Sprintf("Step implementation not found: %s", agent.oldStep.LineText)}
 	}
-	if responseMessage.GetStepNameResponse().GetHasAlias() {
-		return "", fmt.Errorf("steps with aliases : '%s' cannot be refactored", strings.Join(responseMessage.GetStepNameResponse().GetStepName(), "', '")), nil
+	if response.GetIsExternal() {
+		return "", fmt.Errorf("external step: Cannot refactor '%s' is in external project or library", strings.Join(response.GetStepName(), "', '")), nil
+	}
+	if response.GetHasAlias() {
+		return agent.matchedAliasName(response), nil, nil
+	}
+	return response.GetStepName()[0], nil, nil
+}
+
+// stepNameResponseFromRunner fetches the runner's description of the
+// implementation the old step maps to, requesting it only once per
+// refactoring.
+func (agent *rephraseRefactorer) stepNameResponseFromRunner(r runner.Runner) (*gauge_messages.StepNameResponse, error) {
+	if agent.stepNameResponse != nil {
+		return agent.stepNameResponse, nil
 	}
-	if responseMessage.GetStepNameResponse().GetIsExternal() {
-		return "", fmt.Errorf("external step: Cannot refactor '%s' is in external project or library", strings.Join(responseMessage.GetStepNameResponse().GetStepName(), "', '")), nil
+	stepNameMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_StepNameRequest, StepNameRequest: &gauge_messages.StepNameRequest{StepValue: agent.oldStep.Value}}
+	responseMessage, err := r.ExecuteMessageWithTimeout(stepNameMessage)
+	if err != nil {
+		return nil, err
 	}
-	return responseMessage.GetStepNameResponse().GetStepName()[0], nil, nil
+	agent.stepNameResponse = responseMessage.GetStepNameResponse()
+	return agent.stepNameResponse, nil
 }
 
 func (agent *rephraseRefactorer) createParameterPositions(orderMap map[int]int) []*gauge_messages.ParameterPosition {
@@ -7,6 +7,7 @@
 package refactor
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/getgauge/gauge/gauge_messages"
@@ -220,7 +221,7 @@ func (s *MySuite) TestCreateOrderGivesMapOfOldArgsAndNewArgs(c *C) {
 	step1 := &gauge.Step{Args: []*gauge.StepArg{&gauge.StepArg{Name: "a"}, &gauge.StepArg{Name: "b"}, &gauge.StepArg{Name: "c"}, &gauge.StepArg{Name: "d"}}}
 	step2 := &gauge.Step{Args: []*gauge.StepArg{&gauge.StepArg{Name: "d"}, &gauge.StepArg{Name: "b"}, &gauge.StepArg{Name: "c"}, &gauge.StepArg{Name: "a"}}}
 
-	agent := &rephraseRefactorer{step1, step2, false, nil}
+	agent := &rephraseRefactorer{oldStep: step1, newStep: step2, runner: nil}
 	orderMap := agent.createOrderOfArgs()
 
 	c.Assert(orderMap[0], Equals, 3)
@@ -232,7 +233,7 @@ func (s *MySuite) TestCreateOrderGivesMapOfOldArgsAndNewWhenArgsAreAdded(c *C) {
 	step1 := &gauge.Step{Args: []*gauge.StepArg{&gauge.StepArg{Name: "a"}, &gauge.StepArg{Name: "b"}, &gauge.StepArg{Name: "c"}, &gauge.StepArg{Name: "d"}}}
 	step2 := &gauge.Step{Args: []*gauge.StepArg{&gauge.StepArg{Name: "d"}, &gauge.StepArg{Name: "e"}, &gauge.StepArg{Name: "b"}, &gauge.StepArg{Name: "c"}, &gauge.StepArg{Name: "a"}}}
 
-	agent := &rephraseRefactorer{step1, step2, false, nil}
+	agent := &rephraseRefactorer{oldStep: step1, newStep: step2, runner: nil}
 	orderMap := agent.createOrderOfArgs()
 
 	c.Assert(orderMap[0], Equals, 3)
@@ -246,7 +247,7 @@ func (s *MySuite) TestCreateOrderGivesMapOfOldArgsAndNewWhenArgsAreRemoved(c *C)
 	step1 := &gauge.Step{Args: []*gauge.StepArg{&gauge.StepArg{Name: "a"}, &gauge.StepArg{Name: "b"}, &gauge.StepArg{Name: "c"}, &gauge.StepArg{Name: "d"}}}
 	step2 := &gauge.Step{Args: []*gauge.StepArg{&gauge.StepArg{Name: "d"}, &gauge.StepArg{Name: "b"}, &gauge.StepArg{Name: "c"}}}
 
-	agent := &rephraseRefactorer{step1, step2, false, nil}
+	agent := &rephraseRefactorer{oldStep: step1, newStep: step2, runner: nil}
 	orderMap := agent.createOrderOfArgs()
 
 	c.Assert(orderMap[0], Equals, 3)
@@ -507,3 +508,63 @@ func (s *MySuite) TestRefactoringExternalSteps(c *C) {
 	c.Assert(err.Error(), Equals, "external step: Cannot refactor 'first step' is in external project or library")
 	c.Assert(stepName, Equals, "")
 }
+
+func (s *MySuite) TestRefactoringRenamesAllAliasesTogether(c *C) {
+	oldStep := "first step"
+	aliasStep := "first step alias"
+	newStep := "second step"
+	tokens := []*parser.Token{
+		&parser.Token{Kind: gauge.SpecKind, Value: "Spec Heading", LineNo: 1},
+		&parser.Token{Kind: gauge.ScenarioKind, Value: "Scenario Heading 1", LineNo: 2},
+		&parser.Token{Kind: gauge.StepKind, Value: oldStep, LineNo: 3},
+		&parser.Token{Kind: gauge.StepKind, Value: aliasStep, LineNo: 4},
+	}
+	spec, _, _ := new(parser.SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary(), "")
+	r := &mockRunner{
+		response: &gauge_messages.Message{
+			StepNameResponse: &gauge_messages.StepNameResponse{
+				IsStepPresent: true,
+				HasAlias:      true,
+				StepName:      []string{oldStep, aliasStep},
+			},
+		},
+	}
+	agent, _ := getRefactorAgent(oldStep, newStep, r)
+	specs := append(make([]*gauge.Specification, 0), spec)
+
+	agent.discoverAliases()
+	agent.rephraseInSpecsAndConcepts(&specs, gauge.NewConceptDictionary())
+
+	c.Assert(agent.matchedAlias, Equals, oldStep)
+	c.Assert(len(agent.aliasesChanged), Equals, 1)
+	c.Assert(agent.aliasesChanged[0], Equals, aliasStep)
+	c.Assert(specs[0].Scenarios[0].Steps[0].Value, Equals, newStep)
+	c.Assert(specs[0].Scenarios[0].Steps[1].Value, Equals, newStep)
+}
+
+func (s *MySuite) TestGetStepNameFromRunnerReturnsTheMatchedAlias(c *C) {
+	oldStep := "first step"
+	agent, _ := getRefactorAgent(oldStep, "second step", nil)
+	r := &mockRunner{
+		response: &gauge_messages.Message{
+			StepNameResponse: &gauge_messages.StepNameResponse{
+				IsStepPresent: true,
+				HasAlias:      true,
+				StepName:      []string{"some other alias", oldStep},
+			},
+		},
+	}
+
+	stepName, err, warning := agent.getStepNameFromRunner(r)
+
+	c.Assert(err, IsNil)
+	c.Assert(warning, IsNil)
+	c.Assert(stepName, Equals, oldStep)
+}
+
+func (s *MySuite) TestRefactoringResultReportsTheMatchedAlias(c *C) {
+	result := &refactoringResult{MatchedAlias: "first step", AliasesChanged: []string{"first step alias"}}
+
+	c.Assert(strings.Contains(result.String(), "Alias matched        : first step"), Equals, true)
+	c.Assert(strings.Contains(result.String(), "Aliases changed      : [first step alias]"), Equals, true)
+}
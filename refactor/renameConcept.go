@@ -0,0 +1,151 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package refactor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// RenameConcept renames a concept heading across the project: the definition
+// in its .cpt file, every usage in specs and in other concepts, and the
+// concept's parameter list. Parameters of the new heading are matched to the
+// old heading's by name first and then by position, so parameters can be
+// renamed and reordered in one refactoring. With dryRun, the files that
+// would change are listed and nothing is written.
+func RenameConcept(oldHeading, newHeading string, specDirs []string, dryRun bool) error {
+	agent, parseErrs := getRefactorAgent(oldHeading, newHeading, nil)
+	if len(parseErrs) > 0 {
+		var messages []string
+		for _, e := range parseErrs {
+			messages = append(messages, e.Error())
+		}
+		return fmt.Errorf("failed to parse concept headings:\n%s", strings.Join(messages, "\n"))
+	}
+	result, specs, dictionary := parseSpecsAndConcepts(specDirs)
+	if !result.Success {
+		return fmt.Errorf("refactoring failed due to parse errors:\n%s", strings.Join(result.Errors, "\n"))
+	}
+	concept := dictionary.Search(agent.oldStep.Value)
+	if concept == nil {
+		return fmt.Errorf("no concept found with heading %q", oldHeading)
+	}
+	if agent.newStep.Value != agent.oldStep.Value && dictionary.Search(agent.newStep.Value) != nil {
+		return fmt.Errorf("a concept named %q already exists", newHeading)
+	}
+	orderMap := conceptParamOrder(agent.oldStep, agent.newStep)
+
+	specsRefactored := make(map[*gauge.Specification][]*gauge.StepDiff)
+	for _, spec := range specs {
+		if diffs, isRefactored := spec.RenameSteps(agent.oldStep, agent.newStep, orderMap); isRefactored {
+			specsRefactored[spec] = diffs
+		}
+	}
+	conceptsRefactored := make(map[string][]*gauge.StepDiff)
+	isConcept := false
+	for _, cpt := range dictionary.ConceptsMap {
+		for _, item := range cpt.ConceptStep.Items {
+			if item.Kind() != gauge.StepKind {
+				continue
+			}
+			if diff, isRefactored := item.(*gauge.Step).Rename(agent.oldStep, agent.newStep, false, orderMap, &isConcept); isRefactored {
+				conceptsRefactored[cpt.FileName] = append(conceptsRefactored[cpt.FileName], diff)
+			}
+		}
+	}
+	renameDefinition(concept, agent.newStep, paramRenames(agent.oldStep, agent.newStep, orderMap))
+
+	result.SpecsChanged, result.ConceptsChanged = getFileChanges(specs, dictionary, specsRefactored, conceptsRefactored)
+	edits, err := editsFromFileChanges(append(result.SpecsChanged, result.ConceptsChanged...))
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		logger.Infof(true, "Renaming concept %q would change %d file(s):", oldHeading, len(edits))
+		printDiff(edits)
+		return nil
+	}
+	if err := applyEdits(fmt.Sprintf("rename concept %q to %q", oldHeading, newHeading), edits); err != nil {
+		return err
+	}
+	logger.Infof(true, "Renamed concept %q to %q in %d file(s).", oldHeading, newHeading, len(edits))
+	return nil
+}
+
+// renameDefinition rewrites the concept's own heading with the new text and
+// parameter names, and updates references to renamed parameters in the
+// concept's body steps.
+func renameDefinition(concept *gauge.Concept, newStep *gauge.Step, renames map[string]string) {
+	concept.ConceptStep.Value = newStep.Value
+	args := make([]*gauge.StepArg, len(newStep.Args))
+	for i, arg := range newStep.Args {
+		args[i] = &gauge.StepArg{Name: arg.Value, Value: arg.Value, ArgType: gauge.Dynamic}
+	}
+	concept.ConceptStep.Args = args
+	for i := 1; i < len(concept.ConceptStep.Items); i++ {
+		step, ok := concept.ConceptStep.Items[i].(*gauge.Step)
+		if !ok {
+			continue
+		}
+		for _, arg := range step.Args {
+			if arg.ArgType != gauge.Dynamic {
+				continue
+			}
+			if newName, renamed := renames[arg.Value]; renamed {
+				arg.Name = newName
+				arg.Value = newName
+			}
+		}
+	}
+}
+
+// conceptParamOrder maps each of the new heading's parameters to a parameter
+// of the old heading: by name where names match, by position for the rest.
+// Parameters with no counterpart map to -1 and end up empty in usages.
+func conceptParamOrder(oldStep, newStep *gauge.Step) map[int]int {
+	used := make(map[int]bool)
+	orderMap := make(map[int]int, len(newStep.Args))
+	for i, arg := range newStep.Args {
+		orderMap[i] = -1
+		for j, oldArg := range oldStep.Args {
+			if !used[j] && oldArg.String() == arg.String() {
+				orderMap[i], used[j] = j, true
+				break
+			}
+		}
+	}
+	for i := range newStep.Args {
+		if orderMap[i] != -1 {
+			continue
+		}
+		for j := range oldStep.Args {
+			if !used[j] {
+				orderMap[i], used[j] = j, true
+				break
+			}
+		}
+	}
+	return orderMap
+}
+
+// paramRenames lists the old parameter names that got a new name, so the
+// concept body can follow the rename.
+func paramRenames(oldStep, newStep *gauge.Step, orderMap map[int]int) map[string]string {
+	renames := make(map[string]string)
+	for i, j := range orderMap {
+		if j == -1 {
+			continue
+		}
+		if oldStep.Args[j].Value != newStep.Args[i].Value {
+			renames[oldStep.Args[j].Value] = newStep.Args[i].Value
+		}
+	}
+	return renames
+}
@@ -0,0 +1,51 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package refactor
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestConceptParamOrderMatchesByNameFirst(c *C) {
+	agent, errs := getRefactorAgent("login as <user> with <password>", "sign in with <password> as <user>", nil)
+	c.Assert(len(errs), Equals, 0)
+
+	orderMap := conceptParamOrder(agent.oldStep, agent.newStep)
+
+	c.Assert(orderMap[0], Equals, 1)
+	c.Assert(orderMap[1], Equals, 0)
+}
+
+func (s *MySuite) TestConceptParamOrderFallsBackToPosition(c *C) {
+	agent, errs := getRefactorAgent("login as <user>", "login as <username>", nil)
+	c.Assert(len(errs), Equals, 0)
+
+	orderMap := conceptParamOrder(agent.oldStep, agent.newStep)
+
+	c.Assert(orderMap[0], Equals, 0)
+}
+
+func (s *MySuite) TestConceptParamOrderWithNewParameter(c *C) {
+	agent, errs := getRefactorAgent("login as <user>", "login as <user> on <host>", nil)
+	c.Assert(len(errs), Equals, 0)
+
+	orderMap := conceptParamOrder(agent.oldStep, agent.newStep)
+
+	c.Assert(orderMap[0], Equals, 0)
+	c.Assert(orderMap[1], Equals, -1)
+}
+
+func (s *MySuite) TestParamRenames(c *C) {
+	agent, errs := getRefactorAgent("login as <user> with <password>", "login as <username> with <password>", nil)
+	c.Assert(len(errs), Equals, 0)
+	orderMap := conceptParamOrder(agent.oldStep, agent.newStep)
+
+	renames := paramRenames(agent.oldStep, agent.newStep, orderMap)
+
+	c.Assert(len(renames), Equals, 1)
+	c.Assert(renames["user"], Equals, "username")
+}
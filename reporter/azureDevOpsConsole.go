@@ -0,0 +1,116 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// azureDevOpsConsole reports execution progress as Azure DevOps logging
+// commands: specs become sections, scenarios become collapsible groups, and
+// failures are raised as ##vso[task.logissue] errors that Azure DevOps lists
+// on the run summary with the spec file and line number.
+type azureDevOpsConsole struct {
+	mu     *sync.Mutex
+	writer io.Writer
+}
+
+func newAzureDevOpsConsole(out io.Writer) *azureDevOpsConsole {
+	return &azureDevOpsConsole{mu: &sync.Mutex{}, writer: out}
+}
+
+func (az *azureDevOpsConsole) SuiteStart() {
+}
+
+func (az *azureDevOpsConsole) SpecStart(spec *gauge.Specification, res result.Result) {
+	if res.(*result.SpecResult).Skipped {
+		return
+	}
+	logger.Info(false, formatSpec(spec.Heading.Value))
+	az.writeLine(fmt.Sprintf("##[section]%s", formatSpec(spec.Heading.Value)))
+}
+
+func (az *azureDevOpsConsole) SpecEnd(spec *gauge.Specification, res result.Result) {
+}
+
+func (az *azureDevOpsConsole) ScenarioStart(scenario *gauge.Scenario, i gauge_messages.ExecutionInfo, res result.Result) {
+	if res.(*result.ScenarioResult).ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		return
+	}
+	logger.Info(false, formatScenario(scenario.Heading.Value))
+	az.writeLine(fmt.Sprintf("##[group]%s", formatScenario(scenario.Heading.Value)))
+}
+
+func (az *azureDevOpsConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i gauge_messages.ExecutionInfo) {
+	protoScenario := res.(*result.ScenarioResult).ProtoScenario
+	if protoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		return
+	}
+	if protoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED {
+		az.writeLine(fmt.Sprintf("##vso[task.logissue type=error;sourcepath=%s;linenumber=%d]Scenario failed: %s. %s",
+			i.GetCurrentSpec().GetFileName(), scenario.Heading.LineNo, scenario.Heading.Value, scenarioFailureMessage(protoScenario)))
+	}
+	az.writeLine("##[endgroup]")
+}
+
+func (az *azureDevOpsConsole) StepStart(stepText string) {
+	logger.Debug(false, stepText)
+}
+
+func (az *azureDevOpsConsole) StepEnd(step gauge.Step, res result.Result, execInfo gauge_messages.ExecutionInfo) {
+	stepRes := res.(*result.StepResult)
+	if !stepRes.GetStepFailed() {
+		return
+	}
+	logger.Error(false, prepStepMsg(step.LineText))
+	logger.Error(false, prepErrorMessage(stepRes.ProtoStepExecResult().GetExecutionResult().GetErrorMessage()))
+}
+
+func (az *azureDevOpsConsole) ConceptStart(conceptHeading string) {
+	logger.Debug(false, conceptHeading)
+}
+
+func (az *azureDevOpsConsole) ConceptEnd(res result.Result) {
+}
+
+func (az *azureDevOpsConsole) SuiteEnd(res result.Result) {
+	suiteRes := res.(*result.SuiteResult)
+	for _, e := range suiteRes.UnhandledErrors {
+		logger.Error(false, e.Error())
+		az.writeLine(fmt.Sprintf("##vso[task.logissue type=error]%s", e.Error()))
+	}
+}
+
+func (az *azureDevOpsConsole) DataTable(table string) {
+	logger.Debug(false, table)
+}
+
+func (az *azureDevOpsConsole) Errorf(err string, args ...interface{}) {
+	errorMessage := fmt.Sprintf(err, args...)
+	logger.Error(false, errorMessage)
+	az.writeLine(fmt.Sprintf("##vso[task.logissue type=error]%s", errorMessage))
+}
+
+func (az *azureDevOpsConsole) Write(b []byte) (int, error) {
+	az.mu.Lock()
+	defer az.mu.Unlock()
+	fmt.Fprint(az.writer, string(b))
+	return len(b), nil
+}
+
+func (az *azureDevOpsConsole) writeLine(line string) {
+	az.mu.Lock()
+	defer az.mu.Unlock()
+	fmt.Fprint(az.writer, line+newline)
+}
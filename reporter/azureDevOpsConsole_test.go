@@ -0,0 +1,60 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reporter
+
+import (
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+
+	. "gopkg.in/check.v1"
+)
+
+func setupAzureDevOpsConsole() (*dummyWriter, *azureDevOpsConsole) {
+	dw := newDummyWriter()
+	az := newAzureDevOpsConsole(dw)
+	return dw, az
+}
+
+func (s *MySuite) TestSpecStart_AzureDevOpsConsole(c *C) {
+	dw, az := setupAzureDevOpsConsole()
+	specRes := &result.SpecResult{Skipped: false}
+
+	az.SpecStart(&gauge.Specification{Heading: &gauge.Heading{Value: "Specification heading"}}, specRes)
+
+	c.Assert(dw.output, Equals, "##[section]# Specification heading\n")
+}
+
+func (s *MySuite) TestScenarioIsAGroup_AzureDevOpsConsole(c *C) {
+	dw, az := setupAzureDevOpsConsole()
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My scenario"}}
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_PASSED})
+
+	az.ScenarioStart(scenario, gauge_messages.ExecutionInfo{}, scnRes)
+	az.ScenarioEnd(scenario, scnRes, gauge_messages.ExecutionInfo{})
+
+	c.Assert(dw.output, Equals, "##[group]## My scenario\n##[endgroup]\n")
+}
+
+func (s *MySuite) TestFailedScenarioRaisesLogIssue_AzureDevOpsConsole(c *C) {
+	dw, az := setupAzureDevOpsConsole()
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My scenario", LineNo: 12}}
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{
+		ExecutionStatus: gauge_messages.ExecutionStatus_FAILED,
+		ScenarioItems: []*gauge_messages.ProtoItem{{
+			ItemType: gauge_messages.ProtoItem_Step,
+			Step: &gauge_messages.ProtoStep{StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+				ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "boom"},
+			}},
+		}},
+	})
+	execInfo := gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{FileName: "specs/example.spec"}}
+
+	az.ScenarioEnd(scenario, scnRes, execInfo)
+
+	c.Assert(dw.output, Equals, "##vso[task.logissue type=error;sourcepath=specs/example.spec;linenumber=12]Scenario failed: My scenario. boom\n##[endgroup]\n")
+}
@@ -9,6 +9,7 @@ package reporter
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/getgauge/gauge/util"
 )
@@ -56,6 +57,16 @@ func getSuccessSymbol() string {
 	return spaces(1) + successSymbol
 }
 
+// formatStepStatus gives the pass/fail marker a step reports in verbose
+// mode, with the step's duration.
+func formatStepStatus(failed bool, durationMs int64) string {
+	status := "PASS"
+	if failed {
+		status = "FAIL"
+	}
+	return fmt.Sprintf("\t ...[%s] (%s)", status, time.Duration(durationMs)*time.Millisecond)
+}
+
 func prepErrorMessage(msg string) string {
 	return fmt.Sprintf("Error Message: %s", msg)
 }
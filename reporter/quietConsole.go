@@ -0,0 +1,96 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// quietConsole suppresses per-spec and per-scenario progress output, leaving
+// only the final summary on the console. Execution details still go to the
+// log file, and errors are printed so failures are not silent.
+type quietConsole struct {
+	mu     *sync.Mutex
+	writer io.Writer
+}
+
+func newQuietConsole(out io.Writer) *quietConsole {
+	return &quietConsole{mu: &sync.Mutex{}, writer: out}
+}
+
+func (qc *quietConsole) SuiteStart() {
+}
+
+func (qc *quietConsole) SpecStart(spec *gauge.Specification, res result.Result) {
+	logger.Info(false, formatSpec(spec.Heading.Value))
+}
+
+func (qc *quietConsole) SpecEnd(spec *gauge.Specification, res result.Result) {
+}
+
+func (qc *quietConsole) ScenarioStart(scenario *gauge.Scenario, i gauge_messages.ExecutionInfo, res result.Result) {
+	logger.Info(false, formatScenario(scenario.Heading.Value))
+}
+
+func (qc *quietConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i gauge_messages.ExecutionInfo) {
+}
+
+func (qc *quietConsole) StepStart(stepText string) {
+	logger.Debug(false, stepText)
+}
+
+func (qc *quietConsole) StepEnd(step gauge.Step, res result.Result, execInfo gauge_messages.ExecutionInfo) {
+	stepRes := res.(*result.StepResult)
+	if !stepRes.GetStepFailed() {
+		return
+	}
+	logger.Error(false, prepStepMsg(step.LineText))
+	logger.Error(false, prepSpecInfo(execInfo.GetCurrentSpec().GetFileName(), step.LineNo, step.InConcept()))
+	logger.Error(false, prepErrorMessage(stepRes.ProtoStepExecResult().GetExecutionResult().GetErrorMessage()))
+	logger.Error(false, prepStacktrace(stepRes.ProtoStepExecResult().GetExecutionResult().GetStackTrace()))
+}
+
+func (qc *quietConsole) ConceptStart(conceptHeading string) {
+	logger.Debug(false, conceptHeading)
+}
+
+func (qc *quietConsole) ConceptEnd(res result.Result) {
+}
+
+func (qc *quietConsole) SuiteEnd(res result.Result) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	suiteRes := res.(*result.SuiteResult)
+	for _, e := range suiteRes.UnhandledErrors {
+		logger.Error(false, e.Error())
+		fmt.Fprint(qc.writer, indent(e.Error(), errorIndentation)+newline)
+	}
+}
+
+func (qc *quietConsole) DataTable(table string) {
+	logger.Debug(false, table)
+}
+
+func (qc *quietConsole) Errorf(err string, args ...interface{}) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	errorMessage := fmt.Sprintf(err, args...)
+	logger.Error(false, errorMessage)
+	fmt.Fprint(qc.writer, fmt.Sprintf("%s%s", indent(errorMessage, errorIndentation), newline))
+}
+
+func (qc *quietConsole) Write(b []byte) (int, error) {
+	logger.Debug(false, string(b))
+	return len(b), nil
+}
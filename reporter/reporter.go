@@ -34,9 +34,24 @@ var SimpleConsoleOutput bool
 // Verbose represents level of console Reporting. If true its at step level, else at scenario level.
 var Verbose bool
 
+// Quiet suppresses per-scenario progress output, leaving only the final
+// summary and errors on the console.
+var Quiet bool
+
 // MachineReadable represents if output should be in JSON format.
 var MachineReadable bool
 
+// CIService selects a console reporter that emits the service messages of a
+// CI server, so it shows live test progress without extra plugins.
+var CIService string
+
+const (
+	// TeamCityService emits TeamCity ##teamcity[...] service messages.
+	TeamCityService = "teamcity"
+	// AzureDevOpsService emits Azure DevOps logging commands.
+	AzureDevOpsService = "azure"
+)
+
 const newline = "\n"
 
 // Reporter reports the progress of spec execution. It reports
@@ -74,6 +89,12 @@ func Current() Reporter {
 	if currentReporter == nil {
 		if MachineReadable {
 			currentReporter = newJSONConsole(os.Stdout, IsParallel, 0)
+		} else if CIService == TeamCityService {
+			currentReporter = newTeamCityConsole(os.Stdout, 0)
+		} else if CIService == AzureDevOpsService {
+			currentReporter = newAzureDevOpsConsole(os.Stdout)
+		} else if Quiet {
+			currentReporter = newQuietConsole(os.Stdout)
 		} else if SimpleConsoleOutput {
 			currentReporter = newSimpleConsole(os.Stdout)
 		} else if Verbose {
@@ -108,7 +129,18 @@ func initParallelReporters() {
 	for i := 1; i <= NumberOfExecutionStreams; i++ {
 		if MachineReadable {
 			parallelReporters[i] = newJSONConsole(os.Stdout, true, i)
+		} else if CIService == TeamCityService {
+			// TeamCity untangles parallel streams itself via the flowId
+			// attribute, so no per-runner prefix is needed.
+			parallelReporters[i] = newTeamCityConsole(os.Stdout, i)
+		} else if CIService == AzureDevOpsService {
+			parallelReporters[i] = newAzureDevOpsConsole(&parallelReportWriter{nRunner: i})
+		} else if Quiet {
+			writer := &parallelReportWriter{nRunner: i}
+			parallelReporters[i] = newQuietConsole(writer)
 		} else {
+			// The simple console honours Verbose itself, so per-stream
+			// output matches serial execution at every verbosity tier.
 			writer := &parallelReportWriter{nRunner: i}
 			parallelReporters[i] = newSimpleConsole(writer)
 		}
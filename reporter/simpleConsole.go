@@ -91,6 +91,11 @@ func (sc *simpleConsole) StepEnd(step gauge.Step, res result.Result, execInfo ga
 	defer sc.mu.Unlock()
 	printHookFailureSC(sc, res, res.GetPreHook)
 	stepRes := res.(*result.StepResult)
+	if Verbose && !(hookFailed(res.GetPreHook) || hookFailed(res.GetPostHook)) {
+		duration := stepRes.ProtoStepExecResult().GetExecutionResult().GetExecutionTime()
+		status := strings.TrimSpace(formatStepStatus(stepRes.GetStepFailed(), duration))
+		fmt.Fprint(sc.writer, fmt.Sprintf("%s%s", indent(status, sc.indentation), newline))
+	}
 	if stepRes.GetStepFailed() {
 		stepText := prepStepMsg(step.LineText)
 		logger.Error(false, stepText)
@@ -231,7 +231,8 @@ func (s *MySuite) TestSpecReporting_SimpleConsole(c *C) {
 	want := `# Specification heading
   ## My First scenario
       * do foo bar
-doing foo bar
+doing foo bar      ...[PASS] (0s)
+
 `
 
 	c.Assert(dw.output, Equals, want)
@@ -360,7 +361,7 @@ func (s *MySuite) TestExcludeLineNoForFailedStepInConcept(c *C) {
 
 	c.Assert(sc.indentation, Equals, 0)
 	ind := spaces(errorIndentation + 4)
-	want := ind + newline + ind + "Failed Step: " + stepText + newline + ind + "Specification: " + specName + newline + ind + "Error Message: " + errMsg + newline + ind + "Stacktrace: \n" + ind + stackTrace + newline
+	want := spaces(4) + "...[FAIL] (0s)" + newline + ind + newline + ind + "Failed Step: " + stepText + newline + ind + "Specification: " + specName + newline + ind + "Error Message: " + errMsg + newline + ind + "Stacktrace: \n" + ind + stackTrace + newline
 	c.Assert(dw.output, Equals, want)
 }
 
@@ -381,6 +382,6 @@ func (s *MySuite) TestIncludeLineNoForFailedStep(c *C) {
 
 	c.Assert(sc.indentation, Equals, 0)
 	ind := spaces(errorIndentation + 4)
-	want := ind + newline + ind + "Failed Step: " + stepText + newline + ind + "Specification: " + specName + ":3" + newline + ind + "Error Message: " + errMsg + newline + ind + "Stacktrace: \n" + ind + stackTrace + newline
+	want := spaces(4) + "...[FAIL] (0s)" + newline + ind + newline + ind + "Failed Step: " + stepText + newline + ind + "Specification: " + specName + ":3" + newline + ind + "Error Message: " + errMsg + newline + ind + "Stacktrace: \n" + ind + stackTrace + newline
 	c.Assert(dw.output, Equals, want)
 }
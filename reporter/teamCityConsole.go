@@ -0,0 +1,174 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// teamCityConsole reports execution progress as TeamCity service messages
+// (##teamcity[...]), so TeamCity shows specs as test suites and scenarios as
+// tests live during the run. In parallel execution the stream number is sent
+// as the flowId, which TeamCity uses to untangle interleaved output.
+type teamCityConsole struct {
+	mu     *sync.Mutex
+	writer io.Writer
+	stream int
+}
+
+func newTeamCityConsole(out io.Writer, stream int) *teamCityConsole {
+	return &teamCityConsole{mu: &sync.Mutex{}, writer: out, stream: stream}
+}
+
+func (tc *teamCityConsole) SuiteStart() {
+}
+
+func (tc *teamCityConsole) SpecStart(spec *gauge.Specification, res result.Result) {
+	if res.(*result.SpecResult).Skipped {
+		return
+	}
+	logger.Info(false, formatSpec(spec.Heading.Value))
+	tc.writeServiceMessage("testSuiteStarted", map[string]string{"name": spec.Heading.Value})
+}
+
+func (tc *teamCityConsole) SpecEnd(spec *gauge.Specification, res result.Result) {
+	if res.(*result.SpecResult).Skipped {
+		return
+	}
+	tc.writeServiceMessage("testSuiteFinished", map[string]string{"name": spec.Heading.Value})
+}
+
+func (tc *teamCityConsole) ScenarioStart(scenario *gauge.Scenario, i gauge_messages.ExecutionInfo, res result.Result) {
+	if res.(*result.ScenarioResult).ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		tc.writeServiceMessage("testIgnored", map[string]string{"name": scenario.Heading.Value})
+		return
+	}
+	logger.Info(false, formatScenario(scenario.Heading.Value))
+	tc.writeServiceMessage("testStarted", map[string]string{"name": scenario.Heading.Value, "captureStandardOutput": "true"})
+}
+
+func (tc *teamCityConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i gauge_messages.ExecutionInfo) {
+	protoScenario := res.(*result.ScenarioResult).ProtoScenario
+	if protoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		return
+	}
+	if protoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED {
+		tc.writeServiceMessage("testFailed", map[string]string{
+			"name":    scenario.Heading.Value,
+			"message": scenarioFailureMessage(protoScenario),
+		})
+	}
+	tc.writeServiceMessage("testFinished", map[string]string{
+		"name":     scenario.Heading.Value,
+		"duration": fmt.Sprintf("%d", protoScenario.GetExecutionTime()),
+	})
+}
+
+func (tc *teamCityConsole) StepStart(stepText string) {
+	logger.Debug(false, stepText)
+}
+
+func (tc *teamCityConsole) StepEnd(step gauge.Step, res result.Result, execInfo gauge_messages.ExecutionInfo) {
+	stepRes := res.(*result.StepResult)
+	if !stepRes.GetStepFailed() {
+		return
+	}
+	logger.Error(false, prepStepMsg(step.LineText))
+	logger.Error(false, prepErrorMessage(stepRes.ProtoStepExecResult().GetExecutionResult().GetErrorMessage()))
+}
+
+func (tc *teamCityConsole) ConceptStart(conceptHeading string) {
+	logger.Debug(false, conceptHeading)
+}
+
+func (tc *teamCityConsole) ConceptEnd(res result.Result) {
+}
+
+func (tc *teamCityConsole) SuiteEnd(res result.Result) {
+	suiteRes := res.(*result.SuiteResult)
+	for _, e := range suiteRes.UnhandledErrors {
+		logger.Error(false, e.Error())
+		tc.writeServiceMessage("message", map[string]string{"text": e.Error(), "status": "ERROR"})
+	}
+}
+
+func (tc *teamCityConsole) DataTable(table string) {
+	logger.Debug(false, table)
+}
+
+func (tc *teamCityConsole) Errorf(err string, args ...interface{}) {
+	errorMessage := fmt.Sprintf(err, args...)
+	logger.Error(false, errorMessage)
+	tc.writeServiceMessage("message", map[string]string{"text": errorMessage, "status": "ERROR"})
+}
+
+func (tc *teamCityConsole) Write(b []byte) (int, error) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	fmt.Fprint(tc.writer, string(b))
+	return len(b), nil
+}
+
+// writeServiceMessage prints one ##teamcity[...] line. Attribute order is
+// fixed (name first) so the output is stable.
+func (tc *teamCityConsole) writeServiceMessage(messageName string, attributes map[string]string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	parts := []string{messageName}
+	for _, key := range []string{"name", "text", "message", "details", "duration", "captureStandardOutput", "status"} {
+		if value, ok := attributes[key]; ok {
+			parts = append(parts, fmt.Sprintf("%s='%s'", key, escapeTeamCityValue(value)))
+		}
+	}
+	if tc.stream > 0 {
+		parts = append(parts, fmt.Sprintf("flowId='%d'", tc.stream))
+	}
+	fmt.Fprintf(tc.writer, "##teamcity[%s]%s", strings.Join(parts, " "), newline)
+}
+
+// escapeTeamCityValue escapes the characters the TeamCity service message
+// format requires to be escaped with a vertical bar.
+func escapeTeamCityValue(value string) string {
+	replacer := strings.NewReplacer(
+		"|", "||",
+		"'", "|'",
+		"\n", "|n",
+		"\r", "|r",
+		"[", "|[",
+		"]", "|]",
+	)
+	return replacer.Replace(value)
+}
+
+// scenarioFailureMessage returns the error message of the first failed step
+// of the scenario, or a generic message when the failure came from a hook.
+func scenarioFailureMessage(protoScenario *gauge_messages.ProtoScenario) string {
+	for _, item := range append(protoScenario.GetContexts(), protoScenario.GetScenarioItems()...) {
+		if item.GetItemType() != gauge_messages.ProtoItem_Step {
+			continue
+		}
+		execResult := item.GetStep().GetStepExecutionResult().GetExecutionResult()
+		if execResult.GetFailed() {
+			return execResult.GetErrorMessage()
+		}
+	}
+	if failure := protoScenario.GetPreHookFailure(); failure != nil {
+		return failure.GetErrorMessage()
+	}
+	if failure := protoScenario.GetPostHookFailure(); failure != nil {
+		return failure.GetErrorMessage()
+	}
+	return "Scenario failed"
+}
@@ -0,0 +1,85 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reporter
+
+import (
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+
+	. "gopkg.in/check.v1"
+)
+
+func setupTeamCityConsole(stream int) (*dummyWriter, *teamCityConsole) {
+	dw := newDummyWriter()
+	tc := newTeamCityConsole(dw, stream)
+	return dw, tc
+}
+
+func (s *MySuite) TestSpecStartAndEnd_TeamCityConsole(c *C) {
+	dw, tc := setupTeamCityConsole(0)
+	spec := &gauge.Specification{Heading: &gauge.Heading{Value: "Specification heading"}}
+	specRes := &result.SpecResult{Skipped: false, ProtoSpec: &gauge_messages.ProtoSpec{}}
+
+	tc.SpecStart(spec, specRes)
+	tc.SpecEnd(spec, specRes)
+
+	c.Assert(dw.output, Equals, "##teamcity[testSuiteStarted name='Specification heading']\n"+
+		"##teamcity[testSuiteFinished name='Specification heading']\n")
+}
+
+func (s *MySuite) TestScenarioStartAndEnd_TeamCityConsole(c *C) {
+	dw, tc := setupTeamCityConsole(0)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My scenario"}}
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_PASSED, ExecutionTime: 1200})
+
+	tc.ScenarioStart(scenario, gauge_messages.ExecutionInfo{}, scnRes)
+	tc.ScenarioEnd(scenario, scnRes, gauge_messages.ExecutionInfo{})
+
+	c.Assert(dw.output, Equals, "##teamcity[testStarted name='My scenario' captureStandardOutput='true']\n"+
+		"##teamcity[testFinished name='My scenario' duration='1200']\n")
+}
+
+func (s *MySuite) TestFailedScenarioEscapesMessage_TeamCityConsole(c *C) {
+	dw, tc := setupTeamCityConsole(0)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My scenario"}}
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{
+		ExecutionStatus: gauge_messages.ExecutionStatus_FAILED,
+		ScenarioItems: []*gauge_messages.ProtoItem{{
+			ItemType: gauge_messages.ProtoItem_Step,
+			Step: &gauge_messages.ProtoStep{StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+				ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "expected [1]\nit's 2"},
+			}},
+		}},
+	})
+
+	tc.ScenarioEnd(scenario, scnRes, gauge_messages.ExecutionInfo{})
+
+	c.Assert(dw.output, Equals, "##teamcity[testFailed name='My scenario' message='expected |[1|]|nit|'s 2']\n"+
+		"##teamcity[testFinished name='My scenario' duration='0']\n")
+}
+
+func (s *MySuite) TestSkippedScenarioIsIgnored_TeamCityConsole(c *C) {
+	dw, tc := setupTeamCityConsole(0)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My scenario"}}
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_SKIPPED})
+
+	tc.ScenarioStart(scenario, gauge_messages.ExecutionInfo{}, scnRes)
+	tc.ScenarioEnd(scenario, scnRes, gauge_messages.ExecutionInfo{})
+
+	c.Assert(dw.output, Equals, "##teamcity[testIgnored name='My scenario']\n")
+}
+
+func (s *MySuite) TestParallelStreamIsSentAsFlowId_TeamCityConsole(c *C) {
+	dw, tc := setupTeamCityConsole(3)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "My scenario"}}
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_PASSED})
+
+	tc.ScenarioStart(scenario, gauge_messages.ExecutionInfo{}, scnRes)
+
+	c.Assert(dw.output, Equals, "##teamcity[testStarted name='My scenario' captureStandardOutput='true' flowId='3']\n")
+}
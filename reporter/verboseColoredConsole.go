@@ -95,10 +95,11 @@ func (c *verboseColoredConsole) StepEnd(step gauge.Step, res result.Result, exec
 	stepRes := res.(*result.StepResult)
 	c.writer.Clear()
 	if !(hookFailed(res.GetPreHook) || hookFailed(res.GetPostHook)) {
+		duration := stepRes.ProtoStepExecResult().GetExecutionResult().GetExecutionTime()
 		if stepRes.GetStepFailed() {
-			c.displayMessage(c.headingBuffer.String()+"\t ...[FAIL]\n", ct.Red)
+			c.displayMessage(c.headingBuffer.String()+formatStepStatus(true, duration)+newline, ct.Red)
 		} else {
-			c.displayMessage(c.headingBuffer.String()+"\t ...[PASS]\n", ct.Green)
+			c.displayMessage(c.headingBuffer.String()+formatStepStatus(false, duration)+newline, ct.Green)
 		}
 	} else {
 		c.displayMessage(c.headingBuffer.String()+newline, ct.None)
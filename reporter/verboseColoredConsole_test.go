@@ -101,7 +101,7 @@ func (s *MySuite) TestScenarioStartAndScenarioEnd_ColoredConsole(c *C) {
 	dw.output = ""
 
 	cc.StepEnd(gauge.Step{LineText: stepText}, stepRes, specInfo)
-	c.Assert(dw.output, Equals, twoLevelIndentation+stepText+"\t ...[PASS]\n")
+	c.Assert(dw.output, Equals, twoLevelIndentation+stepText+"\t ...[PASS] (0s)\n")
 
 	cc.ScenarioEnd(nil, sceRes, gauge_messages.ExecutionInfo{})
 	c.Assert(cc.headingBuffer.String(), Equals, "")
@@ -138,7 +138,7 @@ func (s *MySuite) TestFailingStepEndInVerbose_ColoredConsole(c *C) {
         Stacktrace:` + spaces(1) + `
         my stacktrace
 `
-	c.Assert(dw.output, Equals, "      "+stepText+"\t ...[FAIL]\n"+expectedErrMsg)
+	c.Assert(dw.output, Equals, "      "+stepText+"\t ...[FAIL] (0s)\n"+expectedErrMsg)
 }
 
 func (s *MySuite) TestStepStartAndStepEnd_ColoredConsole(c *C) {
@@ -168,7 +168,7 @@ func (s *MySuite) TestStepStartAndStepEnd_ColoredConsole(c *C) {
         Stacktrace:` + spaces(1) + `
         ` + stacktrace + `
 `
-	expectedStepEndOutput := spaces(6) + stepText + "\t ...[FAIL]\n" + expectedErrMsg
+	expectedStepEndOutput := spaces(6) + stepText + "\t ...[FAIL] (0s)\n" + expectedErrMsg
 	c.Assert(dw.output, Equals, expectedStepEndOutput)
 }
 
@@ -201,7 +201,7 @@ func (s *MySuite) TestStepFailure_ColoredConsole(c *C) {
         Stacktrace:` + spaces(1) + `
         ` + stacktrace + `
 `
-	expectedStepEndOutput := cursorUp + eraseLine + spaces(6) + "* Say hello to all\t ...[FAIL]\n" + spaces(8) + "Failed!\n" + expectedErrMsg
+	expectedStepEndOutput := cursorUp + eraseLine + spaces(6) + "* Say hello to all\t ...[FAIL] (0s)\n" + spaces(8) + "Failed!\n" + expectedErrMsg
 	c.Assert(dw.output, Equals, expectedStepEndOutput)
 }
 
@@ -0,0 +1,67 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+)
+
+// ContainerInfo describes the container a runner should be launched in, as
+// declared under the `container` key in runner.json. When Image is set, gauge
+// wraps the runner command in `docker run` so that teams get a hermetic
+// execution environment without scripting around gauge themselves.
+type ContainerInfo struct {
+	Image  string
+	Mounts []string
+	Env    map[string]string
+}
+
+// dockerizedCommand wraps a runner command in a `docker run` invocation. The
+// project root is mounted at the same path inside the container and the host
+// network is shared so that the port handshake with gauge works unchanged.
+// GAUGE_* variables (including the ones loaded from the env dir) are forwarded
+// from the gauge process environment into the container.
+func dockerizedCommand(c *ContainerInfo, command []string, env []string) []string {
+	dockerCommand := []string{"docker", "run", "--rm", "--network", "host"}
+	if config.ProjectRoot != "" {
+		dockerCommand = append(dockerCommand, "-v", fmt.Sprintf("%s:%s", config.ProjectRoot, config.ProjectRoot), "-w", config.ProjectRoot)
+	}
+	for _, mount := range c.Mounts {
+		dockerCommand = append(dockerCommand, "-v", mount)
+	}
+	for _, e := range env {
+		key := strings.TrimSpace(strings.Split(e, "=")[0])
+		if strings.HasPrefix(key, "GAUGE_") || key == "gauge_custom_build_path" {
+			dockerCommand = append(dockerCommand, "-e", e)
+		}
+	}
+	keys := make([]string, 0, len(c.Env))
+	for key := range c.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		dockerCommand = append(dockerCommand, "-e", fmt.Sprintf("%s=%s", key, c.Env[key]))
+	}
+	dockerCommand = append(dockerCommand, c.Image)
+	return append(dockerCommand, command...)
+}
+
+// shouldRunInContainer tells if the runner asked to be launched inside a
+// container. Setting GAUGE_RUNNER_IN_DOCKER=false lets a single agent opt out
+// of the runner.json declaration.
+func shouldRunInContainer(r *RunnerInfo) bool {
+	if r.Container.Image == "" {
+		return false
+	}
+	return !strings.EqualFold(os.Getenv("GAUGE_RUNNER_IN_DOCKER"), "false")
+}
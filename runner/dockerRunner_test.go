@@ -0,0 +1,50 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDockerizedCommandWrapsRunnerCommand(t *testing.T) {
+	c := &ContainerInfo{Image: "gauge/java-runner:latest"}
+	got := dockerizedCommand(c, []string{"java", "-jar", "runner.jar"}, []string{})
+
+	want := []string{"docker", "run", "--rm", "--network", "host", "gauge/java-runner:latest", "java", "-jar", "runner.jar"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dockerizedCommand failed.\n\tWant: %v\n\tGot: %v", want, got)
+	}
+}
+
+func TestDockerizedCommandForwardsGaugeEnvAndMounts(t *testing.T) {
+	c := &ContainerInfo{Image: "img", Mounts: []string{"/data:/data"}, Env: map[string]string{"LANG": "C"}}
+	got := strings.Join(dockerizedCommand(c, []string{"run.sh"}, []string{"GAUGE_INTERNAL_PORT=1234", "HOME=/root"}), " ")
+
+	if !strings.Contains(got, "-v /data:/data") {
+		t.Errorf("Expected mount in command, got: %s", got)
+	}
+	if !strings.Contains(got, "-e GAUGE_INTERNAL_PORT=1234") {
+		t.Errorf("Expected GAUGE_INTERNAL_PORT to be forwarded, got: %s", got)
+	}
+	if strings.Contains(got, "HOME=/root") {
+		t.Errorf("Did not expect non GAUGE_* env to be forwarded, got: %s", got)
+	}
+	if !strings.Contains(got, "-e LANG=C") {
+		t.Errorf("Expected declared container env, got: %s", got)
+	}
+}
+
+func TestShouldRunInContainerNeedsAnImage(t *testing.T) {
+	if shouldRunInContainer(&RunnerInfo{}) {
+		t.Errorf("Expected container launch to be off for runner without an image")
+	}
+	if !shouldRunInContainer(&RunnerInfo{Container: ContainerInfo{Image: "img"}}) {
+		t.Errorf("Expected container launch for runner declaring an image")
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/getgauge/gauge/config"
+	gconn "github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/gauge_messages"
 	gm "github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
@@ -40,6 +41,8 @@ type GrpcRunner struct {
 	Timeout      time.Duration
 	info         *RunnerInfo
 	IsExecuting  bool
+	stream       int
+	startedAt    time.Time
 }
 
 func (r *GrpcRunner) invokeLegacyLSPService(message *gm.Message) (*gm.Message, error) {
@@ -215,6 +218,7 @@ func (r *GrpcRunner) ExecuteAndGetStatus(m *gm.Message) *gm.ProtoExecutionResult
 				stackTrace = data[1]
 			}
 			if e.Code() == codes.Unavailable {
+				notifyLifecycle(RunnerCrashed, r.stream, r.Pid(), r.startedAt, message)
 				logger.Fatalf(true, "%s runner quit unexpectedly. Check logs for more details.\nErrorMessage: %s\n%s", r.Info().Id, message, stackTrace)
 			}
 			return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: message, StackTrace: stackTrace}
@@ -264,10 +268,12 @@ func (r *GrpcRunner) Kill() error {
 		case done := <-exited:
 			if done {
 				logger.Debugf(true, "Runner with PID:%d has exited", r.cmd.Process.Pid)
+				notifyLifecycle(RunnerKilled, r.stream, r.cmd.Process.Pid, r.startedAt, "")
 				return nil
 			}
 		case <-time.After(config.PluginKillTimeout()):
 			logger.Warningf(true, "Killing runner with PID:%d forcefully", r.cmd.Process.Pid)
+			notifyLifecycle(RunnerKilled, r.stream, r.cmd.Process.Pid, r.startedAt, "killed forcefully")
 			return r.cmd.Process.Kill()
 		}
 	}
@@ -306,6 +312,7 @@ func StartGrpcRunner(m *manifest.Manifest, stdout, stderr io.Writer, timeout tim
 	if err != nil {
 		return nil, fmt.Errorf("Error occurred while starting runner process.\nError : %w", err)
 	}
+	startedAt := time.Now()
 
 	go func() {
 		err = cmd.Wait()
@@ -327,15 +334,30 @@ func StartGrpcRunner(m *manifest.Manifest, stdout, stderr io.Writer, timeout tim
 		return nil, fmt.Errorf("Timed out connecting to %s", m.Language)
 	}
 	logger.Debugf(true, "Attempting to connect to grpc server at port: %s", port)
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%s", host, port),
-		grpc.WithInsecure(),
+	creds, err := transportCredentials(info)
+	if err != nil {
+		return nil, err
+	}
+	dialOpts := []grpc.DialOption{
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(oneGB), grpc.MaxCallSendMsgSize(oneGB)),
-		grpc.WithBlock())
-	logger.Debugf(true, "Successfully made the connection with runner with port: %s", port)
+		grpc.WithBlock(),
+	}
+	if creds != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(creds))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	var conn *grpc.ClientConn
+	err = gconn.AttemptWithRetry(fmt.Sprintf("%s runner at port %s", m.Language, port), func() error {
+		var dialErr error
+		conn, dialErr = grpc.Dial(fmt.Sprintf("%s:%s", host, port), dialOpts...)
+		return dialErr
+	})
 	if err != nil {
 		return nil, err
 	}
-	r := &GrpcRunner{cmd: cmd, conn: conn, Timeout: timeout, info: info}
+	logger.Debugf(true, "Successfully made the connection with runner with port: %s", port)
+	r := &GrpcRunner{cmd: cmd, conn: conn, Timeout: timeout, info: info, startedAt: startedAt}
 
 	if info.GRPCSupport {
 		r.RunnerClient = gm.NewRunnerClient(conn)
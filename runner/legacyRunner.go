@@ -29,6 +29,8 @@ type LegacyRunner struct {
 	multiThreaded bool
 	lostContact   bool
 	info          *RunnerInfo
+	stream        int
+	startedAt     time.Time
 }
 
 func (r *LegacyRunner) Alive() bool {
@@ -95,10 +97,12 @@ func (r *LegacyRunner) Kill() error {
 		case done := <-exited:
 			if done {
 				logger.Debugf(true, "Runner with PID:%d has exited", r.Cmd.Process.Pid)
+				notifyLifecycle(RunnerKilled, r.stream, r.Cmd.Process.Pid, r.startedAt, "")
 				return nil
 			}
 		case <-time.After(config.PluginKillTimeout()):
 			logger.Warningf(true, "Killing runner with PID:%d forcefully", r.Cmd.Process.Pid)
+			notifyLifecycle(RunnerKilled, r.stream, r.Cmd.Process.Pid, r.startedAt, "killed forcefully")
 			return r.killRunner()
 		}
 	}
@@ -167,7 +171,7 @@ func StartLegacyRunner(manifest *manifest.Manifest, port string, outputStreamWri
 	}()
 	// Wait for the process to exit so we will get a detailed error message
 	errChannel := make(chan error)
-	testRunner := &LegacyRunner{info: r, Cmd: cmd, errorChannel: errChannel, mutex: &sync.Mutex{}, multiThreaded: r.Multithreaded}
+	testRunner := &LegacyRunner{info: r, Cmd: cmd, errorChannel: errChannel, mutex: &sync.Mutex{}, multiThreaded: r.Multithreaded, startedAt: time.Now()}
 	testRunner.waitAndGetErrorMessage()
 	return testRunner, nil
 }
@@ -0,0 +1,81 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// LifecycleState is the phase a runner process moved into.
+type LifecycleState string
+
+const (
+	// RunnerStarted - the runner process has been launched.
+	RunnerStarted LifecycleState = "start"
+	// RunnerReady - the wire connection to the runner is established.
+	RunnerReady LifecycleState = "ready"
+	// RunnerCrashed - the runner process quit unexpectedly.
+	RunnerCrashed LifecycleState = "crash"
+	// RunnerRestarted - a runner was launched on a stream whose previous runner crashed.
+	RunnerRestarted LifecycleState = "restart"
+	// RunnerKilled - the runner was asked to stop at the end of execution.
+	RunnerKilled LifecycleState = "kill"
+)
+
+// LifecycleEvent describes a runner lifecycle transition. Infrastructure
+// plugins and the API can use these to track runner stability and report
+// environment issues separately from test failures.
+type LifecycleEvent struct {
+	State  LifecycleState
+	Stream int
+	Pid    int
+	// Timestamp is when the transition happened.
+	Timestamp time.Time
+	// RunnerUptime is the time since the runner process was launched. For
+	// RunnerReady this is the time the runner took to accept a connection.
+	RunnerUptime time.Duration
+	Message      string
+}
+
+var lifecycleMutex sync.Mutex
+var lifecycleSubscribers []chan LifecycleEvent
+var crashedStreams = map[int]bool{}
+
+// RegisterLifecycleListener subscribes the given channel to runner lifecycle
+// events. Events are delivered best-effort: a subscriber that is not draining
+// its channel misses events instead of stalling execution, so use a buffered
+// channel.
+func RegisterLifecycleListener(ch chan LifecycleEvent) {
+	lifecycleMutex.Lock()
+	defer lifecycleMutex.Unlock()
+	lifecycleSubscribers = append(lifecycleSubscribers, ch)
+}
+
+func notifyLifecycle(state LifecycleState, stream, pid int, startedAt time.Time, message string) {
+	lifecycleMutex.Lock()
+	defer lifecycleMutex.Unlock()
+	if state == RunnerStarted && crashedStreams[stream] {
+		state = RunnerRestarted
+	}
+	if state == RunnerCrashed {
+		crashedStreams[stream] = true
+	}
+	e := LifecycleEvent{State: state, Stream: stream, Pid: pid, Timestamp: time.Now(), Message: message}
+	if !startedAt.IsZero() {
+		e.RunnerUptime = time.Since(startedAt)
+	}
+	logger.Debugf(true, "Runner lifecycle: %s, stream: %d, pid: %d, uptime: %s", e.State, e.Stream, e.Pid, e.RunnerUptime)
+	for _, ch := range lifecycleSubscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
@@ -0,0 +1,53 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLifecycleEventsAreDeliveredToListeners(t *testing.T) {
+	ch := make(chan LifecycleEvent, 1)
+	RegisterLifecycleListener(ch)
+	defer unregisterLifecycleListeners()
+
+	notifyLifecycle(RunnerReady, 2, 42, time.Now().Add(-time.Second), "")
+
+	select {
+	case e := <-ch:
+		if e.State != RunnerReady || e.Stream != 2 || e.Pid != 42 {
+			t.Errorf("unexpected lifecycle event %+v", e)
+		}
+		if e.RunnerUptime <= 0 {
+			t.Errorf("expected a positive runner uptime, got %s", e.RunnerUptime)
+		}
+	default:
+		t.Fatal("expected a lifecycle event to be delivered")
+	}
+}
+
+func TestRunnerStartAfterCrashIsReportedAsRestart(t *testing.T) {
+	ch := make(chan LifecycleEvent, 2)
+	RegisterLifecycleListener(ch)
+	defer unregisterLifecycleListeners()
+
+	notifyLifecycle(RunnerCrashed, 3, 42, time.Now(), "exit status 1")
+	notifyLifecycle(RunnerStarted, 3, 43, time.Now(), "")
+
+	<-ch
+	if e := <-ch; e.State != RunnerRestarted {
+		t.Errorf("expected state %s, got %s", RunnerRestarted, e.State)
+	}
+}
+
+func unregisterLifecycleListeners() {
+	lifecycleMutex.Lock()
+	defer lifecycleMutex.Unlock()
+	lifecycleSubscribers = nil
+	crashedStreams = map[int]bool{}
+}
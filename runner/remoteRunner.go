@@ -0,0 +1,100 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// RemoteRunner talks to a runner that is already listening on another machine or
+// container, e.g. device-bound step implementations on a lab machine. Gauge does
+// not own the remote process; Kill only closes the connection.
+type RemoteRunner struct {
+	connection net.Conn
+	address    string
+	info       *RunnerInfo
+}
+
+// ConnectToRemoteRunner dials the `connectTo` address declared in the manifest
+// and wraps the connection in a Runner.
+func ConnectToRemoteRunner(info *RunnerInfo, address string) (*RemoteRunner, error) {
+	var connection net.Conn
+	err := conn.AttemptWithRetry(fmt.Sprintf("remote runner at %s", address), func() error {
+		var dialErr error
+		connection, dialErr = net.DialTimeout("tcp", address, config.RunnerConnectionTimeout())
+		return dialErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote runner at %s: %s", address, err.Error())
+	}
+	logger.Debugf(true, "Established connection to remote runner at %s", address)
+	return &RemoteRunner{connection: connection, address: address, info: info}, nil
+}
+
+func (r *RemoteRunner) ExecuteAndGetStatus(message *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+	response, err := conn.GetResponseForMessageWithTimeout(message, r.connection, 0)
+	if err != nil {
+		return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: err.Error()}
+	}
+	if response.GetMessageType() == gauge_messages.Message_ExecutionStatusResponse {
+		executionResult := response.GetExecutionStatusResponse().GetExecutionResult()
+		if executionResult == nil {
+			errMsg := "ProtoExecutionResult obtained is nil"
+			logger.Errorf(true, errMsg)
+			return errorResult(errMsg)
+		}
+		return executionResult
+	}
+	errMsg := fmt.Sprintf("Expected ExecutionStatusResponse. Obtained: %s", response.GetMessageType())
+	logger.Errorf(true, errMsg)
+	return errorResult(errMsg)
+}
+
+func (r *RemoteRunner) ExecuteMessageWithTimeout(message *gauge_messages.Message) (*gauge_messages.Message, error) {
+	return conn.GetResponseForMessageWithTimeout(message, r.connection, config.RunnerRequestTimeout())
+}
+
+func (r *RemoteRunner) Alive() bool {
+	return r.connection != nil
+}
+
+// Kill closes the connection to the remote runner. The remote process is left
+// running since its lifecycle is owned by whoever started it.
+func (r *RemoteRunner) Kill() error {
+	if r.connection == nil {
+		return nil
+	}
+	defer func() { r.connection = nil }()
+	return r.connection.Close()
+}
+
+func (r *RemoteRunner) Connection() net.Conn {
+	return r.connection
+}
+
+func (r *RemoteRunner) IsMultithreaded() bool {
+	return r.info != nil && r.info.Multithreaded
+}
+
+// Info gives the information about runner
+func (r *RemoteRunner) Info() *RunnerInfo {
+	if r.info == nil {
+		return &RunnerInfo{Id: fmt.Sprintf("remote runner at %s", r.address)}
+	}
+	return r.info
+}
+
+// Pid returns -1 since the remote runner process is not owned by this gauge process.
+func (r *RemoteRunner) Pid() int {
+	return -1
+}
@@ -14,7 +14,6 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
-	"strconv"
 	"strings"
 
 	"github.com/getgauge/common"
@@ -58,6 +57,10 @@ type RunnerInfo struct {
 	GaugeVersionSupport version.VersionSupport
 	LspLangId           string
 	GRPCSupport         bool
+	Transport           string
+	TLSCertFile         string
+	ConnectTo           string
+	Container           ContainerInfo
 }
 
 func ExecuteInitHookForRunner(language string) error {
@@ -130,6 +133,10 @@ func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, writ
 	}
 	command := getOsSpecificCommand(&r)
 	env := getCleanEnv(port, os.Environ(), debug, getPluginPaths())
+	if shouldRunInContainer(&r) {
+		command = dockerizedCommand(&r.Container, command, env)
+		logger.Debugf(true, "Launching %s runner in container %s", manifest.Language, r.Container.Image)
+	}
 	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, writer.Stdout, writer.Stderr, env)
 	return cmd, &r, err
 }
@@ -168,6 +175,7 @@ func (r *LegacyRunner) waitAndGetErrorMessage() {
 			r.errorChannel <- fmt.Errorf("Runner exited with error: %s", err.Error())
 		}
 		if !pState.Success() {
+			notifyLifecycle(RunnerCrashed, r.stream, pState.Pid(), r.startedAt, pState.String())
 			r.errorChannel <- fmt.Errorf("Runner with pid %d quit unexpectedly(%s)", pState.Pid(), pState.String())
 		}
 	}()
@@ -213,26 +221,29 @@ func getOsSpecificCommand(r *RunnerInfo) []string {
 
 func Start(manifest *manifest.Manifest, stream int, killChannel chan bool, debug bool) (Runner, error) {
 	ri, err := GetRunnerInfo(manifest.Language)
-	if err == nil && ri.GRPCSupport {
-		return StartGrpcRunner(manifest, os.Stdout, os.Stderr, config.RunnerRequestTimeout(), true)
-	}
-
-	writer := logger.NewLogWriter(manifest.Language, true, stream)
-	port, err := conn.GetPortFromEnvironmentVariable(common.GaugePortEnvName)
 	if err != nil {
-		port = 0
+		if manifest.ConnectTo != "" {
+			return ConnectToRemoteRunner(nil, manifest.ConnectTo)
+		}
+		return startTCPRunner(manifest, stream, killChannel, debug)
 	}
-	handler, err := conn.NewGaugeConnectionHandler(port, nil)
-	if err != nil {
-		return nil, err
+	if address := remoteRunnerAddress(manifest, ri); address != "" {
+		return ConnectToRemoteRunner(ri, address)
 	}
-	logger.Debugf(true, "Staring %s runner", manifest.Language)
-	runner, err := StartLegacyRunner(manifest, strconv.Itoa(handler.ConnectionPortNumber()), writer, killChannel, debug)
+	transport, err := transportFor(ri)
 	if err != nil {
 		return nil, err
 	}
-	err = connect(handler, runner)
-	return runner, err
+	return transport(manifest, stream, killChannel, debug)
+}
+
+// remoteRunnerAddress gives the `connectTo` address for the runner, the manifest
+// entry winning over the one in runner.json.
+func remoteRunnerAddress(m *manifest.Manifest, ri *RunnerInfo) string {
+	if m.ConnectTo != "" {
+		return m.ConnectTo
+	}
+	return ri.ConnectTo
 }
 
 func connect(h *conn.GaugeConnectionHandler, runner *LegacyRunner) error {
@@ -0,0 +1,114 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// TCPTransport is the legacy length-prefixed protobuf connection over TCP.
+	TCPTransport = "tcp"
+	// GRPCTransport is the gRPC based connection, with optional TLS.
+	GRPCTransport = "grpc"
+)
+
+// Transport starts a language runner and establishes the wire connection to it.
+// Implementations are selected per-runner via the `transport` field in runner.json,
+// falling back to `grpcSupport` for runners that predate the field.
+type Transport func(m *manifest.Manifest, stream int, killChannel chan bool, debug bool) (Runner, error)
+
+var transports = map[string]Transport{
+	TCPTransport:  startTCPRunner,
+	GRPCTransport: startGrpcRunner,
+}
+
+// transportFor resolves the transport declared in runner.json. An empty value
+// maps to gRPC when the runner declares grpcSupport, else to the legacy TCP transport.
+func transportFor(info *RunnerInfo) (Transport, error) {
+	name := info.Transport
+	if name == "" {
+		if info.GRPCSupport {
+			name = GRPCTransport
+		} else {
+			name = TCPTransport
+		}
+	}
+	t, ok := transports[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown runner transport '%s' in runner.json, supported transports are '%s' and '%s'", name, TCPTransport, GRPCTransport)
+	}
+	return t, nil
+}
+
+func startGrpcRunner(m *manifest.Manifest, stream int, killChannel chan bool, debug bool) (Runner, error) {
+	runner, err := StartGrpcRunner(m, os.Stdout, os.Stderr, config.RunnerRequestTimeout(), true)
+	if err != nil {
+		notifyLifecycle(RunnerCrashed, stream, 0, time.Time{}, err.Error())
+		return nil, err
+	}
+	runner.stream = stream
+	notifyLifecycle(RunnerStarted, stream, runner.Pid(), runner.startedAt, "")
+	notifyLifecycle(RunnerReady, stream, runner.Pid(), runner.startedAt, "")
+	return runner, nil
+}
+
+func startTCPRunner(m *manifest.Manifest, stream int, killChannel chan bool, debug bool) (Runner, error) {
+	writer := logger.NewLogWriter(m.Language, true, stream)
+	port, err := conn.GetPortFromEnvironmentVariable(common.GaugePortEnvName)
+	if err != nil {
+		port = 0
+	}
+	handler, err := conn.NewGaugeConnectionHandler(port, nil)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debugf(true, "Staring %s runner", m.Language)
+	runner, err := StartLegacyRunner(m, strconv.Itoa(handler.ConnectionPortNumber()), writer, killChannel, debug)
+	if err != nil {
+		notifyLifecycle(RunnerCrashed, stream, 0, time.Time{}, err.Error())
+		return nil, err
+	}
+	runner.stream = stream
+	notifyLifecycle(RunnerStarted, stream, runner.Pid(), runner.startedAt, "")
+	err = connect(handler, runner)
+	if err == nil {
+		notifyLifecycle(RunnerReady, stream, runner.Pid(), runner.startedAt, "")
+	}
+	return runner, err
+}
+
+// transportCredentials builds the gRPC transport credentials for a runner, using the
+// CA certificate declared in runner.json. A runner with no certificate gets an
+// insecure (plaintext) channel, which matches the legacy behaviour.
+func transportCredentials(info *RunnerInfo) (credentials.TransportCredentials, error) {
+	if info.TLSCertFile == "" {
+		return nil, nil
+	}
+	pem, err := ioutil.ReadFile(info.TLSCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read runner TLS certificate %s: %s", info.TLSCertFile, err.Error())
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("unable to parse runner TLS certificate %s", info.TLSCertFile)
+	}
+	return credentials.NewTLS(&tls.Config{RootCAs: pool}), nil
+}
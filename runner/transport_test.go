@@ -0,0 +1,52 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"testing"
+)
+
+func TestTransportForDefaultsToTCP(t *testing.T) {
+	transport, err := transportFor(&RunnerInfo{})
+
+	if err != nil {
+		t.Fatalf("transportFor failed: %s", err.Error())
+	}
+	if transport == nil {
+		t.Errorf("Expected tcp transport for runner without grpc support, got nil")
+	}
+}
+
+func TestTransportForGrpcSupportedRunner(t *testing.T) {
+	transport, err := transportFor(&RunnerInfo{GRPCSupport: true})
+
+	if err != nil {
+		t.Fatalf("transportFor failed: %s", err.Error())
+	}
+	if transport == nil {
+		t.Errorf("Expected grpc transport for runner with grpc support, got nil")
+	}
+}
+
+func TestTransportForExplicitDeclarationWinsOverGrpcSupport(t *testing.T) {
+	transport, err := transportFor(&RunnerInfo{Transport: TCPTransport, GRPCSupport: true})
+
+	if err != nil {
+		t.Fatalf("transportFor failed: %s", err.Error())
+	}
+	if transport == nil {
+		t.Errorf("Expected tcp transport when declared explicitly, got nil")
+	}
+}
+
+func TestTransportForUnknownTransport(t *testing.T) {
+	_, err := transportFor(&RunnerInfo{Transport: "carrier-pigeon"})
+
+	if err == nil {
+		t.Errorf("Expected error for unknown transport, got none")
+	}
+}
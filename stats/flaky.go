@@ -0,0 +1,220 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package stats
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	// flakyTag is the tag added to flaky candidates by TagFlaky.
+	flakyTag = "flaky"
+	// flakyWindow is how many of the most recent runs the flakiness score
+	// looks at.
+	flakyWindow = 10
+	// flakyMinExecutions is the minimum number of executions within the
+	// window a scenario needs before it can be scored; fewer executions
+	// give no signal about alternation.
+	flakyMinExecutions = 3
+	// flakyThreshold is the minimum score (in percent) for a scenario to
+	// count as a flaky candidate.
+	flakyThreshold = 25.0
+)
+
+// FlakyScenario is a scenario whose outcome alternated between pass and
+// fail across recent runs. Score is the percentage of consecutive
+// executions whose outcome flipped.
+type FlakyScenario struct {
+	Spec       string  `json:"spec"`
+	Scenario   string  `json:"scenario"`
+	Score      float64 `json:"score"`
+	Flips      int     `json:"flips"`
+	Executions int     `json:"executions"`
+}
+
+// FlakyCandidates returns the flaky candidates computed from the stored
+// runs, highest score first.
+func FlakyCandidates() []FlakyScenario {
+	return flakyScenarios(loadRuns())
+}
+
+// flakyScenarios scores each scenario over the last flakyWindow runs by
+// how often its outcome flipped between consecutive executions. Skipped
+// executions are ignored. Scenarios below flakyThreshold or with fewer
+// than flakyMinExecutions executions are dropped.
+func flakyScenarios(runs []runRecord) []FlakyScenario {
+	if len(runs) > flakyWindow {
+		runs = runs[len(runs)-flakyWindow:]
+	}
+	outcomes := make(map[string][]string)
+	names := make(map[string]scenarioRecord)
+	for _, run := range runs {
+		for _, scenario := range run.Scenarios {
+			if scenario.Status == "skipped" {
+				continue
+			}
+			key := scenarioKey(scenario)
+			outcomes[key] = append(outcomes[key], scenario.Status)
+			names[key] = scenario
+		}
+	}
+	var candidates []FlakyScenario
+	for key, statuses := range outcomes {
+		if len(statuses) < flakyMinExecutions {
+			continue
+		}
+		flips := 0
+		for i := 1; i < len(statuses); i++ {
+			if statuses[i] != statuses[i-1] {
+				flips++
+			}
+		}
+		score := float64(flips) * 100 / float64(len(statuses)-1)
+		if score < flakyThreshold {
+			continue
+		}
+		scenario := names[key]
+		candidates = append(candidates, FlakyScenario{
+			Spec:       scenario.Spec,
+			Scenario:   scenario.Scenario,
+			Score:      score,
+			Flips:      flips,
+			Executions: len(statuses),
+		})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return scenarioKey(scenarioRecord{Spec: candidates[i].Spec, Scenario: candidates[i].Scenario}) <
+			scenarioKey(scenarioRecord{Spec: candidates[j].Spec, Scenario: candidates[j].Scenario})
+	})
+	return candidates
+}
+
+// TagFlaky adds the "flaky" tag to every flaky candidate's scenario in the
+// spec files under specDirs. Scenarios that already carry the tag, or
+// inherit it from their spec, are left alone.
+func TagFlaky(specDirs []string) error {
+	candidates := FlakyCandidates()
+	if len(candidates) == 0 {
+		logger.Infof(true, "No flaky candidates found in the recorded runs.")
+		return nil
+	}
+	dictionary, _, err := parser.ParseConcepts()
+	if err != nil {
+		return fmt.Errorf("failed to parse concepts. %s", err.Error())
+	}
+	specs, _ := parser.ParseSpecFiles(util.GetSpecFiles(specDirs), dictionary, gauge.NewBuildErrors())
+	tagged := 0
+	for _, spec := range specs {
+		scenarios := flakyScenariosIn(spec, candidates)
+		if len(scenarios) == 0 {
+			continue
+		}
+		if err := tagScenarios(spec.FileName, scenarios); err != nil {
+			return err
+		}
+		for _, scenario := range scenarios {
+			logger.Infof(true, "Tagged '%s: %s' as %s.", spec.Heading.Value, scenario.Heading.Value, flakyTag)
+		}
+		tagged += len(scenarios)
+	}
+	if tagged == 0 {
+		logger.Infof(true, "All flaky candidates are already tagged.")
+	}
+	return nil
+}
+
+// flakyScenariosIn lists the scenarios of the spec that are flaky
+// candidates and not already tagged as flaky.
+func flakyScenariosIn(spec *gauge.Specification, candidates []FlakyScenario) []*gauge.Scenario {
+	var scenarios []*gauge.Scenario
+	if hasFlakyTag(spec.Tags) {
+		return scenarios
+	}
+	for _, candidate := range candidates {
+		if candidate.Spec != spec.Heading.Value {
+			continue
+		}
+		for _, scenario := range spec.Scenarios {
+			if scenario.Heading.Value == candidate.Scenario && !hasFlakyTag(scenario.Tags) {
+				scenarios = append(scenarios, scenario)
+			}
+		}
+	}
+	return scenarios
+}
+
+func hasFlakyTag(tags *gauge.Tags) bool {
+	if tags == nil {
+		return false
+	}
+	for _, value := range tags.Values() {
+		if strings.EqualFold(strings.TrimSpace(value), flakyTag) {
+			return true
+		}
+	}
+	return false
+}
+
+// tagScenarios edits the spec file in place, appending the flaky tag to
+// each scenario's tags line or inserting one after its heading. Edits are
+// applied bottom-up so heading line numbers stay valid.
+func tagScenarios(fileName string, scenarios []*gauge.Scenario) error {
+	contents, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("failed to read %s. %s", fileName, err.Error())
+	}
+	lines := strings.Split(string(contents), "\n")
+	sort.Slice(scenarios, func(i, j int) bool { return scenarios[i].Heading.LineNo > scenarios[j].Heading.LineNo })
+	for _, scenario := range scenarios {
+		lines = addFlakyTag(lines, scenario.Heading.LineNo)
+	}
+	util.SaveFile(fileName, strings.Join(lines, "\n"), true)
+	return nil
+}
+
+// addFlakyTag adds the flaky tag to the scenario whose heading is at the
+// given 1-based line number, handling both "##" and underlined headings.
+func addFlakyTag(lines []string, headingLineNo int) []string {
+	insertAt := headingLineNo
+	if insertAt < len(lines) && isUnderline(lines[insertAt]) {
+		insertAt++
+	}
+	for i := insertAt; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(line), "tags:") {
+			lines[i] = strings.TrimRight(lines[i], " \t") + ", " + flakyTag
+			return lines
+		}
+		break
+	}
+	tagged := append([]string{}, lines[:insertAt]...)
+	tagged = append(tagged, "tags: "+flakyTag)
+	return append(tagged, lines[insertAt:]...)
+}
+
+// isUnderline reports whether the line is a setext-style heading underline.
+func isUnderline(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return false
+	}
+	return strings.Trim(trimmed, "-") == "" || strings.Trim(trimmed, "=") == ""
+}
@@ -0,0 +1,93 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package stats
+
+import (
+	"strings"
+	"testing"
+)
+
+func runsWithStatuses(statuses ...string) []runRecord {
+	var runs []runRecord
+	for _, status := range statuses {
+		runs = append(runs, runRecord{Scenarios: []scenarioRecord{{Spec: "s", Scenario: "a", Status: status}}})
+	}
+	return runs
+}
+
+func TestFlakyScenariosScoresAlternatingOutcomes(t *testing.T) {
+	candidates := flakyScenarios(runsWithStatuses("passed", "failed", "passed", "failed", "passed"))
+
+	if len(candidates) != 1 {
+		t.Fatalf("want one flaky candidate, got %v", candidates)
+	}
+	candidate := candidates[0]
+	if candidate.Score != 100 || candidate.Flips != 4 || candidate.Executions != 5 {
+		t.Errorf("want score 100 with 4 flips over 5 executions, got %+v", candidate)
+	}
+}
+
+func TestFlakyScenariosIgnoresStableOutcomes(t *testing.T) {
+	if candidates := flakyScenarios(runsWithStatuses("failed", "failed", "failed", "failed")); len(candidates) != 0 {
+		t.Errorf("want no candidates for a consistently failing scenario, got %v", candidates)
+	}
+}
+
+func TestFlakyScenariosNeedsEnoughExecutions(t *testing.T) {
+	if candidates := flakyScenarios(runsWithStatuses("passed", "failed")); len(candidates) != 0 {
+		t.Errorf("want no candidates below %d executions, got %v", flakyMinExecutions, candidates)
+	}
+}
+
+func TestFlakyScenariosIgnoresSkippedExecutions(t *testing.T) {
+	candidates := flakyScenarios(runsWithStatuses("passed", "skipped", "passed", "skipped", "passed"))
+
+	if len(candidates) != 0 {
+		t.Errorf("want no candidates when executed outcomes are stable, got %v", candidates)
+	}
+}
+
+func TestFlakyScenariosLooksAtRecentWindowOnly(t *testing.T) {
+	statuses := []string{"passed", "failed", "passed", "failed"}
+	for i := 0; i < flakyWindow; i++ {
+		statuses = append(statuses, "passed")
+	}
+
+	if candidates := flakyScenarios(runsWithStatuses(statuses...)); len(candidates) != 0 {
+		t.Errorf("want old flips outside the window ignored, got %v", candidates)
+	}
+}
+
+func TestAddFlakyTagInsertsTagsLineAfterHeading(t *testing.T) {
+	lines := []string{"# Spec", "", "## Scenario", "* step"}
+
+	tagged := addFlakyTag(lines, 3)
+
+	if got := strings.Join(tagged, "\n"); got != "# Spec\n\n## Scenario\ntags: flaky\n* step" {
+		t.Errorf("unexpected content:\n%s", got)
+	}
+}
+
+func TestAddFlakyTagAppendsToExistingTagsLine(t *testing.T) {
+	lines := []string{"# Spec", "", "## Scenario", "tags: slow", "* step"}
+
+	tagged := addFlakyTag(lines, 3)
+
+	if tagged[3] != "tags: slow, flaky" {
+		t.Errorf("want the tag appended, got %q", tagged[3])
+	}
+}
+
+func TestAddFlakyTagHandlesUnderlinedHeading(t *testing.T) {
+	lines := []string{"Spec", "====", "", "Scenario", "--------", "* step"}
+
+	tagged := addFlakyTag(lines, 4)
+
+	if tagged[5] != "tags: flaky" {
+		t.Errorf("want the tags line after the underline, got %q", tagged[5])
+	}
+}
@@ -0,0 +1,153 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package stats keeps a database of per-scenario outcomes and durations of
+// past runs under .gauge, and computes trend statistics from it: pass-rate
+// over time, the slowest scenarios, and scenarios that started failing in
+// the latest run. Runs are recorded when save_execution_result is enabled.
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// JSONOutput emits the statistics as JSON instead of text.
+var JSONOutput bool
+
+// slowestScenarioCount is how many scenarios the slowest-scenarios listing
+// shows.
+const slowestScenarioCount = 5
+
+// Trend holds the statistics computed from the recorded runs.
+type Trend struct {
+	Runs          int              `json:"runs"`
+	PassRates     []float64        `json:"passRates"`
+	Slowest       []scenarioRecord `json:"slowestScenarios"`
+	NewlyFailing  []scenarioRecord `json:"newlyFailing"`
+	Flaky         []FlakyScenario  `json:"flakyScenarios"`
+	LatestRunTime string           `json:"latestRun"`
+}
+
+// Run prints trend statistics from the stored runs.
+func Run() {
+	runs := loadRuns()
+	if len(runs) == 0 {
+		logger.Infof(true, "No recorded runs found. Set save_execution_result to true to record execution statistics.")
+		return
+	}
+	trend := computeTrend(runs)
+	if JSONOutput {
+		printJSONTrend(trend)
+		return
+	}
+	printTrend(trend)
+}
+
+func computeTrend(runs []runRecord) Trend {
+	trend := Trend{Runs: len(runs), LatestRunTime: runs[len(runs)-1].Timestamp.Format("2006-01-02 15:04:05")}
+	for _, run := range runs {
+		trend.PassRates = append(trend.PassRates, passRate(run))
+	}
+	trend.Slowest = slowestScenarios(runs[len(runs)-1])
+	if len(runs) > 1 {
+		trend.NewlyFailing = newlyFailing(runs[len(runs)-2], runs[len(runs)-1])
+	} else {
+		trend.NewlyFailing = newlyFailing(runRecord{}, runs[len(runs)-1])
+	}
+	trend.Flaky = flakyScenarios(runs)
+	return trend
+}
+
+// passRate is the percentage of executed (not skipped) scenarios that
+// passed in the run. A run with no executed scenarios counts as 100%.
+func passRate(run runRecord) float64 {
+	executed, passed := 0, 0
+	for _, scenario := range run.Scenarios {
+		if scenario.Status == "skipped" {
+			continue
+		}
+		executed++
+		if scenario.Status == "passed" {
+			passed++
+		}
+	}
+	if executed == 0 {
+		return 100
+	}
+	return float64(passed) * 100 / float64(executed)
+}
+
+func slowestScenarios(run runRecord) []scenarioRecord {
+	scenarios := make([]scenarioRecord, len(run.Scenarios))
+	copy(scenarios, run.Scenarios)
+	sort.SliceStable(scenarios, func(i, j int) bool { return scenarios[i].DurationMs > scenarios[j].DurationMs })
+	if len(scenarios) > slowestScenarioCount {
+		scenarios = scenarios[:slowestScenarioCount]
+	}
+	return scenarios
+}
+
+// newlyFailing lists the scenarios failing in the current run that were not
+// failing in the previous one.
+func newlyFailing(previous, current runRecord) []scenarioRecord {
+	failedBefore := make(map[string]bool)
+	for _, scenario := range previous.Scenarios {
+		if scenario.Status == "failed" {
+			failedBefore[scenarioKey(scenario)] = true
+		}
+	}
+	var newFailures []scenarioRecord
+	for _, scenario := range current.Scenarios {
+		if scenario.Status == "failed" && !failedBefore[scenarioKey(scenario)] {
+			newFailures = append(newFailures, scenario)
+		}
+	}
+	return newFailures
+}
+
+func scenarioKey(scenario scenarioRecord) string {
+	return scenario.Spec + "::" + scenario.Scenario
+}
+
+func printTrend(trend Trend) {
+	logger.Infof(true, "Execution statistics over %d recorded run(s), latest at %s.\n", trend.Runs, trend.LatestRunTime)
+	logger.Infof(true, "Pass rate trend (oldest to newest):")
+	for i, rate := range trend.PassRates {
+		logger.Infof(true, "  run %-3d %6.1f%%", i+1, rate)
+	}
+	logger.Infof(true, "\nSlowest scenarios in the latest run:")
+	for _, scenario := range trend.Slowest {
+		logger.Infof(true, "  %6dms  %s: %s", scenario.DurationMs, scenario.Spec, scenario.Scenario)
+	}
+	if len(trend.NewlyFailing) == 0 {
+		logger.Infof(true, "\nNo newly failing scenarios compared to the previous run.")
+	} else {
+		logger.Infof(true, "\nNewly failing scenarios compared to the previous run:")
+		for _, scenario := range trend.NewlyFailing {
+			logger.Infof(true, "  %s: %s", scenario.Spec, scenario.Scenario)
+		}
+	}
+	if len(trend.Flaky) > 0 {
+		logger.Infof(true, "\nPossibly flaky scenarios (pass/fail alternated across the last %d run(s)):", flakyWindow)
+		for _, scenario := range trend.Flaky {
+			logger.Infof(true, "  %5.1f%%  %s: %s (%d flip(s) in %d execution(s))", scenario.Score, scenario.Spec, scenario.Scenario, scenario.Flips, scenario.Executions)
+		}
+		logger.Infof(true, "Run 'gauge stats --tag-flaky' to tag these scenarios as %s.", flakyTag)
+	}
+}
+
+func printJSONTrend(trend Trend) {
+	contents, err := json.MarshalIndent(trend, "", "  ")
+	if err != nil {
+		logger.Fatalf(true, "Unable to marshal statistics: %s", err.Error())
+	}
+	fmt.Fprintln(os.Stdout, string(contents))
+}
@@ -0,0 +1,101 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package stats
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestPassRateIgnoresSkippedScenarios(t *testing.T) {
+	run := runRecord{Scenarios: []scenarioRecord{
+		{Scenario: "a", Status: "passed"},
+		{Scenario: "b", Status: "failed"},
+		{Scenario: "c", Status: "skipped"},
+	}}
+
+	if rate := passRate(run); rate != 50 {
+		t.Errorf("want pass rate 50, got %f", rate)
+	}
+}
+
+func TestSlowestScenariosAreSortedAndCapped(t *testing.T) {
+	run := runRecord{}
+	for i := 0; i < 10; i++ {
+		run.Scenarios = append(run.Scenarios, scenarioRecord{Scenario: "s", DurationMs: int64(i)})
+	}
+
+	slowest := slowestScenarios(run)
+
+	if len(slowest) != slowestScenarioCount {
+		t.Fatalf("want %d scenarios, got %d", slowestScenarioCount, len(slowest))
+	}
+	if slowest[0].DurationMs != 9 {
+		t.Errorf("want the slowest scenario first, got duration %d", slowest[0].DurationMs)
+	}
+}
+
+func TestNewlyFailingComparesWithPreviousRun(t *testing.T) {
+	previous := runRecord{Scenarios: []scenarioRecord{
+		{Spec: "s", Scenario: "a", Status: "failed"},
+		{Spec: "s", Scenario: "b", Status: "passed"},
+	}}
+	current := runRecord{Scenarios: []scenarioRecord{
+		{Spec: "s", Scenario: "a", Status: "failed"},
+		{Spec: "s", Scenario: "b", Status: "failed"},
+	}}
+
+	failures := newlyFailing(previous, current)
+
+	if len(failures) != 1 || failures[0].Scenario != "b" {
+		t.Errorf("want scenario b as newly failing, got %v", failures)
+	}
+}
+
+func TestRecordRoundTripAndCap(t *testing.T) {
+	projectRoot, err := ioutil.TempDir("", "gauge-stats")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err.Error())
+	}
+	defer os.RemoveAll(projectRoot)
+	if err := os.MkdirAll(filepath.Join(projectRoot, common.DotGauge), 0750); err != nil {
+		t.Fatalf("unable to create .gauge dir: %s", err.Error())
+	}
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = projectRoot
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := &result.SuiteResult{Timestamp: time.Now().Format(time.RFC3339), SpecResults: []*result.SpecResult{{
+		ProtoSpec: &gauge_messages.ProtoSpec{
+			SpecHeading: "My Spec",
+			Items: []*gauge_messages.ProtoItem{{
+				ItemType: gauge_messages.ProtoItem_Scenario,
+				Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "My Scenario", ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, ExecutionTime: 42},
+			}},
+		},
+	}}}
+	for i := 0; i < maxStoredRuns+5; i++ {
+		Record(res)
+	}
+
+	runs := loadRuns()
+	if len(runs) != maxStoredRuns {
+		t.Fatalf("want %d stored runs, got %d", maxStoredRuns, len(runs))
+	}
+	latest := runs[len(runs)-1]
+	if len(latest.Scenarios) != 1 || latest.Scenarios[0].Status != "failed" || latest.Scenarios[0].DurationMs != 42 {
+		t.Errorf("unexpected scenario record %v", latest.Scenarios)
+	}
+}
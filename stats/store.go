@@ -0,0 +1,118 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package stats
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	statsDBFile = "stats_db.json"
+	// maxStoredRuns caps the database so it doesn't grow without bound.
+	maxStoredRuns = 50
+)
+
+// scenarioRecord is the outcome of one scenario in one run.
+type scenarioRecord struct {
+	Spec       string `json:"spec"`
+	Scenario   string `json:"scenario"`
+	Status     string `json:"status"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// runRecord is the per-scenario outcome of one suite run.
+type runRecord struct {
+	Timestamp time.Time        `json:"timestamp"`
+	Passed    bool             `json:"passed"`
+	Scenarios []scenarioRecord `json:"scenarios"`
+}
+
+func statsDBPath() string {
+	return filepath.Join(config.ProjectRoot, common.DotGauge, statsDBFile)
+}
+
+// Record appends the run's per-scenario outcomes to the stats database
+// under .gauge. Persist failures are logged and ignored: statistics never
+// fail an execution.
+func Record(res *result.SuiteResult) {
+	record := runRecord{Timestamp: time.Now(), Passed: !res.IsFailed, Scenarios: scenarioRecords(res)}
+	runs := loadRuns()
+	runs = append(runs, record)
+	if len(runs) > maxStoredRuns {
+		runs = runs[len(runs)-maxStoredRuns:]
+	}
+	contents, err := json.MarshalIndent(runs, "", "  ")
+	if err != nil {
+		logger.Debugf(true, "Unable to marshal stats database: %s", err.Error())
+		return
+	}
+	if err := ioutil.WriteFile(statsDBPath(), contents, common.NewFilePermissions); err != nil {
+		logger.Debugf(true, "Unable to write stats database: %s", err.Error())
+	}
+}
+
+func loadRuns() []runRecord {
+	var runs []runRecord
+	contents, err := ioutil.ReadFile(statsDBPath())
+	if err != nil {
+		return runs
+	}
+	if err := json.Unmarshal(contents, &runs); err != nil {
+		logger.Debugf(true, "Unable to read stats database: %s", err.Error())
+		return nil
+	}
+	return runs
+}
+
+func scenarioRecords(res *result.SuiteResult) []scenarioRecord {
+	var records []scenarioRecord
+	for _, specResult := range res.SpecResults {
+		specName := specResult.ProtoSpec.GetSpecHeading()
+		for _, scenario := range scenariosOf(specResult.ProtoSpec) {
+			records = append(records, scenarioRecord{
+				Spec:       specName,
+				Scenario:   scenario.GetScenarioHeading(),
+				Status:     statusOf(scenario),
+				DurationMs: scenario.GetExecutionTime(),
+			})
+		}
+	}
+	return records
+}
+
+func scenariosOf(spec *gauge_messages.ProtoSpec) []*gauge_messages.ProtoScenario {
+	var scenarios []*gauge_messages.ProtoScenario
+	for _, item := range spec.GetItems() {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Scenario:
+			scenarios = append(scenarios, item.GetScenario())
+		case gauge_messages.ProtoItem_TableDrivenScenario:
+			scenarios = append(scenarios, item.GetTableDrivenScenario().GetScenario())
+		}
+	}
+	return scenarios
+}
+
+func statusOf(scenario *gauge_messages.ProtoScenario) string {
+	switch scenario.GetExecutionStatus() {
+	case gauge_messages.ExecutionStatus_FAILED:
+		return "failed"
+	case gauge_messages.ExecutionStatus_SKIPPED:
+		return "skipped"
+	default:
+		return "passed"
+	}
+}
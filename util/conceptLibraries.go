@@ -0,0 +1,95 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/version"
+)
+
+// conceptLibrariesDir is the directory under the gauge home where installed
+// concept libraries live, one sub directory per library and version.
+const conceptLibrariesDir = "concepts"
+
+// ConceptLibraryDirs resolves the concept libraries declared in the project
+// manifest to directories of .cpt files. Entries that cannot be resolved are
+// skipped with a warning; the concepts they would have provided then surface
+// as unresolved steps.
+var ConceptLibraryDirs = func() []string {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return nil
+	}
+	var dirs []string
+	for _, library := range m.ConceptLibraries {
+		dir, err := conceptLibraryDir(library)
+		if err != nil {
+			logger.Warningf(true, "Skipping concept library '%s': %s", library, err.Error())
+			continue
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+// conceptLibraryDir resolves one manifest entry: a directory (absolute or
+// relative to the project root), or the name of a library installed under
+// <gauge home>/concepts, optionally pinned as `name@version`. An unpinned
+// name picks the latest installed version.
+func conceptLibraryDir(library string) (string, error) {
+	path := GetPathToFile(library)
+	if common.DirExists(path) {
+		return path, nil
+	}
+	home, err := common.GetGaugeHomeDirectory()
+	if err != nil {
+		return "", err
+	}
+	name, libraryVersion := library, ""
+	if i := strings.LastIndex(library, "@"); i > 0 {
+		name, libraryVersion = library[:i], library[i+1:]
+	}
+	libraryDir := filepath.Join(home, conceptLibrariesDir, name)
+	if libraryVersion == "" {
+		libraryVersion, err = latestInstalledVersionIn(libraryDir)
+		if err != nil {
+			return "", err
+		}
+	}
+	versionedDir := filepath.Join(libraryDir, libraryVersion)
+	if !common.DirExists(versionedDir) {
+		return "", fmt.Errorf("no concept library directory at %s or %s", path, versionedDir)
+	}
+	return versionedDir, nil
+}
+
+func latestInstalledVersionIn(libraryDir string) (string, error) {
+	entries, err := ioutil.ReadDir(libraryDir)
+	if err != nil {
+		return "", fmt.Errorf("concept library is not installed in %s", libraryDir)
+	}
+	var versions []*version.Version
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if v, err := version.ParseVersion(entry.Name()); err == nil {
+			versions = append(versions, v)
+		}
+	}
+	if len(versions) == 0 {
+		return "", fmt.Errorf("no versions of the concept library are installed in %s", libraryDir)
+	}
+	return version.GetLatestVersion(versions).String(), nil
+}
@@ -156,6 +156,14 @@ var exitWithMessage = func(message string) {
 var GetSpecFiles = func(paths []string) []string {
 	var specFiles []string
 	for _, path := range paths {
+		if hasGlobMeta(path) && !common.FileExists(path) {
+			matched := expandGlob(path)
+			if len(matched) < 1 {
+				exitWithMessage(fmt.Sprintf("No specifications found matching glob %s.", path))
+			}
+			specFiles = append(specFiles, matched...)
+			continue
+		}
 		if !common.FileExists(path) {
 			exitWithMessage(fmt.Sprintf("Specs directory %s does not exists.", path))
 		}
@@ -170,10 +178,11 @@ var GetSpecFiles = func(paths []string) []string {
 			specFiles = append(specFiles, f)
 		}
 	}
-	return specFiles
+	return filterExcludedSpecs(specFiles)
 }
 
-// GetConceptFiles returns the list of concept files present in the PROJECTROOT
+// GetConceptFiles returns the list of concept files present in the
+// PROJECTROOT and in the concept libraries the manifest declares.
 var GetConceptFiles = func() []string {
 	projRoot := config.ProjectRoot
 	if projRoot == "" {
@@ -183,7 +192,11 @@ var GetConceptFiles = func() []string {
 	if err != nil {
 		logger.Fatalf(true, "Error getting absolute path. %v", err)
 	}
-	return FindConceptFilesIn(absPath)
+	conceptFiles := FindConceptFilesIn(absPath)
+	for _, libraryDir := range ConceptLibraryDirs() {
+		conceptFiles = append(conceptFiles, FindConceptFilesIn(libraryDir)...)
+	}
+	return conceptFiles
 }
 
 // SaveFile saves contents at the given path
@@ -195,7 +208,11 @@ func SaveFile(fileName string, content string, backup bool) {
 }
 
 func RelPathToProjectRoot(path string) string {
-	return strings.TrimPrefix(path, config.ProjectRoot+string(filepath.Separator))
+	prefix := config.ProjectRoot + string(filepath.Separator)
+	if len(path) >= len(prefix) && PathsEqual(path[:len(prefix)], prefix) {
+		return path[len(prefix):]
+	}
+	return strings.TrimPrefix(path, prefix)
 }
 
 // GetPathToFile returns the path to a given file from the Project root
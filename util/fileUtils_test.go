@@ -266,3 +266,54 @@ func createDirIn(dir string, dirName string) (string, error) {
 	err = os.Rename(tempDir, fullDirName)
 	return fullDirName, err
 }
+
+func (s *MySuite) TestConceptLibraryDirResolvesAPathRelativeToProjectRoot(c *C) {
+	config.ProjectRoot = dir
+	sharedDir := filepath.Join(dir, "shared")
+	c.Assert(os.MkdirAll(sharedDir, 0755), Equals, nil)
+
+	resolved, err := conceptLibraryDir("shared")
+
+	c.Assert(err, Equals, nil)
+	c.Assert(resolved, Equals, sharedDir)
+}
+
+func (s *MySuite) TestConceptLibraryDirResolvesAnInstalledLibrary(c *C) {
+	config.ProjectRoot = dir
+	os.Setenv("GAUGE_HOME", dir)
+	defer os.Unsetenv("GAUGE_HOME")
+	c.Assert(os.MkdirAll(filepath.Join(dir, "concepts", "billing", "1.0.0"), 0755), Equals, nil)
+	c.Assert(os.MkdirAll(filepath.Join(dir, "concepts", "billing", "1.2.0"), 0755), Equals, nil)
+
+	latest, err := conceptLibraryDir("billing")
+	c.Assert(err, Equals, nil)
+	c.Assert(latest, Equals, filepath.Join(dir, "concepts", "billing", "1.2.0"))
+
+	pinned, err := conceptLibraryDir("billing@1.0.0")
+	c.Assert(err, Equals, nil)
+	c.Assert(pinned, Equals, filepath.Join(dir, "concepts", "billing", "1.0.0"))
+}
+
+func (s *MySuite) TestConceptLibraryDirFailsForAMissingLibrary(c *C) {
+	config.ProjectRoot = dir
+	os.Setenv("GAUGE_HOME", dir)
+	defer os.Unsetenv("GAUGE_HOME")
+
+	_, err := conceptLibraryDir("no-such-library")
+
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestGetConceptFilesIncludesManifestConceptLibraries(c *C) {
+	config.ProjectRoot = dir
+	sharedDir, err := ioutil.TempDir("", "gauge_concept_library_")
+	c.Assert(err, Equals, nil)
+	defer os.RemoveAll(sharedDir)
+	createFileIn(sharedDir, "shared.cpt", []byte("# shared concept\n* a step\n"))
+	createFileIn(dir, "local.cpt", []byte("# local concept\n* a step\n"))
+	createFileIn(dir, "manifest.json", []byte(fmt.Sprintf(`{"Language": "java", "ConceptLibraries": [%q]}`, sharedDir)))
+
+	conceptFiles := GetConceptFiles()
+
+	c.Assert(len(conceptFiles), Equals, 2)
+}
@@ -10,14 +10,42 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
 
 	"github.com/getgauge/common"
 )
 
+// httpClient routes requests through the proxy configured via gauge_proxy_url,
+// falling back to the standard proxy environment variables.
+var httpClient = &http.Client{Transport: &http.Transport{Proxy: proxyFor}}
+
+func proxyFor(req *http.Request) (*url.URL, error) {
+	if proxy := config.GaugeProxyURL(); proxy != "" {
+		return url.Parse(proxy)
+	}
+	return http.ProxyFromEnvironment(req)
+}
+
+// GetWithAuth fires a HTTP GET request to the given URL, attaching the
+// Authorization header configured for it in the Gauge properties file, if any.
+// This lets plugins, templates and update checks be served from private,
+// credential-protected endpoints.
+func GetWithAuth(url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if header := config.AuthHeaderFor(url); header != "" {
+		req.Header.Set("Authorization", header)
+	}
+	return httpClient.Do(req)
+}
+
 // progressReader is for indicating the download / upload progress on the console
 type progressReader struct {
 	io.Reader
@@ -55,7 +83,7 @@ func Download(url, targetDir, fileName string, silent bool) (string, error) {
 	targetFile := filepath.Join(targetDir, fileName)
 
 	logger.Debugf(true, "Downloading %s", url)
-	resp, err := http.Get(url)
+	resp, err := GetWithAuth(url)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,136 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+)
+
+// gaugeIgnoreFileName is the file at the project root listing glob
+// patterns of spec files to leave out of discovery, one per line. Blank
+// lines and lines starting with # are ignored.
+const gaugeIgnoreFileName = ".gaugeignore"
+
+// ExcludedGlobs holds glob patterns of spec files to leave out of
+// discovery, given on the command line via --exclude. They apply in
+// addition to the patterns in .gaugeignore.
+var ExcludedGlobs []string
+
+// Patterns are matched against the spec file path relative to the project
+// root, with forward slashes. `*` and `?` do not cross directory
+// boundaries; `**` matches any number of them. A pattern without a slash
+// also matches against the file name alone, so "legacy.spec" excludes the
+// file in any directory.
+func excludePatterns() []string {
+	patterns := append([]string{}, ExcludedGlobs...)
+	contents, err := ioutil.ReadFile(filepath.Join(config.ProjectRoot, gaugeIgnoreFileName))
+	if err != nil {
+		return patterns
+	}
+	for _, line := range GetLinesFromText(string(contents)) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns
+}
+
+// filterExcludedSpecs drops the spec files matching .gaugeignore or
+// --exclude patterns from the given list.
+func filterExcludedSpecs(specFiles []string) []string {
+	patterns := excludePatterns()
+	if len(patterns) == 0 {
+		return specFiles
+	}
+	var files []string
+	for _, file := range specFiles {
+		if isExcludedSpec(file, patterns) {
+			logger.Debugf(true, "Excluding spec file %s", file)
+			continue
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+func isExcludedSpec(path string, patterns []string) bool {
+	relPath := filepath.ToSlash(RelPathToProjectRoot(path))
+	for _, pattern := range patterns {
+		re, err := globToRegexp(filepath.ToSlash(pattern))
+		if err != nil {
+			logger.Warningf(true, "Skipping invalid exclude pattern '%s'. %s", pattern, err.Error())
+			continue
+		}
+		if re.MatchString(relPath) {
+			return true
+		}
+		if !strings.Contains(pattern, "/") && re.MatchString(filepath.Base(relPath)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasGlobMeta reports whether the path contains glob metacharacters.
+func hasGlobMeta(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+// expandGlob returns the spec files under the project root whose path
+// relative to it matches the glob pattern.
+func expandGlob(pattern string) []string {
+	re, err := globToRegexp(filepath.ToSlash(pattern))
+	if err != nil {
+		exitWithMessage(fmt.Sprintf("Invalid glob pattern '%s'. %s", pattern, err.Error()))
+		return nil
+	}
+	var matched []string
+	for _, file := range FindSpecFilesIn(config.ProjectRoot) {
+		if re.MatchString(filepath.ToSlash(RelPathToProjectRoot(file))) {
+			matched = append(matched, file)
+		}
+	}
+	return matched
+}
+
+// globToRegexp compiles a glob pattern: `*` and `?` match within one path
+// segment, `**` spans segments.
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				if i+2 < len(pattern) && pattern[i+2] == '/' {
+					b.WriteString("(?:[^/]+/)*")
+					i += 2
+				} else {
+					b.WriteString(".*")
+					i++
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,91 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func createSpecFile(c *C, relPath string) string {
+	path := filepath.Join(dir, relPath)
+	err := os.MkdirAll(filepath.Dir(path), 0750)
+	c.Assert(err, Equals, nil)
+	err = ioutil.WriteFile(path, []byte("# Spec\n## Scenario\n* step\n"), 0644)
+	c.Assert(err, Equals, nil)
+	return path
+}
+
+func (s *MySuite) TestGlobToRegexp(c *C) {
+	matches := func(pattern, path string) bool {
+		re, err := globToRegexp(pattern)
+		c.Assert(err, Equals, nil)
+		return re.MatchString(path)
+	}
+	c.Assert(matches("specs/legacy/**", "specs/legacy/old.spec"), Equals, true)
+	c.Assert(matches("specs/legacy/**", "specs/legacy/nested/old.spec"), Equals, true)
+	c.Assert(matches("specs/legacy/**", "specs/current/new.spec"), Equals, false)
+	c.Assert(matches("specs/*.spec", "specs/foo.spec"), Equals, true)
+	c.Assert(matches("specs/*.spec", "specs/nested/foo.spec"), Equals, false)
+	c.Assert(matches("specs/**/foo.spec", "specs/foo.spec"), Equals, true)
+	c.Assert(matches("specs/**/foo.spec", "specs/a/b/foo.spec"), Equals, true)
+	c.Assert(matches("foo?.spec", "foo1.spec"), Equals, true)
+	c.Assert(matches("foo?.spec", "foo12.spec"), Equals, false)
+}
+
+func (s *MySuite) TestGetSpecFilesHonoursGaugeIgnore(c *C) {
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	createSpecFile(c, filepath.Join("specs", "new.spec"))
+	createSpecFile(c, filepath.Join("specs", "legacy", "old.spec"))
+	err := ioutil.WriteFile(filepath.Join(dir, gaugeIgnoreFileName), []byte("# legacy suite, not maintained\nspecs/legacy/**\n"), 0644)
+	c.Assert(err, Equals, nil)
+
+	files := GetSpecFiles([]string{filepath.Join(dir, "specs")})
+
+	c.Assert(len(files), Equals, 1)
+	c.Assert(filepath.Base(files[0]), Equals, "new.spec")
+}
+
+func (s *MySuite) TestGetSpecFilesHonoursExcludedGlobs(c *C) {
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	oldGlobs := ExcludedGlobs
+	ExcludedGlobs = []string{"specs/legacy/**"}
+	defer func() { ExcludedGlobs = oldGlobs }()
+	createSpecFile(c, filepath.Join("specs", "new.spec"))
+	createSpecFile(c, filepath.Join("specs", "legacy", "old.spec"))
+
+	files := GetSpecFiles([]string{filepath.Join(dir, "specs")})
+
+	c.Assert(len(files), Equals, 1)
+	c.Assert(filepath.Base(files[0]), Equals, "new.spec")
+}
+
+func (s *MySuite) TestExcludePatternWithoutSlashMatchesFileName(c *C) {
+	c.Assert(isExcludedSpec(filepath.Join(dir, "specs", "old.spec"), []string{"old.spec"}), Equals, true)
+	c.Assert(isExcludedSpec(filepath.Join(dir, "specs", "new.spec"), []string{"old.spec"}), Equals, false)
+}
+
+func (s *MySuite) TestGetSpecFilesExpandsGlobArgs(c *C) {
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	createSpecFile(c, filepath.Join("specs", "login.spec"))
+	createSpecFile(c, filepath.Join("specs", "logout.spec"))
+	createSpecFile(c, filepath.Join("specs", "search.spec"))
+
+	files := GetSpecFiles([]string{"specs/log*.spec"})
+
+	c.Assert(len(files), Equals, 2)
+}
@@ -0,0 +1,54 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+const (
+	longPathPrefix    = `\\?\`
+	uncLongPathPrefix = `\\?\UNC\`
+)
+
+// NormalizePath canonicalizes a file path for comparison across platforms:
+// redundant elements are removed, separators become OS native, the Windows
+// long-path prefix (\\?\) is stripped with UNC paths keeping their leading
+// \\, and case is folded on Windows where the filesystem is case-insensitive.
+// The result is meant for comparisons and map keys, not for display.
+func NormalizePath(path string) string {
+	if IsWindows() {
+		return strings.ToLower(filepath.Clean(stripLongPathPrefix(toWindowsSeparators(path))))
+	}
+	return filepath.Clean(path)
+}
+
+// PathsEqual reports whether two paths refer to the same file, tolerating
+// differences in case (on Windows), separators and redundant path elements.
+func PathsEqual(path1, path2 string) bool {
+	return NormalizePath(path1) == NormalizePath(path2)
+}
+
+// HasPathPrefix reports whether path is inside the directory prefix, with the
+// same tolerance as PathsEqual.
+func HasPathPrefix(path, prefix string) bool {
+	return strings.HasPrefix(NormalizePath(path), NormalizePath(prefix))
+}
+
+// stripLongPathPrefix removes the Windows long-path prefix, turning
+// \\?\C:\specs into C:\specs and \\?\UNC\server\share into \\server\share.
+func stripLongPathPrefix(path string) string {
+	if strings.HasPrefix(path, uncLongPathPrefix) {
+		return WindowsSep + WindowsSep + path[len(uncLongPathPrefix):]
+	}
+	return strings.TrimPrefix(path, longPathPrefix)
+}
+
+func toWindowsSeparators(path string) string {
+	return strings.Replace(path, UnixSep, WindowsSep, -1)
+}
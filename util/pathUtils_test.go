@@ -0,0 +1,64 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"testing"
+)
+
+func TestNormalizePathRemovesRedundantElements(t *testing.T) {
+	got := NormalizePath("specs//nested/../example.spec")
+	want := "specs/example.spec"
+	if IsWindows() {
+		want = `specs\example.spec`
+	}
+	if got != want {
+		t.Errorf("got : %s, want : %s", got, want)
+	}
+}
+
+func TestPathsEqualToleratesRedundantElements(t *testing.T) {
+	if !PathsEqual("specs/example.spec", "specs/./example.spec") {
+		t.Error("Expected paths to be equal")
+	}
+	if PathsEqual("specs/example.spec", "specs/other.spec") {
+		t.Error("Expected paths to differ")
+	}
+}
+
+func TestStripLongPathPrefix(t *testing.T) {
+	got := stripLongPathPrefix(`\\?\C:\Users\gauge\example.spec`)
+	want := `C:\Users\gauge\example.spec`
+	if got != want {
+		t.Errorf("got : %s, want : %s", got, want)
+	}
+}
+
+func TestStripLongPathPrefixKeepsUNCRoot(t *testing.T) {
+	got := stripLongPathPrefix(`\\?\UNC\server\share\example.spec`)
+	want := `\\server\share\example.spec`
+	if got != want {
+		t.Errorf("got : %s, want : %s", got, want)
+	}
+}
+
+func TestToWindowsSeparators(t *testing.T) {
+	got := toWindowsSeparators(`specs/nested/example.spec`)
+	want := `specs\nested\example.spec`
+	if got != want {
+		t.Errorf("got : %s, want : %s", got, want)
+	}
+}
+
+func TestHasPathPrefix(t *testing.T) {
+	if !HasPathPrefix("project/specs/example.spec", "project/specs") {
+		t.Error("Expected prefix to match")
+	}
+	if HasPathPrefix("project/specs/example.spec", "project/other") {
+		t.Error("Expected prefix to not match")
+	}
+}
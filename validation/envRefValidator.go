@@ -0,0 +1,89 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+// validateEnvSpecificReferences checks that references which depend on the
+// chosen environment resolve before execution starts: file/table special
+// parameters must point at readable files and dynamic parameters must resolve
+// from the spec data table or the environment. All failures for a spec are
+// reported together so a misconfigured env fails fast with a consolidated list.
+func validateEnvSpecificReferences(spec *gauge.Specification) []error {
+	var errs []error
+	for _, step := range allExecutableSteps(spec) {
+		errs = append(errs, validateStepReferences(spec, step)...)
+	}
+	return errs
+}
+
+func allExecutableSteps(spec *gauge.Specification) []*gauge.Step {
+	steps := append([]*gauge.Step{}, spec.Contexts...)
+	for _, scenario := range spec.Scenarios {
+		steps = append(steps, scenario.Steps...)
+	}
+	return append(steps, spec.TearDownSteps...)
+}
+
+func validateStepReferences(spec *gauge.Specification, step *gauge.Step) []error {
+	var errs []error
+	for _, arg := range step.Args {
+		switch arg.ArgType {
+		case gauge.SpecialString, gauge.SpecialTable:
+			if err := validateSpecialParamFile(arg.Name); err != nil {
+				errs = append(errs, NewStepValidationError(step, err.Error(), spec.FileName, &invalidResponse, ""))
+			}
+		case gauge.Dynamic:
+			if !resolvesFromDataTableOrEnv(spec, arg.Value) {
+				msg := fmt.Sprintf("Dynamic parameter <%s> could not be resolved from the data table or the environment", arg.Value)
+				errs = append(errs, NewStepValidationError(step, msg, spec.FileName, &invalidResponse, ""))
+			}
+		}
+	}
+	if step.IsConcept {
+		// Parameters inside a concept resolve from the concept lookup, only the
+		// special parameters passed into it need a file on disk.
+		for _, cStep := range step.ConceptSteps {
+			for _, arg := range cStep.Args {
+				if arg.ArgType == gauge.SpecialString || arg.ArgType == gauge.SpecialTable {
+					if err := validateSpecialParamFile(arg.Name); err != nil {
+						errs = append(errs, NewStepValidationError(cStep, err.Error(), spec.FileName, &invalidResponse, ""))
+					}
+				}
+			}
+		}
+	}
+	return errs
+}
+
+func validateSpecialParamFile(name string) error {
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+	filePath := strings.TrimSpace(parts[1])
+	if _, err := util.GetFileContents(filePath); err != nil {
+		return fmt.Errorf("Special parameter <%s> could not be resolved, file %s is not readable", name, filePath)
+	}
+	return nil
+}
+
+func resolvesFromDataTableOrEnv(spec *gauge.Specification, param string) bool {
+	for _, header := range spec.DataTable.Table.Headers {
+		if header == param {
+			return true
+		}
+	}
+	return os.Getenv(param) != ""
+}
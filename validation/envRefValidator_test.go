@@ -0,0 +1,73 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"os"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestValidateEnvSpecificReferencesWithResolvableDynamicParam(t *testing.T) {
+	spec := &gauge.Specification{
+		DataTable: gauge.DataTable{Table: *gauge.NewTable([]string{"user"}, [][]gauge.TableCell{{{Value: "foo", CellType: gauge.Static}}}, 1)},
+		Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{
+			{Value: "hello {}", Args: []*gauge.StepArg{{Value: "user", ArgType: gauge.Dynamic}}},
+		}}},
+	}
+
+	errs := validateEnvSpecificReferences(spec)
+
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateEnvSpecificReferencesWithUnresolvableDynamicParam(t *testing.T) {
+	spec := &gauge.Specification{
+		Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{
+			{Value: "hello {}", Args: []*gauge.StepArg{{Value: "no_such_param_anywhere", ArgType: gauge.Dynamic}}},
+		}}},
+	}
+
+	errs := validateEnvSpecificReferences(spec)
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+}
+
+func TestValidateEnvSpecificReferencesResolvesDynamicParamFromEnv(t *testing.T) {
+	os.Setenv("resolved_from_env", "value")
+	defer os.Unsetenv("resolved_from_env")
+	spec := &gauge.Specification{
+		Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{
+			{Value: "hello {}", Args: []*gauge.StepArg{{Value: "resolved_from_env", ArgType: gauge.Dynamic}}},
+		}}},
+	}
+
+	errs := validateEnvSpecificReferences(spec)
+
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateEnvSpecificReferencesWithMissingSpecialFile(t *testing.T) {
+	spec := &gauge.Specification{
+		Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{
+			{Value: "read {}", Args: []*gauge.StepArg{{Name: "file:/no/such/file.txt", ArgType: gauge.SpecialString}}},
+		}}},
+	}
+
+	errs := validateEnvSpecificReferences(spec)
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+}
@@ -0,0 +1,104 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/getgauge/gauge/parser"
+)
+
+// JSONOutput makes Validate emit issues as a structured JSON document on
+// stdout instead of human readable log lines, for editor integrations and CI
+// annotations. Set by the --json flag.
+var JSONOutput bool
+
+// ValidationIssue is one parse or validation error in machine-readable form.
+// Column is 1 when gauge only tracked the line the issue is on.
+type ValidationIssue struct {
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Column     int    `json:"column"`
+	StepText   string `json:"stepText,omitempty"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+const specValidationErrorCode = "SPEC_VALIDATION_ERROR"
+
+// issuesIn flattens the validation result into a deduplicated, sorted list of
+// issues covering parse errors, spec level errors and step validation errors.
+func issuesIn(res *ValidationResult) []ValidationIssue {
+	var errs []error
+	if res.ErrMap != nil {
+		for _, specErrs := range res.ErrMap.SpecErrs {
+			errs = append(errs, specErrs...)
+		}
+		for _, stepErr := range res.ErrMap.StepErrs {
+			errs = append(errs, stepErr)
+		}
+	}
+	errs = append(errs, res.Errs...)
+
+	issues := make([]ValidationIssue, 0)
+	seen := make(map[string]bool)
+	for _, err := range errs {
+		issue, ok := toIssue(err)
+		if !ok {
+			continue
+		}
+		key := fmt.Sprintf("%s:%d:%s:%s", issue.File, issue.Line, issue.Code, issue.Message)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		issues = append(issues, issue)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Message < issues[j].Message
+	})
+	return issues
+}
+
+func toIssue(err error) (ValidationIssue, bool) {
+	switch e := err.(type) {
+	case parser.ParseError:
+		return ValidationIssue{Code: e.Code(), Message: e.Message, File: e.FileName, Line: e.LineNo, Column: e.Column(), StepText: e.LineText}, true
+	case StepValidationError:
+		return ValidationIssue{
+			Code:       e.ErrorType().String(),
+			Message:    e.Message(),
+			File:       e.FileName(),
+			Line:       e.Step().LineNo,
+			Column:     1,
+			StepText:   e.Step().GetLineText(),
+			Suggestion: e.Suggestion(),
+		}, true
+	case SpecValidationError:
+		return ValidationIssue{Code: specValidationErrorCode, Message: e.message, File: e.fileName, Line: 1, Column: 1}, true
+	default:
+		return ValidationIssue{}, false
+	}
+}
+
+func printJSONIssues(res *ValidationResult) error {
+	contents, err := json.MarshalIndent(issuesIn(res), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(contents))
+	return nil
+}
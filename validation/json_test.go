@@ -0,0 +1,64 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+)
+
+func TestIssuesInConvertsParseAndValidationErrors(t *testing.T) {
+	spec := &gauge.Specification{FileName: "one.spec"}
+	step := &gauge.Step{LineNo: 7, LineText: "a step", Value: "a step"}
+	notFound := gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND
+	errMap := gauge.NewBuildErrors()
+	errMap.SpecErrs[spec] = []error{parser.ParseError{FileName: "one.spec", LineNo: 3, Message: "Spec heading not found", LineText: "text"}}
+	errMap.StepErrs[step] = NewStepValidationError(step, "Step implementation not found", "one.spec", &notFound, "stub()")
+	res := NewValidationResult(nil, errMap, nil, false)
+
+	issues := issuesIn(res)
+
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %v", issues)
+	}
+	if issues[0].Code != "MISSING_SPEC_HEADING" || issues[0].Line != 3 || issues[0].File != "one.spec" {
+		t.Errorf("Unexpected parse issue %+v", issues[0])
+	}
+	if issues[1].Code != "STEP_IMPLEMENTATION_NOT_FOUND" || issues[1].Line != 7 || issues[1].Suggestion != "stub()" {
+		t.Errorf("Unexpected step issue %+v", issues[1])
+	}
+}
+
+func TestIssuesInFlagsDuplicateScenarioHeadings(t *testing.T) {
+	spec := &gauge.Specification{FileName: "one.spec"}
+	errMap := gauge.NewBuildErrors()
+	errMap.SpecErrs[spec] = []error{parser.ParseError{FileName: "one.spec", LineNo: 12, Message: "Duplicate scenario definition 'Login' found in the same specification"}}
+	res := NewValidationResult(nil, errMap, nil, false)
+
+	issues := issuesIn(res)
+
+	if len(issues) != 1 || issues[0].Code != "DUPLICATE_SCENARIO_HEADING" {
+		t.Errorf("Expected a DUPLICATE_SCENARIO_HEADING issue, got %v", issues)
+	}
+}
+
+func TestIssuesInDeduplicatesRepeatedErrors(t *testing.T) {
+	spec := &gauge.Specification{FileName: "one.spec"}
+	parseErr := parser.ParseError{FileName: "one.spec", LineNo: 3, Message: "Spec heading not found"}
+	errMap := gauge.NewBuildErrors()
+	errMap.SpecErrs[spec] = []error{parseErr, parseErr}
+	res := NewValidationResult(nil, errMap, nil, false, parseErr)
+
+	issues := issuesIn(res)
+
+	if len(issues) != 1 {
+		t.Errorf("Expected duplicates to be removed, got %v", issues)
+	}
+}
@@ -0,0 +1,159 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// reservedTags is the registry of tags gauge itself gives semantics to.
+// Validation flags likely typos of these (closest-match suggestions) and
+// combinations that contradict each other.
+var reservedTags = []string{"serial", "xfail", "quarantined"}
+
+// reservedTagPrefixes are reserved tags carrying a value, e.g. timeout:30s
+// or env:staging.
+var reservedTagPrefixes = []string{"timeout", "env"}
+
+// conflictingTags lists reserved tag pairs that make no sense together.
+var conflictingTags = [][]string{
+	{"xfail", "quarantined"},
+}
+
+// validateReservedTags checks spec and scenario tags against the reserved tag
+// registry and reports typos, malformed values and conflicting combinations.
+func validateReservedTags(spec *gauge.Specification) []error {
+	var errs []error
+	addErrs := func(tags *gauge.Tags) {
+		for _, err := range checkTags(tags) {
+			errs = append(errs, NewSpecValidationError(err.Error(), spec.FileName))
+		}
+	}
+	if spec.Tags != nil {
+		addErrs(spec.Tags)
+	}
+	for _, scenario := range spec.Scenarios {
+		if scenario.Tags != nil {
+			addErrs(scenario.Tags)
+		}
+	}
+	return errs
+}
+
+func checkTags(tags *gauge.Tags) []error {
+	var errs []error
+	values := tags.Values()
+	for _, tag := range values {
+		if err := checkTag(strings.TrimSpace(tag)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, pair := range conflictingTags {
+		if containsTag(values, pair[0]) && containsTag(values, pair[1]) {
+			errs = append(errs, fmt.Errorf("Tags '%s' and '%s' conflict with each other", pair[0], pair[1]))
+		}
+	}
+	return errs
+}
+
+func checkTag(tag string) error {
+	lower := strings.ToLower(tag)
+	if idx := strings.Index(lower, ":"); idx >= 0 {
+		prefix, value := lower[:idx], strings.TrimSpace(lower[idx+1:])
+		for _, reserved := range reservedTagPrefixes {
+			if prefix == reserved {
+				return checkReservedTagValue(reserved, value)
+			}
+			if isLikelyTypo(prefix, reserved) {
+				return fmt.Errorf("Unknown tag '%s', did you mean '%s:%s'?", tag, reserved, value)
+			}
+		}
+		return nil
+	}
+	for _, reserved := range reservedTags {
+		if lower == reserved {
+			return nil
+		}
+		if isLikelyTypo(lower, reserved) {
+			return fmt.Errorf("Unknown tag '%s', did you mean '%s'?", tag, reserved)
+		}
+	}
+	return nil
+}
+
+func checkReservedTagValue(prefix, value string) error {
+	switch prefix {
+	case "timeout":
+		if _, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSuffix(value, "s"), "m")); err != nil || value == "" {
+			return fmt.Errorf("Tag 'timeout:%s' has an invalid value, expected a duration like timeout:30s", value)
+		}
+	case "env":
+		if value == "" {
+			return fmt.Errorf("Tag 'env:' has no value, expected an environment name like env:staging")
+		}
+		if !common.DirExists(filepath.Join(config.ProjectRoot, common.EnvDirectoryName, value)) {
+			return fmt.Errorf("Tag 'env:%s' refers to an environment that does not exist", value)
+		}
+	}
+	return nil
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(strings.TrimSpace(t), tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLikelyTypo tells if tag is close enough to a reserved tag to be a likely
+// misspelling: edit distance 1, or 2 for longer names.
+func isLikelyTypo(tag, reserved string) bool {
+	if tag == reserved {
+		return false
+	}
+	distance := editDistance(tag, reserved)
+	if len(reserved) >= 6 {
+		return distance <= 2
+	}
+	return distance <= 1
+}
+
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := 0; j <= len(b); j++ {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, min(curr[j-1]+1, prev[j-1]+cost))
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,64 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func specWithTags(tags ...string) *gauge.Specification {
+	return &gauge.Specification{FileName: "spec.spec", Tags: &gauge.Tags{RawValues: [][]string{tags}}}
+}
+
+func TestValidateReservedTagsAcceptsExactReservedTags(t *testing.T) {
+	errs := validateReservedTags(specWithTags("serial", "quarantined", "timeout:30s"))
+
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateReservedTagsCatchesTypo(t *testing.T) {
+	errs := validateReservedTags(specWithTags("serail"))
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if !strings.Contains(errs[0].Error(), "serial") {
+		t.Errorf("Expected suggestion for 'serial', got: %s", errs[0].Error())
+	}
+}
+
+func TestValidateReservedTagsCatchesConflicts(t *testing.T) {
+	errs := validateReservedTags(specWithTags("xfail", "quarantined"))
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if !strings.Contains(errs[0].Error(), "conflict") {
+		t.Errorf("Expected conflict error, got: %s", errs[0].Error())
+	}
+}
+
+func TestValidateReservedTagsCatchesBadTimeoutValue(t *testing.T) {
+	errs := validateReservedTags(specWithTags("timeout:abc"))
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+}
+
+func TestValidateReservedTagsIgnoresUnrelatedTags(t *testing.T) {
+	errs := validateReservedTags(specWithTags("sanity", "login", "nightly"))
+
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
@@ -0,0 +1,47 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/getgauge/common"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// GenerateStubsFile is the file step implementation stubs are written to when
+// validation finds unimplemented steps. The stub code comes from the language
+// runner's validation response, so it is in the project's language. Empty
+// means stubs are only printed to the console. Set by the --generate-stubs
+// flag.
+var GenerateStubsFile string
+
+// writeStubs collects the runner-generated implementation stubs for
+// unimplemented steps and writes them to the given file, one stub per step,
+// deduplicated.
+func writeStubs(validationErrors validationErrors, fileName string) error {
+	var stubs []string
+	for errType, errs := range groupErrors(validationErrors) {
+		if errType != gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
+			continue
+		}
+		stubs = append(stubs, filterDuplicateSuggestions(errs)...)
+	}
+	if len(stubs) == 0 {
+		logger.Infof(true, "No unimplemented steps found, skipped writing %s.", fileName)
+		return nil
+	}
+	contents := strings.Join(stubs, "\n") + "\n"
+	if err := ioutil.WriteFile(fileName, []byte(contents), common.NewFilePermissions); err != nil {
+		return fmt.Errorf("unable to write stubs to %s: %s", fileName, err.Error())
+	}
+	logger.Infof(true, "Wrote %d step implementation stub(s) to %s.", len(stubs), fileName)
+	return nil
+}
@@ -0,0 +1,61 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestWriteStubsWritesDeduplicatedStubsToFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gauge-stubs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	stubsFile := filepath.Join(dir, "stubs.txt")
+	notFound := gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND
+	spec := &gauge.Specification{FileName: "one.spec"}
+	step1 := &gauge.Step{LineNo: 3, Value: "first step"}
+	step2 := &gauge.Step{LineNo: 5, Value: "first step"}
+	vErrs := validationErrors{spec: []error{
+		NewStepValidationError(step1, "Step implementation not found", "one.spec", &notFound, "stub one"),
+		NewStepValidationError(step2, "Step implementation not found", "one.spec", &notFound, "stub one"),
+	}}
+
+	if err := writeStubs(vErrs, stubsFile); err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	contents, err := ioutil.ReadFile(stubsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(contents) != "stub one\n" {
+		t.Errorf("Expected deduplicated stub contents, got %q", string(contents))
+	}
+}
+
+func TestWriteStubsSkipsFileWhenNothingIsUnimplemented(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gauge-stubs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	stubsFile := filepath.Join(dir, "stubs.txt")
+
+	if err := writeStubs(validationErrors{}, stubsFile); err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	if _, err := os.Stat(stubsFile); !os.IsNotExist(err) {
+		t.Error("Expected no stubs file to be written")
+	}
+}
@@ -0,0 +1,74 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// validateTraceabilityTags checks tags like @jira:PROJ-123 that link specs
+// and scenarios to requirement or issue IDs: the ID behind a configured
+// traceability prefix (traceability_tag_prefixes) must match the configured
+// traceability_id_pattern, so broken references are caught before execution.
+func validateTraceabilityTags(spec *gauge.Specification) []error {
+	pattern, err := regexp.Compile("^(?:" + env.TraceabilityIDPattern() + ")$")
+	if err != nil {
+		return []error{NewSpecValidationError(fmt.Sprintf("Invalid traceability_id_pattern '%s'. %s", env.TraceabilityIDPattern(), err.Error()), spec.FileName)}
+	}
+	var errs []error
+	addErrs := func(tags *gauge.Tags) {
+		for _, tag := range tags.Values() {
+			if err := checkTraceabilityTag(strings.TrimSpace(tag), pattern); err != nil {
+				errs = append(errs, NewSpecValidationError(err.Error(), spec.FileName))
+			}
+		}
+	}
+	if spec.Tags != nil {
+		addErrs(spec.Tags)
+	}
+	for _, scenario := range spec.Scenarios {
+		if scenario.Tags != nil {
+			addErrs(scenario.Tags)
+		}
+	}
+	return errs
+}
+
+func checkTraceabilityTag(tag string, pattern *regexp.Regexp) error {
+	prefix, id := splitTraceabilityTag(tag)
+	if prefix == "" {
+		return nil
+	}
+	if id == "" {
+		return fmt.Errorf("Tag '%s:' has no requirement ID, expected one like %s:PROJ-123", prefix, prefix)
+	}
+	if !pattern.MatchString(id) {
+		return fmt.Errorf("Tag '%s:%s' has an invalid requirement ID, it must match '%s'", prefix, id, env.TraceabilityIDPattern())
+	}
+	return nil
+}
+
+// splitTraceabilityTag returns the traceability prefix and the referenced ID
+// of the tag, or empty strings when the tag is not a traceability tag.
+func splitTraceabilityTag(tag string) (string, string) {
+	idx := strings.Index(tag, ":")
+	if idx < 0 {
+		return "", ""
+	}
+	prefix := strings.ToLower(strings.TrimSpace(tag[:idx]))
+	for _, traceability := range env.TraceabilityTagPrefixes() {
+		if prefix == traceability {
+			return prefix, strings.TrimSpace(tag[idx+1:])
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,106 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func stubTraceabilityProperties(t *testing.T, prefixes []string, pattern string) {
+	oldPrefixes, oldPattern := env.TraceabilityTagPrefixes, env.TraceabilityIDPattern
+	env.TraceabilityTagPrefixes = func() []string { return prefixes }
+	env.TraceabilityIDPattern = func() string { return pattern }
+	t.Cleanup(func() {
+		env.TraceabilityTagPrefixes = oldPrefixes
+		env.TraceabilityIDPattern = oldPattern
+	})
+}
+
+func TestValidateTraceabilityTagsAcceptsWellFormedIDs(t *testing.T) {
+	stubTraceabilityProperties(t, []string{"jira", "issue"}, "[A-Za-z][A-Za-z0-9]*-[0-9]+")
+
+	errs := validateTraceabilityTags(specWithTags("jira:PROJ-123", "issue:GH-42", "nightly"))
+
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateTraceabilityTagsCatchesMalformedID(t *testing.T) {
+	stubTraceabilityProperties(t, []string{"jira"}, "[A-Za-z][A-Za-z0-9]*-[0-9]+")
+
+	errs := validateTraceabilityTags(specWithTags("jira:123"))
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if !strings.Contains(errs[0].Error(), "invalid requirement ID") {
+		t.Errorf("Expected invalid ID error, got: %s", errs[0].Error())
+	}
+}
+
+func TestValidateTraceabilityTagsCatchesEmptyID(t *testing.T) {
+	stubTraceabilityProperties(t, []string{"jira"}, "[A-Za-z][A-Za-z0-9]*-[0-9]+")
+
+	errs := validateTraceabilityTags(specWithTags("jira:"))
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if !strings.Contains(errs[0].Error(), "has no requirement ID") {
+		t.Errorf("Expected missing ID error, got: %s", errs[0].Error())
+	}
+}
+
+func TestValidateTraceabilityTagsChecksScenarioTags(t *testing.T) {
+	stubTraceabilityProperties(t, []string{"jira"}, "[A-Za-z][A-Za-z0-9]*-[0-9]+")
+	spec := specWithTags("jira:PROJ-1")
+	spec.Scenarios = []*gauge.Scenario{{Tags: &gauge.Tags{RawValues: [][]string{{"jira:broken id"}}}}}
+
+	errs := validateTraceabilityTags(spec)
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+}
+
+func TestValidateTraceabilityTagsIgnoresUnconfiguredPrefixes(t *testing.T) {
+	stubTraceabilityProperties(t, []string{"jira"}, "[A-Za-z][A-Za-z0-9]*-[0-9]+")
+
+	errs := validateTraceabilityTags(specWithTags("timeout:30s", "owner:alice"))
+
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateTraceabilityTagsHonoursCustomPattern(t *testing.T) {
+	stubTraceabilityProperties(t, []string{"req"}, "[0-9]{4}")
+
+	errs := validateTraceabilityTags(specWithTags("req:1234", "req:PROJ-1"))
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+}
+
+func TestValidateTraceabilityTagsReportsInvalidPattern(t *testing.T) {
+	stubTraceabilityProperties(t, []string{"jira"}, "[unclosed")
+
+	errs := validateTraceabilityTags(specWithTags("jira:PROJ-1"))
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if !strings.Contains(errs[0].Error(), "traceability_id_pattern") {
+		t.Errorf("Expected pattern error, got: %s", errs[0].Error())
+	}
+}
@@ -22,11 +22,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/getgauge/gauge/annotations"
 	"github.com/getgauge/gauge/api"
 	"github.com/getgauge/gauge/gauge"
 	gm "github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/project"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 )
@@ -110,6 +112,20 @@ func Validate(args []string) {
 		args = append(args, util.GetSpecDirs()...)
 	}
 	res := ValidateSpecs(args, false)
+	if JSONOutput {
+		if res.Runner != nil {
+			if err := res.Runner.Kill(); err != nil {
+				logger.Errorf(false, "unable to kill runner: %s", err.Error())
+			}
+		}
+		if err := printJSONIssues(res); err != nil {
+			logger.Fatalf(true, "Unable to print validation results as JSON: %s", err.Error())
+		}
+		if len(res.Errs) > 0 || !res.ParseOk || (res.ErrMap != nil && res.ErrMap.HasErrors()) {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
 	if len(res.Errs) > 0 {
 		os.Exit(1)
 	}
@@ -160,6 +176,16 @@ func NewValidationResult(s *gauge.SpecCollection, errMap *gauge.BuildErrors, r r
 	return &ValidationResult{SpecCollection: s, ErrMap: errMap, Runner: r, ParseOk: parseOk, Errs: e}
 }
 
+// ValidateSpecsInProject activates the given project and validates the specs
+// in it. It is the project-scoped entry point replacing the package-level
+// globals ValidateSpecs still reads.
+func ValidateSpecsInProject(p *project.Project, args []string, debug bool) (*ValidationResult, error) {
+	if err := p.Activate(); err != nil {
+		return nil, err
+	}
+	return ValidateSpecs(args, debug), nil
+}
+
 // ValidateSpecs parses the specs, creates a new validator and call the runner to get the validation result.
 func ValidateSpecs(args []string, debug bool) *ValidationResult {
 	logger.Debug(true, "Parsing started.")
@@ -174,14 +200,27 @@ func ValidateSpecs(args []string, debug bool) *ValidationResult {
 	vErrs := NewValidator(s, r, conceptDict).Validate()
 	errMap = getErrMap(errMap, vErrs)
 	s = parser.GetSpecsForDataTableRows(s, errMap)
-	printValidationFailures(vErrs)
-	showSuggestion(vErrs)
+	if !JSONOutput {
+		printValidationFailures(vErrs)
+		if GenerateStubsFile == "" {
+			showSuggestion(vErrs)
+		}
+	}
+	if GenerateStubsFile != "" {
+		if err := writeStubs(vErrs, GenerateStubsFile); err != nil {
+			logger.Errorf(true, err.Error())
+		}
+	}
 	if !res.Ok {
 		err := r.Kill()
 		if err != nil {
 			logger.Errorf(true, "unable to kill runner: %s", err.Error())
 		}
-		return NewValidationResult(nil, nil, nil, false, errors.New("Parsing failed"))
+		errs := []error{errors.New("Parsing failed")}
+		for _, e := range res.ParseErrors {
+			errs = append(errs, e)
+		}
+		return NewValidationResult(nil, errMap, nil, false, errs...)
 	}
 	if specsFailed {
 		return NewValidationResult(gauge.NewSpecCollection(s, false), errMap, r, false)
@@ -244,6 +283,12 @@ func fillSpecErrors(spec *gauge.Specification, errMap *gauge.BuildErrors, steps
 func printValidationFailures(validationErrors validationErrors) {
 	for _, e := range FilterDuplicates(validationErrors) {
 		logger.Errorf(true, "[ValidationError] %s", e.Error())
+		switch vErr := e.(type) {
+		case StepValidationError:
+			annotations.Error("gauge-validation", vErr.fileName, vErr.step.LineNo, vErr.message)
+		case SpecValidationError:
+			annotations.Error("gauge-validation", vErr.fileName, 1, vErr.message)
+		}
 	}
 }
 
@@ -397,33 +442,52 @@ func (v *SpecValidator) DataTable(dataTable *gauge.DataTable) {
 // Validates data table for the range, if any error found append to the validation errors
 func (v *SpecValidator) Specification(specification *gauge.Specification) {
 	v.validationErrors = make([]error, 0)
-	err := validateDataTableRange(specification.DataTable.Table.GetRowCount())
+	err := validateDataTableRange(&specification.DataTable.Table)
 	if err != nil {
 		v.validationErrors = append(v.validationErrors, NewSpecValidationError(err.Error(), specification.FileName))
 	}
+	v.validationErrors = append(v.validationErrors, validateEnvSpecificReferences(specification)...)
+	v.validationErrors = append(v.validationErrors, validateReservedTags(specification)...)
+	v.validationErrors = append(v.validationErrors, validateTraceabilityTags(specification)...)
 }
 
-func validateDataTableRange(rowCount int) error {
+func validateDataTableRange(table *gauge.Table) error {
 	if TableRows == "" {
 		return nil
 	}
-	if strings.Contains(TableRows, "-") {
-		indexes := strings.Split(TableRows, "-")
-		if len(indexes) > 2 {
-			return fmt.Errorf("Table rows range '%s' is invalid => Table rows range should be of format rowNumber-rowNumber", TableRows)
-		}
-		if err := validateTableRow(indexes[0], rowCount); err != nil {
-			return err
+	rowCount := table.GetRowCount()
+	for _, part := range strings.Split(TableRows, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return fmt.Errorf("Table rows range validation failed => Row number cannot be empty")
 		}
-		if err := validateTableRow(indexes[1], rowCount); err != nil {
-			return err
+		if strings.Contains(part, "=") {
+			column := strings.TrimSpace(strings.SplitN(part, "=", 2)[0])
+			if column == "" {
+				return fmt.Errorf("Table rows filter '%s' is invalid => Column name cannot be empty", part)
+			}
+			if _, err := table.Get(column); err != nil {
+				return fmt.Errorf("Table rows filter '%s' is invalid => Column '%s' does not exist in the data table", part, column)
+			}
+			continue
 		}
-	} else {
-		indexes := strings.Split(TableRows, ",")
-		for _, i := range indexes {
-			if err := validateTableRow(i, rowCount); err != nil {
-				return err
+		if strings.Contains(part, "-") {
+			bounds := strings.Split(part, "-")
+			if len(bounds) != 2 {
+				return fmt.Errorf("Table rows range '%s' is invalid => Table rows range should be of format rowNumber-rowNumber", part)
+			}
+			for _, bound := range bounds {
+				if strings.TrimSpace(bound) == "" {
+					continue
+				}
+				if err := validateTableRow(bound, rowCount); err != nil {
+					return err
+				}
 			}
+			continue
+		}
+		if err := validateTableRow(part, rowCount); err != nil {
+			return err
 		}
 	}
 	return nil
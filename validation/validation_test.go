@@ -8,6 +8,7 @@ package validation
 
 import (
 	"net"
+	"strconv"
 	"testing"
 
 	"github.com/getgauge/gauge/gauge"
@@ -261,15 +262,31 @@ var tableRowTests = []*tableRow{
 	{"Row count is zero with non empty input", "1", 0, errors.New("Table rows range validation failed => Table row number '1' is out of range")},
 	{"Row count is non-zero with empty input", "", 2, nil},
 	{"Row count is non-zero with non-empty input", "2", 2, nil},
+	{"Open ended range", "3-", 5, nil},
+	{"Open ended range out of range", "6-", 5, errors.New("Table rows range validation failed => Table row number '6' is out of range")},
+	{"Range open at the start", "-3", 5, nil},
+	{"Mixed ranges and numbers", "1-2,5", 5, nil},
+	{"Valid column filter", "role=admin", 5, nil},
+	{"Unknown column in filter", "team=qa", 5, errors.New("Table rows filter 'team=qa' is invalid => Column 'team' does not exist in the data table")},
+	{"Empty column in filter", "=admin", 5, errors.New("Table rows filter '=admin' is invalid => Column name cannot be empty")},
+}
+
+func tableWithRows(count int) *gauge.Table {
+	table := gauge.NewTable([]string{"id", "role"}, [][]gauge.TableCell{{}, {}}, 1)
+	for i := 0; i < count; i++ {
+		table.AddRowValues(table.CreateTableCells([]string{strconv.Itoa(i + 1), "admin"}))
+	}
+	return table
 }
 
 func (s *MySuite) TestToValidateDataTableRowsRangeFromInputFlag(c *C) {
 	for _, test := range tableRowTests {
 		TableRows = test.input
-		got := validateDataTableRange(test.tableRowsCount)
+		got := validateDataTableRange(tableWithRows(test.tableRowsCount))
 		want := test.err
 		c.Assert(got, DeepEquals, want, Commentf(test.name))
 	}
+	TableRows = ""
 }
 
 type mockRunner struct {
@@ -0,0 +1,111 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Constraint is a version range like ">=4.0 <5" or "2.1.3". Clauses are
+// separated by spaces and all of them must hold. A clause is an operator
+// (>=, <=, >, <, =) followed by a version; a bare version matches by prefix,
+// so "4.0" matches every 4.0.x. Partial versions are padded with zeros.
+type Constraint struct {
+	text    string
+	clauses []constraintClause
+}
+
+type constraintClause struct {
+	op      string
+	version *Version
+	// parts is the number of version segments the clause spelled out,
+	// so a bare "4.0" can match on major and minor only.
+	parts int
+}
+
+var constraintOperators = []string{">=", "<=", ">", "<", "="}
+
+// ParseConstraint parses a version range expression into a Constraint.
+func ParseConstraint(text string) (*Constraint, error) {
+	c := &Constraint{text: text}
+	for _, field := range strings.Fields(text) {
+		op := ""
+		for _, candidate := range constraintOperators {
+			if strings.HasPrefix(field, candidate) {
+				op = candidate
+				break
+			}
+		}
+		v, parts, err := parsePartialVersion(strings.TrimPrefix(field, op))
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %s", text, err.Error())
+		}
+		c.clauses = append(c.clauses, constraintClause{op: op, version: v, parts: parts})
+	}
+	if len(c.clauses) == 0 {
+		return nil, fmt.Errorf("invalid version constraint %q: empty constraint", text)
+	}
+	return c, nil
+}
+
+// Satisfies reports whether the given version is within the constraint.
+func (c *Constraint) Satisfies(v *Version) bool {
+	for _, clause := range c.clauses {
+		if !clause.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Constraint) String() string {
+	return c.text
+}
+
+func (clause constraintClause) matches(v *Version) bool {
+	switch clause.op {
+	case ">=":
+		return v.IsGreaterThanEqualTo(clause.version)
+	case ">":
+		return v.IsGreaterThan(clause.version)
+	case "<=":
+		return v.IsLesserThanEqualTo(clause.version)
+	case "<":
+		return v.IsLesserThan(clause.version)
+	default: // "=" and bare versions match on the segments spelled out.
+		if !IsEqual(v.Major, clause.version.Major) {
+			return false
+		}
+		if clause.parts > 1 && !IsEqual(v.Minor, clause.version.Minor) {
+			return false
+		}
+		if clause.parts > 2 && !IsEqual(v.Patch, clause.version.Patch) {
+			return false
+		}
+		return true
+	}
+}
+
+// parsePartialVersion parses "5", "4.0" or "4.0.3", padding missing segments
+// with zeros, and returns how many segments were given.
+func parsePartialVersion(text string) (*Version, int, error) {
+	splits := strings.Split(text, ".")
+	if len(splits) > 3 {
+		return nil, 0, fmt.Errorf("incorrect version format %s", text)
+	}
+	segments := []int{0, 0, 0}
+	for i, split := range splits {
+		value, err := strconv.Atoi(split)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error parsing version %s to integer. %s", text, err.Error())
+		}
+		segments[i] = value
+	}
+	return &Version{segments[0], segments[1], segments[2]}, len(splits), nil
+}
@@ -0,0 +1,56 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package version
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestConstraintWithRange(c *C) {
+	constraint, err := ParseConstraint(">=4.0 <5")
+	c.Assert(err, Equals, nil)
+
+	c.Assert(constraint.Satisfies(&Version{4, 0, 0}), Equals, true)
+	c.Assert(constraint.Satisfies(&Version{4, 7, 3}), Equals, true)
+	c.Assert(constraint.Satisfies(&Version{3, 9, 9}), Equals, false)
+	c.Assert(constraint.Satisfies(&Version{5, 0, 0}), Equals, false)
+}
+
+func (s *MySuite) TestBareConstraintMatchesByPrefix(c *C) {
+	constraint, err := ParseConstraint("4.0")
+	c.Assert(err, Equals, nil)
+
+	c.Assert(constraint.Satisfies(&Version{4, 0, 0}), Equals, true)
+	c.Assert(constraint.Satisfies(&Version{4, 0, 9}), Equals, true)
+	c.Assert(constraint.Satisfies(&Version{4, 1, 0}), Equals, false)
+
+	constraint, err = ParseConstraint("2.1.3")
+	c.Assert(err, Equals, nil)
+	c.Assert(constraint.Satisfies(&Version{2, 1, 3}), Equals, true)
+	c.Assert(constraint.Satisfies(&Version{2, 1, 4}), Equals, false)
+}
+
+func (s *MySuite) TestConstraintWithExclusiveBounds(c *C) {
+	constraint, err := ParseConstraint(">1.2 <=2")
+	c.Assert(err, Equals, nil)
+
+	c.Assert(constraint.Satisfies(&Version{1, 2, 0}), Equals, false)
+	c.Assert(constraint.Satisfies(&Version{1, 2, 1}), Equals, true)
+	c.Assert(constraint.Satisfies(&Version{2, 0, 0}), Equals, true)
+	c.Assert(constraint.Satisfies(&Version{2, 0, 1}), Equals, false)
+}
+
+func (s *MySuite) TestParseInvalidConstraint(c *C) {
+	_, err := ParseConstraint("")
+	c.Assert(err, NotNil)
+
+	_, err = ParseConstraint(">=four")
+	c.Assert(err, NotNil)
+
+	_, err = ParseConstraint("1.2.3.4")
+	c.Assert(err, NotNil)
+}